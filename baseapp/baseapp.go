@@ -3,9 +3,11 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"os"
 	"reflect"
 	"runtime/debug"
 	"strings"
+	"syscall"
 
 	"errors"
 
@@ -18,6 +20,7 @@ import (
 
 	"my-cosmos/cosmos-sdk/codec"
 	"my-cosmos/cosmos-sdk/store"
+	"my-cosmos/cosmos-sdk/store/snapshots"
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/version"
 )
@@ -25,6 +28,10 @@ import (
 // Key to store the consensus params in the main store.
 var mainConsensusParamsKey = []byte("consensus_params")
 
+// Format of the keys to store the header of each committed block in the DB,
+// outside of the stores, for queries at a past height.
+const blockHeaderKeyFmt = "h/%d" // h/<height>
+
 // Enum mode for app.runTx
 type runTxMode uint8
 
@@ -90,6 +97,21 @@ type BaseApp struct {
 	// 验证人 愿意接受处理交易的最低 gas价格。 这主要用于DoS和垃圾邮件预防。
 	minGasPrices sdk.DecCoins
 
+	// The height after which the node gracefully shuts down once the block is
+	// committed, e.g. before a software upgrade. Zero disables halting.
+	haltHeight uint64
+
+	// Snapshots of the multistore are taken into snapshotStore every
+	// snapshotInterval heights, keeping the snapshotKeepRecent most recent
+	// ones. A zero interval disables snapshots.
+	snapshotStore      *snapshots.Store
+	snapshotInterval   uint64
+	snapshotKeepRecent uint32
+
+	// Snapshots are taken in the background. The channel holds a token while
+	// one is running, and the heights reached meanwhile are skipped.
+	snapshotting chan struct{}
+
 	// flag for sealing options and parameters to a BaseApp
 	// 用于密封BaseApp的选项和参数的标志
 	sealed bool
@@ -523,9 +545,28 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 		return sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[1])).QueryResult()
 	}
 
+	cacheMS, header, err := app.queryMultiStore(req.Height)
+	if err != nil {
+		return err.QueryResult()
+	}
+
+	// the stores which have been pruned on their own panic once accessed
+	defer func() {
+		if r := recover(); r != nil {
+			rType, ok := r.(sdk.ErrorPrunedVersion)
+			if !ok {
+				panic(r)
+			}
+			res = sdk.ErrUnknownRequest(fmt.Sprintf(
+				"cannot query height %d, the %s store has pruned it (latest height: %d)",
+				rType.Version, rType.StoreName, app.LastBlockHeight(),
+			)).QueryResult()
+		}
+	}()
+
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
-		app.cms.CacheMultiStore(), app.checkState.ctx.BlockHeader(), true, app.logger,
+		cacheMS, header, true, app.logger,
 	).WithMinGasPrices(app.minGasPrices)
 
 	// Passes the rest of the path as an argument to the querier.
@@ -538,15 +579,90 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 			Code:      uint32(err.Code()),
 			Codespace: string(err.Codespace()),
 			Log:       err.ABCILog(),
+			Height:    ctx.BlockHeight(),
 		}
 	}
 
 	return abci.ResponseQuery{
-		Code:  uint32(sdk.CodeOK),
-		Value: resBytes,
+		Code:   uint32(sdk.CodeOK),
+		Value:  resBytes,
+		Height: ctx.BlockHeight(),
+	}
+}
+
+// queryMultiStore returns a cache-wrapped multistore of the state at the
+// given height along with the header custom queries are run with, so that
+// queries depending on the block time see the time of that height. A height
+// of 0 stands for the latest state. Past heights whose header was not kept,
+// such as the ones committed by an older version of the app, are rejected.
+func (app *BaseApp) queryMultiStore(height int64) (sdk.CacheMultiStore, abci.Header, sdk.Error) {
+	if height == 0 {
+		return app.cms.CacheMultiStore(), app.checkState.ctx.BlockHeader(), nil
+	}
+
+	lastHeight := app.LastBlockHeight()
+	if height < 0 || height > lastHeight {
+		return nil, abci.Header{}, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"cannot query height %d; the latest height is %d", height, lastHeight))
+	}
+
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, abci.Header{}, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"cannot query height %d, it may have been pruned (latest height: %d): %s", height, lastHeight, err))
+	}
+
+	header, ok := app.getBlockHeader(height)
+	if !ok {
+		return nil, abci.Header{}, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"cannot query height %d, its block header was not kept (latest height: %d)", height, lastHeight))
+	}
+	return cacheMS, header, nil
+}
+
+// setBlockHeader stores the header of a block in the DB. It is kept as long as
+// the multistore keeps the state of its height, see deletePrunedBlockHeaders.
+func (app *BaseApp) setBlockHeader(header abci.Header) {
+	bz, err := proto.Marshal(&header)
+	if err != nil {
+		panic(err)
+	}
+	app.db.Set([]byte(fmt.Sprintf(blockHeaderKeyFmt, header.Height)), bz)
+}
+
+// prunedVersionsLister is implemented by the multistores which report the
+// versions pruned by their last commit, such as rootmulti.Store.
+type prunedVersionsLister interface {
+	PrunedVersions() []int64
+}
+
+// deletePrunedBlockHeaders deletes the headers of the heights pruned by the
+// last commit of the multistore, which can no longer be queried. The headers
+// are never deleted with a multistore which does not report its pruning.
+func (app *BaseApp) deletePrunedBlockHeaders() {
+	lister, ok := app.cms.(prunedVersionsLister)
+	if !ok {
+		return
+	}
+	for _, height := range lister.PrunedVersions() {
+		app.db.Delete([]byte(fmt.Sprintf(blockHeaderKeyFmt, height)))
 	}
 }
 
+// getBlockHeader returns the header of a committed block, if it was kept.
+func (app *BaseApp) getBlockHeader(height int64) (abci.Header, bool) {
+	bz := app.db.Get([]byte(fmt.Sprintf(blockHeaderKeyFmt, height)))
+	if bz == nil {
+		return abci.Header{}, false
+	}
+
+	var header abci.Header
+	if err := proto.Unmarshal(bz, &header); err != nil {
+		panic(err)
+	}
+	return header, true
+}
+
 // BeginBlock implements the ABCI application interface.
 // TODO 交由tendermint 发起 rpc调用
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
@@ -955,9 +1071,14 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// 编写Deliver状态并提交MultiStore
 	app.deliverState.ms.Write()
 
+	// keep the header for queries at this height, before the multistore
+	// commit syncs the DB
+	app.setBlockHeader(header)
+
 	//
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
+	app.deletePrunedBlockHeaders()
 
 	// Reset the Check state to the latest committed.
 	//
@@ -968,11 +1089,84 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		app.snapshot(header.Height)
+	}
+
+	if app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight {
+		app.halt(header.Height)
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
 }
 
+// halt attempts to gracefully shut down the node by sending SIGINT to the
+// process, so the block just committed is persisted before exiting. It falls
+// back on os.Exit if the signal cannot be delivered.
+func (app *BaseApp) halt(height int64) {
+	app.logger.Info("halting node per configuration", "height", height, "halt-height", app.haltHeight)
+
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		if err = p.Signal(syscall.SIGINT); err == nil {
+			return
+		}
+	}
+
+	app.logger.Error("failed to signal process, exiting", "err", err)
+	os.Exit(0)
+}
+
+// Snapshotter returns the multistore of the app as a snapshots.Snapshotter,
+// or nil if it does not support snapshots.
+func (app *BaseApp) Snapshotter() snapshots.Snapshotter {
+	snapshotter, _ := app.cms.(snapshots.Snapshotter)
+	return snapshotter
+}
+
+// snapshot starts taking a snapshot of the multistore at the height just
+// committed, in the background so that Commit does not wait for it. The
+// height is held from pruning until the snapshot is done. The height is
+// skipped if the previous snapshot is still running. Failures are logged
+// rather than halting the chain.
+func (app *BaseApp) snapshot(height int64) {
+	snapshotter := app.Snapshotter()
+	if snapshotter == nil {
+		app.logger.Error("multistore does not support snapshots", "height", height)
+		return
+	}
+
+	select {
+	case app.snapshotting <- struct{}{}:
+	default:
+		app.logger.Info("skipping state snapshot, the previous one is still running", "height", height)
+		return
+	}
+
+	snapshotter.HoldHeight(height)
+	go func() {
+		defer func() { <-app.snapshotting }()
+		defer snapshotter.ReleaseHeight(height)
+		app.createSnapshot(snapshotter, height)
+	}()
+}
+
+// createSnapshot takes a snapshot of a held height and prunes the old ones.
+func (app *BaseApp) createSnapshot(snapshotter snapshots.Snapshotter, height int64) {
+	manifest, err := app.snapshotStore.Create(snapshotter, height)
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+	app.logger.Info("created state snapshot", "height", height, "hash", manifest.Hash)
+
+	if err := app.snapshotStore.Prune(int(app.snapshotKeepRecent)); err != nil {
+		app.logger.Error("failed to prune state snapshots", "err", err)
+	}
+}
+
 // ----------------------------------------------------------------------------
 // State
 
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
+	"my-cosmos/cosmos-sdk/store/snapshots"
 	store "my-cosmos/cosmos-sdk/store/types"
 
 	"github.com/stretchr/testify/assert"
@@ -261,6 +264,19 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestSetHaltHeight(t *testing.T) {
+	app := newBaseApp(t.Name(), SetHaltHeight(20))
+	require.Equal(t, uint64(20), app.haltHeight)
+
+	// zero is ignored and the halt height is only ever lowered
+	app.SetHaltHeight(0)
+	require.Equal(t, uint64(20), app.haltHeight)
+	app.SetHaltHeight(30)
+	require.Equal(t, uint64(20), app.haltHeight)
+	app.SetHaltHeight(10)
+	require.Equal(t, uint64(10), app.haltHeight)
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -1186,6 +1202,214 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestCustomQueryAtHeight(t *testing.T) {
+	key := []byte("counter")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			store := ctx.KVStore(capKey1)
+			store.Set(key, []byte{byte(msg.(msgCounter).Counter)})
+			return sdk.Result{}
+		})
+		bapp.QueryRouter().AddRoute("counter", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+			store := ctx.KVStore(capKey1)
+			return store.Get(key), nil
+		})
+	}
+
+	commitBlocks := func(app *BaseApp, n int64) {
+		app.InitChain(abci.RequestInitChain{})
+		for height := int64(1); height <= n; height++ {
+			app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+			resTx := app.Deliver(newTxCounter(height, height))
+			require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+			app.EndBlock(abci.RequestEndBlock{})
+			app.Commit()
+		}
+	}
+
+	app := setupBaseApp(t, SetPruning(store.PruneNothing), routerOpt)
+	commitBlocks(app, 3)
+
+	// the latest state is queried without a height
+	res := app.Query(abci.RequestQuery{Path: "/custom/counter"})
+	require.Equal(t, uint32(sdk.CodeOK), res.Code, res.Log)
+	require.Equal(t, []byte{3}, res.Value)
+	require.Equal(t, int64(3), res.Height)
+
+	for height := int64(1); height <= 3; height++ {
+		res = app.Query(abci.RequestQuery{Path: "/custom/counter", Height: height})
+		require.Equal(t, uint32(sdk.CodeOK), res.Code, res.Log)
+		require.Equal(t, []byte{byte(height)}, res.Value)
+		require.Equal(t, height, res.Height)
+	}
+
+	// heights after the latest one can not be queried
+	res = app.Query(abci.RequestQuery{Path: "/custom/counter", Height: 4})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), res.Code)
+
+	// neither can pruned heights
+	app = setupBaseApp(t, routerOpt)
+	commitBlocks(app, 3)
+
+	res = app.Query(abci.RequestQuery{Path: "/custom/counter", Height: 1})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), res.Code)
+	require.Contains(t, res.Log, "pruned")
+
+	res = app.Query(abci.RequestQuery{Path: "/custom/counter", Height: 3})
+	require.Equal(t, uint32(sdk.CodeOK), res.Code, res.Log)
+	require.Equal(t, []byte{3}, res.Value)
+}
+
+func TestCustomQueryAtHeightHeader(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("time", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+			return []byte(ctx.BlockHeader().Time.UTC().Format(time.RFC3339)), nil
+		})
+	}
+
+	app := setupBaseApp(t, SetPruning(store.PruneNothing), routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+	blockTime := func(height int64) time.Time {
+		return time.Date(2019, 1, 1, 0, 0, int(height), 0, time.UTC)
+	}
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height, Time: blockTime(height)}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	// the queries at a past height see the header of that height
+	for height := int64(1); height <= 3; height++ {
+		res := app.Query(abci.RequestQuery{Path: "/custom/time", Height: height})
+		require.Equal(t, uint32(sdk.CodeOK), res.Code, res.Log)
+		require.Equal(t, blockTime(height).Format(time.RFC3339), string(res.Value))
+		require.Equal(t, height, res.Height)
+	}
+
+	// the heights whose header was not kept are rejected rather than run
+	// with the header of the latest block
+	app.db.Delete([]byte(fmt.Sprintf(blockHeaderKeyFmt, 2)))
+	res := app.Query(abci.RequestQuery{Path: "/custom/time", Height: 2})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), res.Code)
+	require.Contains(t, res.Log, "header")
+}
+
+func TestBlockHeadersPruned(t *testing.T) {
+	app := setupBaseApp(t, SetPruning(store.NewPruningOptions(1, 0)))
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	// the header of a height is deleted along with its state
+	_, ok := app.getBlockHeader(1)
+	require.False(t, ok)
+	for height := int64(2); height <= 3; height++ {
+		header, ok := app.getBlockHeader(height)
+		require.True(t, ok)
+		require.Equal(t, height, header.Height)
+	}
+}
+
+func TestCustomQueryAtHeightStorePruning(t *testing.T) {
+	key := []byte("counter")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			for _, capKey := range []sdk.StoreKey{capKey1, capKey2} {
+				ctx.KVStore(capKey).Set(key, []byte{byte(msg.(msgCounter).Counter)})
+			}
+			return sdk.Result{}
+		})
+		for route, capKey := range map[string]sdk.StoreKey{"key1": capKey1, "key2": capKey2} {
+			capKey := capKey
+			bapp.QueryRouter().AddRoute(route, func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+				return ctx.KVStore(capKey).Get(key), nil
+			})
+		}
+	}
+
+	// key1 keeps its full history, key2 only its latest state
+	app := newBaseApp(t.Name(), SetPruning(store.PruneNothing), SetStorePruning(map[string]string{"key2": "everything"}), routerOpt)
+	app.MountStores(capKey1, capKey2)
+	require.Nil(t, app.LoadLatestVersion(capKey1))
+
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		resTx := app.Deliver(newTxCounter(height, height))
+		require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	// the history of key1 can be queried although key2 has been pruned
+	res := app.Query(abci.RequestQuery{Path: "/custom/key1", Height: 1})
+	require.Equal(t, uint32(sdk.CodeOK), res.Code, res.Log)
+	require.Equal(t, []byte{1}, res.Value)
+
+	// reading key2 at a pruned height fails
+	res = app.Query(abci.RequestQuery{Path: "/custom/key2", Height: 1})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), res.Code)
+	require.Contains(t, res.Log, "pruned")
+	require.Contains(t, res.Log, "key2")
+
+	res = app.Query(abci.RequestQuery{Path: "/custom/key2", Height: 3})
+	require.Equal(t, uint32(sdk.CodeOK), res.Code, res.Log)
+	require.Equal(t, []byte{3}, res.Value)
+}
+
+func TestSnapshotInterval(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	snapshotStore := snapshots.NewStore(dir, 0)
+	app := setupBaseApp(t, SetSnapshot(snapshotStore, 2, 1))
+
+	app.InitChain(abci.RequestInitChain{})
+	appHashes := make(map[int64][]byte)
+	for height := int64(1); height <= 5; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.cms.GetCommitKVStore(capKey2).Set([]byte("height"), []byte{byte(height)})
+		app.EndBlock(abci.RequestEndBlock{})
+		appHashes[height] = app.Commit().Data
+		waitForSnapshot(app)
+	}
+
+	// a snapshot is taken every other height, only the most recent is kept
+	manifests, err := snapshotStore.List()
+	require.Nil(t, err)
+	require.Len(t, manifests, 1)
+	require.Equal(t, int64(4), manifests[0].Height)
+	require.Equal(t, appHashes[4], []byte(manifests[0].AppHash))
+
+	// a height is skipped while the previous snapshot is running
+	app.snapshotting <- struct{}{}
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 6}})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	<-app.snapshotting
+	_, err = snapshotStore.Get(6)
+	require.NotNil(t, err)
+
+	// the snapshot restores the state of that height
+	restored := newBaseApp(t.Name())
+	restored.MountStores(capKey1, capKey2)
+	require.Nil(t, restored.LoadLatestVersion(capKey1))
+	_, err = snapshotStore.Restore(restored.Snapshotter(), 4, appHashes[4])
+	require.Nil(t, err)
+	require.Equal(t, appHashes[4], restored.LastCommitID().Hash)
+	require.Equal(t, []byte{4}, restored.cms.GetCommitKVStore(capKey2).Get([]byte("height")))
+}
+
+// waitForSnapshot waits for the snapshot running in the background, if any.
+func waitForSnapshot(app *BaseApp) {
+	app.snapshotting <- struct{}{}
+	<-app.snapshotting
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
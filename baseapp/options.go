@@ -7,6 +7,7 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"my-cosmos/cosmos-sdk/store"
+	"my-cosmos/cosmos-sdk/store/snapshots"
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
@@ -19,6 +20,27 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
+// SetStorePruning returns an option that sets the pruning strategies of
+// individual stores of the multistore associated with the app, by store name.
+// They override the option set with SetPruning, see store.ParsePruningOptions
+// for their format.
+func SetStorePruning(strategies map[string]string) func(*BaseApp) {
+	opts := make(map[string]sdk.PruningOptions, len(strategies))
+	for name, strategy := range strategies {
+		opt, err := store.ParsePruningOptions(strategy)
+		if err != nil {
+			panic(fmt.Sprintf("invalid pruning strategy of store %s: %v", name, err))
+		}
+		opts[name] = opt
+	}
+
+	return func(bap *BaseApp) {
+		for name, opt := range opts {
+			bap.cms.SetStorePruning(name, opt)
+		}
+	}
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 // Setmingasprices: 返回在应用程序上设置最低天然气价格的选项.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
@@ -30,6 +52,20 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetHaltHeight returns an option that sets the height after which the node
+// shuts down once the block is committed.
+func SetHaltHeight(height uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.SetHaltHeight(height) }
+}
+
+// SetSnapshot returns an option that takes a snapshot of the multistore into
+// the given snapshot store every interval heights, keeping keepRecent
+// snapshots. A zero interval disables snapshots and a zero keepRecent keeps
+// all of them.
+func SetSnapshot(snapshotStore *snapshots.Store, interval uint64, keepRecent uint32) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.SetSnapshot(snapshotStore, interval, keepRecent) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -95,6 +131,34 @@ func (app *BaseApp) SetIDPeerFilter(pf sdk.PeerFilter) {
 	app.idPeerFilter = pf
 }
 
+// SetHaltHeight sets the height after which the node shuts down once the
+// block is committed. Unlike the other setters it may be called on a sealed
+// BaseApp, so that modules can schedule a halt at runtime (e.g. x/upgrade).
+// Zero is ignored and a halt height already in place is only ever lowered.
+func (app *BaseApp) SetHaltHeight(height uint64) {
+	if height == 0 {
+		return
+	}
+	if app.haltHeight == 0 || height < app.haltHeight {
+		app.haltHeight = height
+	}
+}
+
+// SetSnapshot sets the snapshot store and the interval, in heights, at which
+// snapshots are taken. See the SetSnapshot option.
+func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, interval uint64, keepRecent uint32) {
+	if app.sealed {
+		panic("SetSnapshot() on sealed BaseApp")
+	}
+	if interval > 0 && snapshotStore == nil {
+		panic("snapshot interval set without a snapshot store")
+	}
+	app.snapshotStore = snapshotStore
+	app.snapshotInterval = interval
+	app.snapshotKeepRecent = keepRecent
+	app.snapshotting = make(chan struct{}, 1)
+}
+
 func (app *BaseApp) SetFauxMerkleMode() {
 	if app.sealed {
 		panic("SetFauxMerkleMode() on sealed BaseApp")
@@ -1,6 +1,7 @@
 package context
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "my-cosmos/cosmos-sdk/types"
@@ -48,15 +49,53 @@ func (ctx CLIContext) QueryStore(key cmn.HexBytes, storeName string) (res []byte
 }
 
 // QuerySubspace performs a query from a Tendermint node with the provided
-// store name and subspace.
+// store name and subspace. It fetches every page of the subspace, see
+// QuerySubspacePage.
 func (ctx CLIContext) QuerySubspace(subspace []byte, storeName string) (res []sdk.KVPair, err error) {
-	resRaw, err := ctx.queryStore(subspace, storeName, "subspace")
+	var startKey []byte
+	for {
+		kvs, nextKey, err := ctx.QuerySubspacePage(subspace, startKey, 0, storeName)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, kvs...)
+		if len(nextKey) == 0 {
+			return res, nil
+		}
+		startKey = nextKey
+	}
+}
+
+// QuerySubspacePage performs a query from a Tendermint node with the provided
+// store name and subspace. It returns at most limit key/value pairs from the
+// start key on, and the start key of the next page, which is empty once the
+// end of the subspace is reached. Results from untrusted nodes are verified
+// to hold every key of the range.
+func (ctx CLIContext) QuerySubspacePage(
+	subspace, startKey []byte, limit uint64, storeName string,
+) (res []sdk.KVPair, nextKey []byte, err error) {
+
+	params := sdk.NewQuerySubspaceParams(subspace, startKey, limit)
+	bz, err := ctx.Codec.MarshalBinaryLengthPrefixed(params)
 	if err != nil {
-		return res, err
+		return nil, nil, err
+	}
+
+	resRaw, err := ctx.queryStore(bz, storeName, "subspace")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result sdk.QuerySubspaceResult
+	if err := ctx.Codec.UnmarshalBinaryLengthPrefixed(resRaw, &result); err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(result.Prefix, subspace) || !bytes.Equal(result.StartKey, startKey) {
+		return nil, nil, errors.New("subspace query result does not match the query")
 	}
 
-	ctx.Codec.MustUnmarshalBinaryLengthPrefixed(resRaw, &res)
-	return
+	return result.KVs, result.NextKey, nil
 }
 
 // GetAccount queries for an account given an address and a block height. An
@@ -167,7 +206,7 @@ func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, err erro
 		return res, errors.New(resp.Log)
 	}
 
-	// data from trusted node or subspace query doesn't need verification
+	// data from trusted node or queries without a proof don't need verification
 	if ctx.TrustNode || !isQueryStoreWithProof(path) {
 		return resp.Value, nil
 	}
@@ -241,7 +280,8 @@ func (ctx CLIContext) queryStore(key cmn.HexBytes, storeName, endPath string) ([
 }
 
 // isQueryStoreWithProof expects a format like /<queryType>/<storeName>/<subpath>
-// queryType must be "store" and subpath must be "key" to require a proof.
+// queryType must be "store" and subpath must be "key" or "subspace" to require
+// a proof.
 func isQueryStoreWithProof(path string) bool {
 	if !strings.HasPrefix(path, "/") {
 		return false
@@ -260,7 +300,8 @@ func isQueryStoreWithProof(path string) bool {
 	return false
 }
 
-// parseQueryStorePath expects a format like /store/<storeName>/key.
+// parseQueryStorePath expects a format like /store/<storeName>/key or
+// /store/<storeName>/subspace.
 func parseQueryStorePath(path string) (storeName string, err error) {
 	if !strings.HasPrefix(path, "/") {
 		return "", errors.New("expected path to start with /")
@@ -272,7 +313,7 @@ func parseQueryStorePath(path string) (storeName string, err error) {
 		return "", errors.New("expected format like /store/<storeName>/key")
 	case paths[0] != "store":
 		return "", errors.New("expected format like /store/<storeName>/key")
-	case paths[2] != "key":
+	case paths[2] != "key" && paths[2] != "subspace":
 		return "", errors.New("expected format like /store/<storeName>/key")
 	}
 
@@ -36,16 +36,26 @@ import (
 	"my-cosmos/cosmos-sdk/x/auth"
 	authrest "my-cosmos/cosmos-sdk/x/auth/client/rest"
 	txbuilder "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	authzrest "my-cosmos/cosmos-sdk/x/authz/client/rest"
 	bankrest "my-cosmos/cosmos-sdk/x/bank/client/rest"
+	circuitclient "my-cosmos/cosmos-sdk/x/circuit/client"
+	circuitrest "my-cosmos/cosmos-sdk/x/circuit/client/rest"
 	distr "my-cosmos/cosmos-sdk/x/distribution"
+	distrclient "my-cosmos/cosmos-sdk/x/distribution/client"
 	distrrest "my-cosmos/cosmos-sdk/x/distribution/client/rest"
+	evidencerest "my-cosmos/cosmos-sdk/x/evidence/client/rest"
+	feegrantrest "my-cosmos/cosmos-sdk/x/feegrant/client/rest"
 	"my-cosmos/cosmos-sdk/x/gov"
 	govrest "my-cosmos/cosmos-sdk/x/gov/client/rest"
 	gcutils "my-cosmos/cosmos-sdk/x/gov/client/utils"
+	ibcrest "my-cosmos/cosmos-sdk/x/ibc/client/rest"
+	paramsclient "my-cosmos/cosmos-sdk/x/params/client"
 	"my-cosmos/cosmos-sdk/x/slashing"
 	slashingrest "my-cosmos/cosmos-sdk/x/slashing/client/rest"
 	"my-cosmos/cosmos-sdk/x/staking"
 	stakingrest "my-cosmos/cosmos-sdk/x/staking/client/rest"
+	supplyrest "my-cosmos/cosmos-sdk/x/supply/client/rest"
+	upgradeclient "my-cosmos/cosmos-sdk/x/upgrade/client"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmcfg "github.com/tendermint/tendermint/config"
@@ -404,10 +414,21 @@ func registerRoutes(rs *RestServer) {
 	tx.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 	authrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, auth.StoreKey)
 	bankrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
+	ibcrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	distrrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, distr.StoreKey)
 	stakingrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	slashingrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
-	govrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	supplyrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	feegrantrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	authzrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	evidencerest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	circuitrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	govrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, []govrest.ProposalRESTHandler{
+		paramsclient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+		upgradeclient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+		distrclient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+		circuitclient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+	})
 }
 
 // Request makes a test LCD test request. It returns a response object and a
@@ -18,13 +18,20 @@ import (
 	"my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/authz"
 	"my-cosmos/cosmos-sdk/x/bank"
+	"my-cosmos/cosmos-sdk/x/circuit"
 	distr "my-cosmos/cosmos-sdk/x/distribution"
+	"my-cosmos/cosmos-sdk/x/evidence"
+	"my-cosmos/cosmos-sdk/x/feegrant"
 	"my-cosmos/cosmos-sdk/x/gov"
+	"my-cosmos/cosmos-sdk/x/ibc"
 	"my-cosmos/cosmos-sdk/x/mint"
 	"my-cosmos/cosmos-sdk/x/params"
 	"my-cosmos/cosmos-sdk/x/slashing"
 	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/supply"
+	"my-cosmos/cosmos-sdk/x/upgrade"
 )
 
 const (
@@ -37,6 +44,15 @@ const (
 var (
 	DefaultCLIHome  = os.ExpandEnv("$HOME/.gaiacli")
 	DefaultNodeHome = os.ExpandEnv("$HOME/.gaiad")
+
+	// 各模块账户及其权限，只有登记在这里的模块才能持有资金
+	maccPerms = map[string][]string{
+		auth.FeeCollectorName: nil,
+		distr.ModuleName:      nil,
+		mint.ModuleName:       {auth.Minter},
+		ibc.ModuleName:        {auth.Minter, auth.Burner},
+		staking.ModuleName:    {auth.Minter, auth.Burner},
+	}
 )
 
 // Extended ABCI application
@@ -45,18 +61,24 @@ type GaiaApp struct {
 	cdc *codec.Codec
 
 	// keys to access the substores
-	keyMain          *sdk.KVStoreKey
-	keyAccount       *sdk.KVStoreKey
-	keyStaking       *sdk.KVStoreKey
-	tkeyStaking      *sdk.TransientStoreKey
-	keySlashing      *sdk.KVStoreKey
-	keyMint          *sdk.KVStoreKey
-	keyDistr         *sdk.KVStoreKey
-	tkeyDistr        *sdk.TransientStoreKey
-	keyGov           *sdk.KVStoreKey
-	keyFeeCollection *sdk.KVStoreKey
-	keyParams        *sdk.KVStoreKey
-	tkeyParams       *sdk.TransientStoreKey
+	keyMain     *sdk.KVStoreKey
+	keyAccount  *sdk.KVStoreKey
+	keyStaking  *sdk.KVStoreKey
+	tkeyStaking *sdk.TransientStoreKey
+	keySlashing *sdk.KVStoreKey
+	keyMint     *sdk.KVStoreKey
+	keyDistr    *sdk.KVStoreKey
+	tkeyDistr   *sdk.TransientStoreKey
+	keyGov      *sdk.KVStoreKey
+	keyUpgrade  *sdk.KVStoreKey
+	keyIBC      *sdk.KVStoreKey
+	keySupply   *sdk.KVStoreKey
+	keyFeeGrant *sdk.KVStoreKey
+	keyAuthz    *sdk.KVStoreKey
+	keyEvidence *sdk.KVStoreKey
+	keyCircuit  *sdk.KVStoreKey
+	keyParams   *sdk.KVStoreKey
+	tkeyParams  *sdk.TransientStoreKey
 
 	// Manage getting and setting accounts
 	accountKeeper       auth.AccountKeeper
@@ -67,6 +89,13 @@ type GaiaApp struct {
 	mintKeeper          mint.Keeper
 	distrKeeper         distr.Keeper
 	govKeeper           gov.Keeper
+	upgradeKeeper       upgrade.Keeper
+	ibcKeeper           ibc.Keeper
+	supplyKeeper        supply.Keeper
+	feeGrantKeeper      feegrant.Keeper
+	authzKeeper         authz.Keeper
+	evidenceKeeper      evidence.Keeper
+	circuitKeeper       circuit.Keeper
 	paramsKeeper        params.Keeper
 }
 
@@ -95,18 +124,24 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		cdc:              cdc,
 
 		// 各种 key ？？
-		keyMain:          sdk.NewKVStoreKey(bam.MainStoreKey),
-		keyAccount:       sdk.NewKVStoreKey(auth.StoreKey),
-		keyStaking:       sdk.NewKVStoreKey(staking.StoreKey),
-		tkeyStaking:      sdk.NewTransientStoreKey(staking.TStoreKey),
-		keyMint:          sdk.NewKVStoreKey(mint.StoreKey),
-		keyDistr:         sdk.NewKVStoreKey(distr.StoreKey),
-		tkeyDistr:        sdk.NewTransientStoreKey(distr.TStoreKey),
-		keySlashing:      sdk.NewKVStoreKey(slashing.StoreKey),
-		keyGov:           sdk.NewKVStoreKey(gov.StoreKey),
-		keyFeeCollection: sdk.NewKVStoreKey(auth.FeeStoreKey),
-		keyParams:        sdk.NewKVStoreKey(params.StoreKey),
-		tkeyParams:       sdk.NewTransientStoreKey(params.TStoreKey),
+		keyMain:     sdk.NewKVStoreKey(bam.MainStoreKey),
+		keyAccount:  sdk.NewKVStoreKey(auth.StoreKey),
+		keyStaking:  sdk.NewKVStoreKey(staking.StoreKey),
+		tkeyStaking: sdk.NewTransientStoreKey(staking.TStoreKey),
+		keyMint:     sdk.NewKVStoreKey(mint.StoreKey),
+		keyDistr:    sdk.NewKVStoreKey(distr.StoreKey),
+		tkeyDistr:   sdk.NewTransientStoreKey(distr.TStoreKey),
+		keySlashing: sdk.NewKVStoreKey(slashing.StoreKey),
+		keyGov:      sdk.NewKVStoreKey(gov.StoreKey),
+		keyUpgrade:  sdk.NewKVStoreKey(upgrade.StoreKey),
+		keyIBC:      sdk.NewKVStoreKey(ibc.StoreKey),
+		keySupply:   sdk.NewKVStoreKey(supply.StoreKey),
+		keyFeeGrant: sdk.NewKVStoreKey(feegrant.StoreKey),
+		keyAuthz:    sdk.NewKVStoreKey(authz.StoreKey),
+		keyEvidence: sdk.NewKVStoreKey(evidence.StoreKey),
+		keyCircuit:  sdk.NewKVStoreKey(circuit.StoreKey),
+		keyParams:   sdk.NewKVStoreKey(params.StoreKey),
+		tkeyParams:  sdk.NewTransientStoreKey(params.TStoreKey),
 	}
 
 	/**
@@ -138,10 +173,15 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		bank.DefaultCodespace,
 	)
 	// FeeCollectionKeeper处理anteHandler中的费用收集和不同费用令牌的MinFees设置
-	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(
-		app.cdc,
-		app.keyFeeCollection,
-	)
+	// 收取的手续费存放在 fee_collector 模块账户中
+	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.accountKeeper)
+
+	// 记录各币种的总供应量，铸币、销毁和跨链凭证都会更新它
+	// 同时管理各模块账户，模块之间的资金转移都经过它
+	app.supplyKeeper = supply.NewKeeper(app.cdc, app.keySupply, app.accountKeeper, app.bankKeeper, maccPerms)
+
+	// 手续费授权，授权人可以在额度内替被授权人支付交易手续费
+	app.feeGrantKeeper = feegrant.NewKeeper(app.cdc, app.keyFeeGrant, app.accountKeeper)
 
 	/**
 	################
@@ -153,14 +193,14 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	stakingKeeper := staking.NewKeeper(
 		app.cdc,
 		app.keyStaking, app.tkeyStaking,
-		app.bankKeeper, app.paramsKeeper.Subspace(staking.DefaultParamspace),
+		app.bankKeeper, app.supplyKeeper, app.paramsKeeper.Subspace(staking.DefaultParamspace),
 		staking.DefaultCodespace,
 	)
 
 	// 旨在实现灵活的通胀率，并在市场流动性和货币供应之间取得平衡
 	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint,
 		app.paramsKeeper.Subspace(mint.DefaultParamspace),
-		&stakingKeeper, app.feeCollectionKeeper,
+		&stakingKeeper, app.supplyKeeper,
 	)
 
 	// 用于分配保税利益相关者的费用和通货膨胀
@@ -169,7 +209,7 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		app.cdc,
 		app.keyDistr,
 		app.paramsKeeper.Subspace(distr.DefaultParamspace),
-		app.bankKeeper, &stakingKeeper, app.feeCollectionKeeper,
+		app.supplyKeeper, &stakingKeeper, app.feeCollectionKeeper,
 		distr.DefaultCodespace,
 	)
 
@@ -181,18 +221,49 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		slashing.DefaultCodespace,
 	)
 
+	// 软件升级管理器，未注册升级处理函数的节点会在升级高度的前一个区块提交后停止
+	upgradeKeeper := upgrade.NewKeeper(app.cdc, app.keyUpgrade)
+	app.upgradeKeeper = *upgradeKeeper.SetHaltFunc(func(height int64) {
+		app.SetHaltHeight(uint64(height))
+	})
+
+	// 熔断器，治理提案或授权账户可以禁用出现问题的消息类型
+	app.circuitKeeper = circuit.NewKeeper(app.cdc, app.keyCircuit)
+
 	/**
 	这个是 链上治理 管理器
 
 	供受保护的利益相关者提出建议并对其进行投票
+	通过的提案按其内容的路由交给对应模块的处理函数执行
 	 */
+	govRouter := gov.NewRouter()
+	govRouter.
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(circuit.RouterKey, circuit.NewCircuitBreakerProposalHandler(app.circuitKeeper))
+
 	app.govKeeper = gov.NewKeeper(
 		app.cdc,
 		app.keyGov,
 		app.paramsKeeper, app.paramsKeeper.Subspace(gov.DefaultParamspace), app.bankKeeper, &stakingKeeper,
-		gov.DefaultCodespace,
+		gov.DefaultCodespace, govRouter,
 	)
 
+	// 跨链通信，托管转出的原生代币并为转入的代币铸造凭证
+	app.ibcKeeper = ibc.NewKeeper(app.cdc, app.keyIBC, ibc.DefaultCodespace)
+
+	// 消息授权，被授权人可以代替授权人执行指定类型的消息，消息经由下面注册的路由分发
+	app.authzKeeper = authz.NewKeeper(app.cdc, app.keyAuthz, app.Router())
+
+	// 不当行为的证据，按证据的路由交给对应模块的处理函数惩罚，双签证据由惩罚模块处理
+	evidenceRouter := evidence.NewRouter()
+	evidenceRouter.
+		AddRoute(evidence.RouteEquivocation, slashing.NewEquivocationHandler(app.slashingKeeper))
+
+	app.evidenceKeeper = evidence.NewKeeper(app.cdc, app.keyEvidence, evidenceRouter)
+
 	// register the staking hooks
 	// NOTE: The stakingKeeper above is passed by reference, so that it can be
 	// modified like below:
@@ -224,7 +295,18 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		AddRoute(slashing.RouterKey, slashing.NewHandler(app.slashingKeeper)).
 
 		// 链上治理相关
-		AddRoute(gov.RouterKey, gov.NewHandler(app.govKeeper))
+		AddRoute(gov.RouterKey, gov.NewHandler(app.govKeeper)).
+
+		// 跨链通信
+		AddRoute(ibc.RouterKey, ibc.NewHandler(app.ibcKeeper, app.bankKeeper, app.supplyKeeper)).
+
+		// 手续费授权
+		AddRoute(feegrant.RouterKey, feegrant.NewHandler(app.feeGrantKeeper)).
+
+		// 消息授权
+		AddRoute(authz.RouterKey, authz.NewHandler(app.authzKeeper)).
+		AddRoute(evidence.RouterKey, evidence.NewHandler(app.evidenceKeeper)).
+		AddRoute(circuit.RouterKey, circuit.NewHandler(app.circuitKeeper))
 
 
 	app.QueryRouter().
@@ -233,6 +315,12 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 
 		// 链上治理相关
 		AddRoute(gov.QuerierRoute, gov.NewQuerier(app.govKeeper)).
+		AddRoute(upgrade.QuerierRoute, upgrade.NewQuerier(app.upgradeKeeper)).
+		AddRoute(supply.QuerierRoute, supply.NewQuerier(app.supplyKeeper)).
+		AddRoute(feegrant.QuerierRoute, feegrant.NewQuerier(app.feeGrantKeeper)).
+		AddRoute(authz.QuerierRoute, authz.NewQuerier(app.authzKeeper)).
+		AddRoute(evidence.QuerierRoute, evidence.NewQuerier(app.evidenceKeeper)).
+		AddRoute(circuit.QuerierRoute, circuit.NewQuerier(app.circuitKeeper)).
 		AddRoute(slashing.QuerierRoute, slashing.NewQuerier(app.slashingKeeper, app.cdc)).
 
 		// 经济模型相关
@@ -244,8 +332,8 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	 */
 	// 从KV数据库加载相关数据--在当前版本中，IVAL存储是KVStore基础的实现
 	app.MountStores(app.keyMain, app.keyAccount, app.keyStaking, app.keyMint, app.keyDistr,
-		app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams,
-		app.tkeyParams, app.tkeyStaking, app.tkeyDistr,
+		app.keySlashing, app.keyGov, app.keyUpgrade, app.keyIBC, app.keySupply, app.keyFeeGrant,
+		app.keyAuthz, app.keyEvidence, app.keyCircuit, app.keyParams, app.tkeyParams, app.tkeyStaking, app.tkeyDistr,
 	)
 
 	/**
@@ -261,7 +349,10 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 
 	// 设置一个 账户及外部token等等的 auth相关的 func
 	// 设置权限控制句柄
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
+	// 交易手续费可以由授权人从其授予的额度中支付
+	// 包含被熔断器禁用的消息的交易在进入内存池前就被拒绝
+	app.SetAnteHandler(circuit.NewAnteHandler(app.circuitKeeper,
+		auth.NewAnteHandlerWithFeeGrants(app.accountKeeper, app.feeCollectionKeeper, app.feeGrantKeeper)))
 
 	// TODO 重要   关于诶个block 执行之后的 验证人信息变更全部在这里了 和tendermint交互的
 	// 设置一个 执行 block中tx之后调用的 func
@@ -292,6 +383,13 @@ func MakeCodec() *codec.Codec {
 	distr.RegisterCodec(cdc)
 	slashing.RegisterCodec(cdc)
 	gov.RegisterCodec(cdc)
+	params.RegisterCodec(cdc)
+	upgrade.RegisterCodec(cdc)
+	ibc.RegisterCodec(cdc)
+	feegrant.RegisterCodec(cdc)
+	authz.RegisterCodec(cdc)
+	evidence.RegisterCodec(cdc)
+	circuit.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -309,6 +407,9 @@ func MakeCodec() *codec.Codec {
 TODO 这个由 tendermint 在处理每个块之前 回调cosmos 做的 rpc 交互
  */
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// apply any scheduled software upgrade before the other modules run
+	upgrade.BeginBlocker(ctx, app.upgradeKeeper)
+
 	// mint new tokens for the previous block
 	//
 	mint.BeginBlocker(ctx, app.mintKeeper)
@@ -333,6 +434,9 @@ func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) ab
 	// 在执行区块前 开始惩罚检查
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
 
+	// 提交 tendermint 发现的双签证据，由惩罚模块处理
+	evidence.BeginBlocker(ctx, req, app.evidenceKeeper)
+
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
 	}
@@ -350,6 +454,9 @@ func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.R
 	// 先调一波链上治理的接口
 	tags := gov.EndBlocker(ctx, app.govKeeper)
 
+	// compound the rewards before the validator set updates are computed
+	distr.EndBlocker(ctx, app.distrKeeper)
+
 	// TODO staking 的验证人变更的逻辑在这里哦
 	validatorUpdates, endBlockerTags := staking.EndBlocker(ctx, app.stakingKeeper)
 	tags = append(tags, endBlockerTags...)
@@ -384,11 +491,16 @@ func (app *GaiaApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisSt
 	}
 
 	// initialize module-specific stores
-	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
+	auth.InitGenesis(ctx, app.accountKeeper, genesisState.AuthData)
 	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankData)
 	slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.SlashingData, genesisState.StakingData.Validators.ToSDKValidators())
 	gov.InitGenesis(ctx, app.govKeeper, genesisState.GovData)
 	mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
+	supply.InitGenesis(ctx, app.supplyKeeper, genesisState.SupplyData)
+	feegrant.InitGenesis(ctx, app.feeGrantKeeper, genesisState.FeeGrantData)
+	authz.InitGenesis(ctx, app.authzKeeper, genesisState.AuthzData)
+	evidence.InitGenesis(ctx, app.evidenceKeeper, genesisState.EvidenceData)
+	circuit.InitGenesis(ctx, app.circuitKeeper, genesisState.CircuitData)
 
 	// validate genesis state
 	if err := GaiaValidateGenesisState(genesisState); err != nil {
@@ -421,6 +533,11 @@ func (app *GaiaApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisSt
 		*/
 		validators = app.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	}
+
+	// genesis files without a supply start with the coins they hand out
+	if genesisState.SupplyData.Supply.Empty() {
+		app.supplyKeeper.SetTotalSupply(ctx, app.genesisSupply(ctx))
+	}
 	return validators
 }
 
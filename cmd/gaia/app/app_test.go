@@ -12,11 +12,16 @@ import (
 
 	"my-cosmos/cosmos-sdk/codec"
 	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/authz"
+	"my-cosmos/cosmos-sdk/x/circuit"
 	distr "my-cosmos/cosmos-sdk/x/distribution"
+	"my-cosmos/cosmos-sdk/x/evidence"
+	"my-cosmos/cosmos-sdk/x/feegrant"
 	"my-cosmos/cosmos-sdk/x/gov"
 	"my-cosmos/cosmos-sdk/x/mint"
 	"my-cosmos/cosmos-sdk/x/slashing"
 	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/supply"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -36,6 +41,11 @@ func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
 		distr.DefaultGenesisState(),
 		gov.DefaultGenesisState(),
 		slashing.DefaultGenesisState(),
+		supply.DefaultGenesisState(),
+		feegrant.DefaultGenesisState(),
+		authz.DefaultGenesisState(),
+		evidence.DefaultGenesisState(),
+		circuit.DefaultGenesisState(),
 	)
 
 	stateBytes, err := codec.MarshalJSONIndent(gapp.cdc, genesisState)
@@ -10,12 +10,17 @@ import (
 	"my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/authz"
 	"my-cosmos/cosmos-sdk/x/bank"
+	"my-cosmos/cosmos-sdk/x/circuit"
 	distr "my-cosmos/cosmos-sdk/x/distribution"
+	"my-cosmos/cosmos-sdk/x/evidence"
+	"my-cosmos/cosmos-sdk/x/feegrant"
 	"my-cosmos/cosmos-sdk/x/gov"
 	"my-cosmos/cosmos-sdk/x/mint"
 	"my-cosmos/cosmos-sdk/x/slashing"
 	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/supply"
 )
 
 // export the state of gaia for a genesis file
@@ -40,13 +45,18 @@ func (app *GaiaApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteLis
 
 	genState := NewGenesisState(
 		accounts,
-		auth.ExportGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper),
+		auth.ExportGenesis(ctx, app.accountKeeper),
 		bank.ExportGenesis(ctx, app.bankKeeper),
 		staking.ExportGenesis(ctx, app.stakingKeeper),
 		mint.ExportGenesis(ctx, app.mintKeeper),
 		distr.ExportGenesis(ctx, app.distrKeeper),
 		gov.ExportGenesis(ctx, app.govKeeper),
 		slashing.ExportGenesis(ctx, app.slashingKeeper),
+		supply.ExportGenesis(ctx, app.supplyKeeper),
+		feegrant.ExportGenesis(ctx, app.feeGrantKeeper),
+		authz.ExportGenesis(ctx, app.authzKeeper),
+		evidence.ExportGenesis(ctx, app.evidenceKeeper),
+		circuit.ExportGenesis(ctx, app.circuitKeeper),
 	)
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
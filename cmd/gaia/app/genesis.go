@@ -16,12 +16,17 @@ import (
 	"my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/authz"
 	"my-cosmos/cosmos-sdk/x/bank"
+	"my-cosmos/cosmos-sdk/x/circuit"
 	distr "my-cosmos/cosmos-sdk/x/distribution"
+	"my-cosmos/cosmos-sdk/x/evidence"
+	"my-cosmos/cosmos-sdk/x/feegrant"
 	"my-cosmos/cosmos-sdk/x/gov"
 	"my-cosmos/cosmos-sdk/x/mint"
 	"my-cosmos/cosmos-sdk/x/slashing"
 	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/supply"
 )
 
 var (
@@ -40,6 +45,11 @@ type GenesisState struct {
 	DistrData    distr.GenesisState    `json:"distr"`
 	GovData      gov.GenesisState      `json:"gov"`
 	SlashingData slashing.GenesisState `json:"slashing"`
+	SupplyData   supply.GenesisState   `json:"supply"`
+	FeeGrantData feegrant.GenesisState `json:"feegrant"`
+	AuthzData    authz.GenesisState    `json:"authz"`
+	EvidenceData evidence.GenesisState `json:"evidence"`
+	CircuitData  circuit.GenesisState  `json:"circuit"`
 	GenTxs       []json.RawMessage     `json:"gentxs"`
 }
 
@@ -47,7 +57,9 @@ func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
 	bankData bank.GenesisState,
 	stakingData staking.GenesisState, mintData mint.GenesisState,
 	distrData distr.GenesisState, govData gov.GenesisState,
-	slashingData slashing.GenesisState) GenesisState {
+	slashingData slashing.GenesisState, supplyData supply.GenesisState,
+	feeGrantData feegrant.GenesisState, authzData authz.GenesisState,
+	evidenceData evidence.GenesisState, circuitData circuit.GenesisState) GenesisState {
 
 	return GenesisState{
 		Accounts:     accounts,
@@ -58,6 +70,11 @@ func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
 		DistrData:    distrData,
 		GovData:      govData,
 		SlashingData: slashingData,
+		SupplyData:   supplyData,
+		FeeGrantData: feeGrantData,
+		AuthzData:    authzData,
+		EvidenceData: evidenceData,
+		CircuitData:  circuitData,
 	}
 }
 
@@ -85,6 +102,13 @@ type GenesisAccount struct {
 	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // delegated vesting coins at time of delegation
 	StartTime        int64     `json:"start_time"`        // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time"`          // vesting end time (UNIX Epoch time)
+
+	// periodic vesting account fields
+	VestingPeriods auth.Periods `json:"vesting_periods"` // vesting schedule, empty unless the account vests periodically
+
+	// module account fields
+	ModuleName        string   `json:"module_name"`        // name of the module owning the account
+	ModulePermissions []string `json:"module_permissions"` // permissions of the module account
 }
 
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
@@ -113,6 +137,17 @@ func NewGenesisAccountI(acc auth.Account) GenesisAccount {
 		gacc.EndTime = vacc.GetEndTime()
 	}
 
+	pvacc, ok := acc.(*auth.PeriodicVestingAccount)
+	if ok {
+		gacc.VestingPeriods = pvacc.GetVestingPeriods()
+	}
+
+	macc, ok := acc.(*auth.ModuleAccount)
+	if ok {
+		gacc.ModuleName = macc.GetName()
+		gacc.ModulePermissions = macc.GetPermissions()
+	}
+
 	return gacc
 }
 
@@ -125,6 +160,14 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 		Sequence:      ga.Sequence,
 	}
 
+	if ga.ModuleName != "" {
+		return &auth.ModuleAccount{
+			BaseAccount: bacc,
+			Name:        ga.ModuleName,
+			Permissions: ga.ModulePermissions,
+		}
+	}
+
 	if !ga.OriginalVesting.IsZero() {
 		baseVestingAcc := &auth.BaseVestingAccount{
 			BaseAccount:      bacc,
@@ -134,7 +177,13 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 			EndTime:          ga.EndTime,
 		}
 
-		if ga.StartTime != 0 && ga.EndTime != 0 {
+		if len(ga.VestingPeriods) > 0 {
+			return &auth.PeriodicVestingAccount{
+				BaseVestingAccount: baseVestingAcc,
+				StartTime:          ga.StartTime,
+				VestingPeriods:     ga.VestingPeriods,
+			}
+		} else if ga.StartTime != 0 && ga.EndTime != 0 {
 			return &auth.ContinuousVestingAccount{
 				BaseVestingAccount: baseVestingAcc,
 				StartTime:          ga.StartTime,
@@ -210,6 +259,11 @@ func NewDefaultGenesisState() GenesisState {
 		DistrData:    distr.DefaultGenesisState(),
 		GovData:      gov.DefaultGenesisState(),
 		SlashingData: slashing.DefaultGenesisState(),
+		SupplyData:   supply.DefaultGenesisState(),
+		FeeGrantData: feegrant.DefaultGenesisState(),
+		AuthzData:    authz.DefaultGenesisState(),
+		EvidenceData: evidence.DefaultGenesisState(),
+		CircuitData:  circuit.DefaultGenesisState(),
 		GenTxs:       nil,
 	}
 }
@@ -246,6 +300,21 @@ func GaiaValidateGenesisState(genesisState GenesisState) error {
 	if err := gov.ValidateGenesis(genesisState.GovData); err != nil {
 		return err
 	}
+	if err := supply.ValidateGenesis(genesisState.SupplyData); err != nil {
+		return err
+	}
+	if err := feegrant.ValidateGenesis(genesisState.FeeGrantData); err != nil {
+		return err
+	}
+	if err := authz.ValidateGenesis(genesisState.AuthzData); err != nil {
+		return err
+	}
+	if err := evidence.ValidateGenesis(genesisState.EvidenceData); err != nil {
+		return err
+	}
+	if err := circuit.ValidateGenesis(genesisState.CircuitData); err != nil {
+		return err
+	}
 
 	return slashing.ValidateGenesis(genesisState.SlashingData)
 }
@@ -263,6 +332,16 @@ func validateGenesisStateAccounts(accs []GenesisAccount) error {
 			return fmt.Errorf("duplicate account found in genesis state; address: %s", addrStr)
 		}
 
+		// module accounts must live at the address derived from their name
+		if acc.ModuleName != "" {
+			if !acc.Address.Equals(auth.NewModuleAddress(acc.ModuleName)) {
+				return fmt.Errorf("invalid address for module account %s; address: %s", acc.ModuleName, addrStr)
+			}
+			if !acc.OriginalVesting.IsZero() {
+				return fmt.Errorf("module account %s cannot be a vesting account", acc.ModuleName)
+			}
+		}
+
 		// validate any vesting fields
 		if !acc.OriginalVesting.IsZero() {
 			if acc.EndTime == 0 {
@@ -277,6 +356,12 @@ func validateGenesisStateAccounts(accs []GenesisAccount) error {
 					time.Unix(acc.EndTime, 0).UTC().Format(time.RFC3339),
 				)
 			}
+
+			if len(acc.VestingPeriods) > 0 {
+				if err := validateVestingPeriods(acc); err != nil {
+					return err
+				}
+			}
 		}
 
 		addrMap[addrStr] = true
@@ -285,6 +370,33 @@ func validateGenesisStateAccounts(accs []GenesisAccount) error {
 	return nil
 }
 
+// validateVestingPeriods ensures that the schedule of a periodic vesting
+// account vests exactly its original vesting coins and ends at its end time.
+func validateVestingPeriods(acc GenesisAccount) error {
+	addrStr := acc.Address.String()
+
+	for _, period := range acc.VestingPeriods {
+		if period.Length <= 0 {
+			return fmt.Errorf("vesting period length must be positive; address: %s", addrStr)
+		}
+		if !period.Amount.IsValid() {
+			return fmt.Errorf("invalid vesting period amount %s; address: %s", period.Amount, addrStr)
+		}
+	}
+
+	if !acc.VestingPeriods.TotalAmount().IsEqual(acc.OriginalVesting) {
+		return fmt.Errorf(
+			"vesting periods must vest the original vesting coins; address: %s, periods: %s, original vesting: %s",
+			addrStr, acc.VestingPeriods.TotalAmount(), acc.OriginalVesting,
+		)
+	}
+	if acc.StartTime+acc.VestingPeriods.TotalLength() != acc.EndTime {
+		return fmt.Errorf("vesting periods must end at the vesting end time; address: %s", addrStr)
+	}
+
+	return nil
+}
+
 // GaiaAppGenState but with JSON
 func GaiaAppGenStateJSON(cdc *codec.Codec, genDoc tmtypes.GenesisDoc, appGenTxs []json.RawMessage) (
 	appState json.RawMessage, err error) {
@@ -68,6 +68,33 @@ func TestToAccount(t *testing.T) {
 	acc = genAcc.ToAccount()
 	require.IsType(t, &auth.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*auth.ContinuousVestingAccount))
+
+	authAcc.SetCoins(sdk.Coins{sdk.NewInt64Coin(defaultBondDenom, 150)})
+	pvacc := auth.NewPeriodicVestingAccount(&authAcc, time.Now().Unix(), auth.Periods{
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(defaultBondDenom, 100)}},
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(defaultBondDenom, 50)}},
+	})
+	genAcc = NewGenesisAccountI(pvacc)
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pvacc, acc.(*auth.PeriodicVestingAccount))
+	require.NoError(t, validateGenesisStateAccounts([]GenesisAccount{genAcc}))
+
+	// the schedule must vest the original vesting coins
+	genAcc.OriginalVesting = sdk.Coins{sdk.NewInt64Coin(defaultBondDenom, 100)}
+	require.Error(t, validateGenesisStateAccounts([]GenesisAccount{genAcc}))
+
+	macc := auth.NewEmptyModuleAccount("mint", auth.Minter)
+	require.NoError(t, macc.SetCoins(sdk.Coins{sdk.NewInt64Coin(defaultBondDenom, 10)}))
+	genAcc = NewGenesisAccountI(macc)
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.ModuleAccount{}, acc)
+	require.Equal(t, macc, acc.(*auth.ModuleAccount))
+	require.NoError(t, validateGenesisStateAccounts([]GenesisAccount{genAcc}))
+
+	// a module account must live at the address derived from its name
+	genAcc.Address = addr
+	require.Error(t, validateGenesisStateAccounts([]GenesisAccount{genAcc}))
 }
 
 func TestGaiaAppGenTx(t *testing.T) {
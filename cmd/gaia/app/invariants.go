@@ -7,20 +7,64 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
 	banksim "my-cosmos/cosmos-sdk/x/bank/simulation"
 	distrsim "my-cosmos/cosmos-sdk/x/distribution/simulation"
+	ibcsim "my-cosmos/cosmos-sdk/x/ibc/simulation"
+	mintsim "my-cosmos/cosmos-sdk/x/mint/simulation"
+	"my-cosmos/cosmos-sdk/x/staking"
 	stakingsim "my-cosmos/cosmos-sdk/x/staking/simulation"
 )
 
 func (app *GaiaApp) runtimeInvariants() []sdk.Invariant {
 	return []sdk.Invariant{
 		banksim.NonnegativeBalanceInvariant(app.accountKeeper),
+		banksim.TotalSupplyInvariant(app.accountKeeper, app.supplyKeeper, app.heldCoins),
+		mintsim.SupplyInvariant(app.mintKeeper, app.stakingKeeper, app.supplyKeeper),
 		distrsim.NonNegativeOutstandingInvariant(app.distrKeeper),
-		stakingsim.SupplyInvariants(app.stakingKeeper, app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper),
+		distrsim.ModuleAccountInvariant(app.distrKeeper, app.accountKeeper),
+		stakingsim.SupplyInvariants(app.stakingKeeper, app.accountKeeper),
 		stakingsim.NonNegativePowerInvariant(app.stakingKeeper),
+		ibcsim.AllInvariants(app.ibcKeeper, app.accountKeeper),
 	}
 }
 
+// heldCoins returns the coins held outside of accounts: the tokens of
+// validators and unbonding delegations. Collected fees and the pools of
+// distribution are held by module accounts.
+func (app *GaiaApp) heldCoins(ctx sdk.Context) sdk.DecCoins {
+	var held sdk.DecCoins
+
+	staked := sdk.ZeroInt()
+	app.stakingKeeper.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
+		staked = staked.Add(validator.GetTokens())
+		return false
+	})
+	app.stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd staking.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			staked = staked.Add(entry.Balance)
+		}
+		return false
+	})
+	if staked.IsPositive() {
+		held = held.Add(sdk.DecCoins{sdk.NewDecCoin(app.stakingKeeper.BondDenom(ctx), staked)})
+	}
+	return held
+}
+
+// genesisSupply returns all coins held by the accounts and modules, as the
+// supply of a genesis file that does not record one
+func (app *GaiaApp) genesisSupply(ctx sdk.Context) sdk.Coins {
+	total := app.heldCoins(ctx)
+	app.accountKeeper.IterateAccounts(ctx, func(acc auth.Account) bool {
+		total = total.Add(sdk.NewDecCoins(acc.GetCoins()))
+		return false
+	})
+
+	supply, _ := total.TruncateDecimal()
+	return supply
+}
+
 func (app *GaiaApp) assertRuntimeInvariants() {
 	ctx := app.NewContext(false, abci.Header{Height: app.LastBlockHeight() + 1})
 	app.assertRuntimeInvariantsOnContext(ctx)
@@ -29,6 +29,7 @@ import (
 	"my-cosmos/cosmos-sdk/x/gov"
 	govsim "my-cosmos/cosmos-sdk/x/gov/simulation"
 	"my-cosmos/cosmos-sdk/x/mint"
+	mintsim "my-cosmos/cosmos-sdk/x/mint/simulation"
 	"my-cosmos/cosmos-sdk/x/mock/simulation"
 	"my-cosmos/cosmos-sdk/x/slashing"
 	slashingsim "my-cosmos/cosmos-sdk/x/slashing/simulation"
@@ -121,9 +122,16 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 				endTime = randIntBetween(r, int(startTime), int(startTime+(60*60*12)))
 			}
 
-			if r.Intn(100) < 50 {
+			switch length := int64(endTime) - startTime; {
+			case r.Intn(100) < 33:
 				vacc = auth.NewContinuousVestingAccount(&bacc, startTime, int64(endTime))
-			} else {
+			case r.Intn(100) < 50 && length > 1:
+				// vest half of the coins half way through the schedule
+				vacc = auth.NewPeriodicVestingAccount(&bacc, startTime, auth.Periods{
+					{Length: length / 2, Amount: sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount/2))}},
+					{Length: length - length/2, Amount: sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount-amount/2))}},
+				})
+			default:
 				vacc = auth.NewDelayedVestingAccount(&bacc, int64(endTime))
 			}
 
@@ -284,10 +292,12 @@ func testAndRunTxs(app *GaiaApp) []simulation.WeightedOperation {
 func invariants(app *GaiaApp) []sdk.Invariant {
 	return []sdk.Invariant{
 		simulation.PeriodicInvariant(banksim.NonnegativeBalanceInvariant(app.accountKeeper), period, 0),
+		simulation.PeriodicInvariant(banksim.TotalSupplyInvariant(app.accountKeeper, app.supplyKeeper, app.heldCoins), period, 0),
+		simulation.PeriodicInvariant(mintsim.SupplyInvariant(app.mintKeeper, app.stakingKeeper, app.supplyKeeper), period, 0),
 		simulation.PeriodicInvariant(govsim.AllInvariants(), period, 0),
 		simulation.PeriodicInvariant(distrsim.AllInvariants(app.distrKeeper, app.stakingKeeper), period, 0),
-		simulation.PeriodicInvariant(stakingsim.AllInvariants(app.stakingKeeper, app.feeCollectionKeeper,
-			app.distrKeeper, app.accountKeeper), period, 0),
+		simulation.PeriodicInvariant(distrsim.ModuleAccountInvariant(app.distrKeeper, app.accountKeeper), period, 0),
+		simulation.PeriodicInvariant(stakingsim.AllInvariants(app.stakingKeeper, app.accountKeeper), period, 0),
 		simulation.PeriodicInvariant(slashingsim.AllInvariants(), period, 0),
 	}
 }
@@ -450,9 +460,13 @@ func TestGaiaImportExport(t *testing.T) {
 		{app.keySlashing, newApp.keySlashing, [][]byte{}},
 		{app.keyMint, newApp.keyMint, [][]byte{}},
 		{app.keyDistr, newApp.keyDistr, [][]byte{}},
-		{app.keyFeeCollection, newApp.keyFeeCollection, [][]byte{}},
 		{app.keyParams, newApp.keyParams, [][]byte{}},
 		{app.keyGov, newApp.keyGov, [][]byte{}},
+		{app.keyUpgrade, newApp.keyUpgrade, [][]byte{}},
+		{app.keyFeeGrant, newApp.keyFeeGrant, [][]byte{}},
+		{app.keyAuthz, newApp.keyAuthz, [][]byte{}},
+		{app.keyEvidence, newApp.keyEvidence, [][]byte{}},
+		{app.keyCircuit, newApp.keyCircuit, [][]byte{}},
 	}
 	for _, storeKeysPrefix := range storeKeysPrefixes {
 		storeKeyA := storeKeysPrefix.A
@@ -33,6 +33,11 @@ func NewHandler(k bank.Keeper) sdk.Handler {
 		case bank.MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
 
+		case bank.MsgCreateVestingAccount:
+			// creating vesting accounts is a transfer, so the standard handler
+			// rejects it while transfers are disabled
+			return bank.NewHandler(k)(ctx, msg)
+
 		default:
 			errMsg := "Unrecognized bank Msg type: %s" + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -65,6 +70,11 @@ func handleMsgMultiSend(ctx sdk.Context, k bank.Keeper, msg bank.MsgMultiSend) s
 			return bank.ErrSendDisabled(k.Codespace()).Result()
 		}
 	}
+	for _, out := range msg.Outputs {
+		if k.BlockedAddr(ctx, out.Address) {
+			return bank.ErrSendToModuleAccount(k.Codespace(), out.Address).Result()
+		}
+	}
 
 	tags, err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
@@ -22,6 +22,8 @@ import (
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/auth"
 	"my-cosmos/cosmos-sdk/x/gov"
+	"my-cosmos/cosmos-sdk/x/ibc"
+	"my-cosmos/cosmos-sdk/x/ibc/client/relayer"
 )
 
 func TestGaiaCLIKeysAddMultisig(t *testing.T) {
@@ -245,6 +247,10 @@ func TestGaiaCLISend(t *testing.T) {
 	fooAcc = f.QueryAccount(fooAddr)
 	require.Equal(t, startTokens.Sub(sendTokens.MulRaw(3)), fooAcc.GetCoins().AmountOf(denom))
 
+	// Sends move tokens between accounts without changing the total supply
+	supply := f.QuerySupplyTotal()
+	require.Equal(t, startCoins.AmountOf(fooDenom).MulRaw(2), supply.AmountOf(fooDenom))
+
 	f.Cleanup()
 }
 
@@ -463,12 +469,12 @@ func TestGaiaCLISubmitProposal(t *testing.T) {
 
 	// Ensure propsal is directly queryable
 	proposal1 := f.QueryGovProposal(1)
-	require.Equal(t, uint64(1), proposal1.GetProposalID())
-	require.Equal(t, gov.StatusDepositPeriod, proposal1.GetStatus())
+	require.Equal(t, uint64(1), proposal1.ProposalID)
+	require.Equal(t, gov.StatusDepositPeriod, proposal1.Status)
 
 	// Ensure query proposals returns properly
 	proposalsQuery = f.QueryGovProposals()
-	require.Equal(t, uint64(1), proposalsQuery[0].GetProposalID())
+	require.Equal(t, uint64(1), proposalsQuery[0].ProposalID)
 
 	// Query the deposits on the proposal
 	deposit := f.QueryGovDeposit(1, fooAddr)
@@ -507,8 +513,8 @@ func TestGaiaCLISubmitProposal(t *testing.T) {
 
 	// Fetch the proposal and ensure it is now in the voting period
 	proposal1 = f.QueryGovProposal(1)
-	require.Equal(t, uint64(1), proposal1.GetProposalID())
-	require.Equal(t, gov.StatusVotingPeriod, proposal1.GetStatus())
+	require.Equal(t, uint64(1), proposal1.ProposalID)
+	require.Equal(t, gov.StatusVotingPeriod, proposal1.Status)
 
 	// Test vote generate only
 	success, stdout, stderr = f.TxGovVote(1, gov.OptionYes, keyFoo, "--generate-only")
@@ -544,7 +550,7 @@ func TestGaiaCLISubmitProposal(t *testing.T) {
 
 	// Ensure the proposal returns as in the voting period
 	proposalsQuery = f.QueryGovProposals("--status=VotingPeriod")
-	require.Equal(t, uint64(1), proposalsQuery[0].GetProposalID())
+	require.Equal(t, uint64(1), proposalsQuery[0].ProposalID)
 
 	// submit a second test proposal
 	f.TxGovSubmitProposal(keyFoo, "Text", "Apples", "test", sdk.NewCoin(denom, proposalTokens), "-y")
@@ -552,7 +558,7 @@ func TestGaiaCLISubmitProposal(t *testing.T) {
 
 	// Test limit on proposals query
 	proposalsQuery = f.QueryGovProposals("--limit=1")
-	require.Equal(t, uint64(2), proposalsQuery[0].GetProposalID())
+	require.Equal(t, uint64(2), proposalsQuery[0].ProposalID)
 
 	f.Cleanup()
 }
@@ -1081,3 +1087,88 @@ func TestValidateGenesis(t *testing.T) {
 
 	f.ValidateGenesis()
 }
+
+func TestGaiaCLIIBCRelayer(t *testing.T) {
+	t.Parallel()
+	fa := InitFixtures(t)
+	fb := InitFixtures(t)
+
+	// the relayer signs for chain b with a key of chain a's keybase
+	fb.AddGenesisAccount(fa.KeyAddress(keyBar), startCoins)
+
+	// start both gaiad servers
+	procA := fa.GDStart()
+	defer procA.Stop(false)
+	procB := fb.GDStart()
+	defer procB.Stop(false)
+	tests.WaitForNextNBlocksTM(2, fb.Port)
+
+	// every handshake step but the first ones proves the previous one, which
+	// needs the header of the block after it
+	step := func(f, cp *Fixtures, command string, flags ...string) {
+		success, _, _ := f.TxIBC(keyFoo, command, append(flags, "-y")...)
+		require.True(t, success)
+		tests.WaitForNextNBlocksTM(2, f.Port)
+		tests.WaitForNextNBlocksTM(2, cp.Port)
+	}
+	nodeA := fmt.Sprintf("--counterparty-node=%s", fa.RPCAddr)
+	nodeB := fmt.Sprintf("--counterparty-node=%s", fb.RPCAddr)
+	step(fa, fb, "create-client clientb", nodeB)
+	step(fb, fa, "create-client clienta", nodeA)
+	step(fa, fb, "conn-open-init conna clientb connb clienta")
+	step(fb, fa, "conn-open-try connb clienta conna clientb", nodeA)
+	step(fa, fb, "conn-open-ack conna", nodeB)
+	step(fb, fa, "conn-open-confirm connb", nodeA)
+	step(fa, fb, "chan-open-init chana conna chanb")
+	step(fb, fa, "chan-open-try chanb connb chana", nodeA)
+	step(fa, fb, "chan-open-ack chana", nodeB)
+	step(fb, fa, "chan-open-confirm chanb", nodeA)
+
+	stateFile := filepath.Join(fa.GCLIHome, "relayer.json")
+	proc := fa.IBCRelay(fb, keyFoo, keyBar, "chana", stateFile)
+	defer proc.Stop(false)
+
+	fooAddrA := fa.KeyAddress(keyFoo)
+	fooAddrB := fb.KeyAddress(keyFoo)
+	startTokens := sdk.TokensFromTendermintPower(1000)
+	sendTokens := sdk.TokensFromTendermintPower(10)
+	voucherDenom := ibc.VoucherDenom("chanb", fooDenom)
+
+	// waitFor waits for a few blocks of chain b until cond holds
+	waitFor := func(cond func() bool) {
+		for i := 0; i < 20 && !cond(); i++ {
+			tests.WaitForNextNBlocksTM(1, fb.Port)
+		}
+		require.True(t, cond())
+	}
+
+	// send coins from chain a to chain b, which mints vouchers for them
+	success, _, _ := fa.TxIBCTransfer(keyFoo, fooAddrB, sdk.NewCoin(fooDenom, sendTokens), "chana", 10000, "-y")
+	require.True(t, success)
+	waitFor(func() bool {
+		fooAccB := fb.QueryAccount(fooAddrB)
+		return fooAccB.GetCoins().AmountOf(voucherDenom).Equal(sendTokens)
+	})
+	fooAccA := fa.QueryAccount(fooAddrA)
+	require.Equal(t, startTokens.Sub(sendTokens), fooAccA.GetCoins().AmountOf(fooDenom))
+
+	// the acknowledgement completes the packet on chain a
+	waitFor(func() bool {
+		state, err := relayer.LoadState(stateFile)
+		require.NoError(t, err)
+		return state.End(fa.ChainID, "chana").CompletedSequence == 1
+	})
+
+	// send the vouchers back, which releases the escrowed coins on chain a
+	success, _, _ = fb.TxIBCTransfer(keyFoo, fooAddrA, sdk.NewCoin(voucherDenom, sendTokens), "chanb", 10000, "-y")
+	require.True(t, success)
+	waitFor(func() bool {
+		fooAccA = fa.QueryAccount(fooAddrA)
+		return fooAccA.GetCoins().AmountOf(fooDenom).Equal(startTokens)
+	})
+	fooAccB := fb.QueryAccount(fooAddrB)
+	require.True(t, fooAccB.GetCoins().AmountOf(voucherDenom).IsZero())
+
+	fa.Cleanup()
+	fb.Cleanup()
+}
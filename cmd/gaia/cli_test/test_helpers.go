@@ -1,6 +1,7 @@
 package clitest
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -368,6 +369,37 @@ func (f *Fixtures) TxGovVote(proposalID int, option gov.VoteOption, from string,
 	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), app.DefaultKeyPass)
 }
 
+//___________________________________________________________________________________
+// gaiacli tx ibc
+
+// TxIBC is gaiacli tx ibc [command]
+func (f *Fixtures) TxIBC(from, command string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("gaiacli tx ibc %s --from=%s %v", command, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), app.DefaultKeyPass)
+}
+
+// TxIBCTransfer is gaiacli tx ibc transfer
+func (f *Fixtures) TxIBCTransfer(from string, to sdk.AccAddress, amount sdk.Coin, channel string, timeoutHeight int64, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("gaiacli tx ibc transfer --from=%s --to=%s --amount=%s --channel=%s --timeout-height=%d %v",
+		from, hex.EncodeToString(to), amount, channel, timeoutHeight, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), app.DefaultKeyPass)
+}
+
+// IBCRelay runs gaiacli tx ibc relay between the chains of f and cp, signing
+// for both with keys of f, and returns the relayer process
+func (f *Fixtures) IBCRelay(cp *Fixtures, key, cpKey, channel, stateFile string, flags ...string) *tests.Process {
+	cmd := fmt.Sprintf("gaiacli tx ibc relay --home=%s --chain-a-id=%s --chain-a-node=%s --chain-a-key=%s "+
+		"--chain-b-id=%s --chain-b-node=%s --chain-b-key=%s --channel=%s --state-file=%s --poll-interval=1s",
+		f.GCLIHome, f.ChainID, f.RPCAddr, key, cp.ChainID, cp.RPCAddr, cpKey, channel, stateFile)
+	proc := tests.GoExecuteTWithStdout(f.T, addFlags(cmd, flags))
+
+	for i := 0; i < 2; i++ {
+		_, err := proc.StdinPipe.Write([]byte(app.DefaultKeyPass + "\n"))
+		require.NoError(f.T, err)
+	}
+	return proc
+}
+
 //___________________________________________________________________________________
 // gaiacli query account
 
@@ -599,6 +631,20 @@ func (f *Fixtures) QuerySlashingParams() slashing.Params {
 	return params
 }
 
+//___________________________________________________________________________________
+// query supply
+
+// QuerySupplyTotal is gaiacli query supply total
+func (f *Fixtures) QuerySupplyTotal(flags ...string) sdk.Coins {
+	cmd := fmt.Sprintf("gaiacli query supply total %v", f.Flags())
+	out, _ := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	var supply sdk.Coins
+	cdc := app.MakeCodec()
+	err := cdc.UnmarshalJSON([]byte(out), &supply)
+	require.NoError(f.T, err, "out %v\n, err %v", out, err)
+	return supply
+}
+
 //___________________________________________________________________________________
 // executors
 
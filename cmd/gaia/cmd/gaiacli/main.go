@@ -24,22 +24,42 @@ import (
 
 	at "my-cosmos/cosmos-sdk/x/auth"
 	auth "my-cosmos/cosmos-sdk/x/auth/client/rest"
+	az "my-cosmos/cosmos-sdk/x/authz"
+	authz "my-cosmos/cosmos-sdk/x/authz/client/rest"
 	bank "my-cosmos/cosmos-sdk/x/bank/client/rest"
+	cb "my-cosmos/cosmos-sdk/x/circuit"
+	circuit "my-cosmos/cosmos-sdk/x/circuit/client/rest"
 	dist "my-cosmos/cosmos-sdk/x/distribution/client/rest"
+	ev "my-cosmos/cosmos-sdk/x/evidence"
+	evidence "my-cosmos/cosmos-sdk/x/evidence/client/rest"
+	fg "my-cosmos/cosmos-sdk/x/feegrant"
+	feegrant "my-cosmos/cosmos-sdk/x/feegrant/client/rest"
 	gv "my-cosmos/cosmos-sdk/x/gov"
 	gov "my-cosmos/cosmos-sdk/x/gov/client/rest"
+	ibc "my-cosmos/cosmos-sdk/x/ibc/client/rest"
 	sl "my-cosmos/cosmos-sdk/x/slashing"
 	slashing "my-cosmos/cosmos-sdk/x/slashing/client/rest"
 	st "my-cosmos/cosmos-sdk/x/staking"
 	staking "my-cosmos/cosmos-sdk/x/staking/client/rest"
+	sp "my-cosmos/cosmos-sdk/x/supply"
+	supply "my-cosmos/cosmos-sdk/x/supply/client/rest"
 
 	authcmd "my-cosmos/cosmos-sdk/x/auth/client/cli"
+	authzClient "my-cosmos/cosmos-sdk/x/authz/client"
 	bankcmd "my-cosmos/cosmos-sdk/x/bank/client/cli"
+	circuitClient "my-cosmos/cosmos-sdk/x/circuit/client"
+	ibccmd "my-cosmos/cosmos-sdk/x/ibc/client/cli"
 	distcmd "my-cosmos/cosmos-sdk/x/distribution"
 	distClient "my-cosmos/cosmos-sdk/x/distribution/client"
+	evidenceClient "my-cosmos/cosmos-sdk/x/evidence/client"
+	feegrantClient "my-cosmos/cosmos-sdk/x/feegrant/client"
 	govClient "my-cosmos/cosmos-sdk/x/gov/client"
+	paramsClient "my-cosmos/cosmos-sdk/x/params/client"
 	slashingClient "my-cosmos/cosmos-sdk/x/slashing/client"
 	stakingClient "my-cosmos/cosmos-sdk/x/staking/client"
+	supplyClient "my-cosmos/cosmos-sdk/x/supply/client"
+	upgr "my-cosmos/cosmos-sdk/x/upgrade"
+	upgradeClient "my-cosmos/cosmos-sdk/x/upgrade/client"
 
 	_ "my-cosmos/cosmos-sdk/client/lcd/statik"
 )
@@ -65,10 +85,22 @@ func main() {
 	// Module clients hold cli commnads (tx,query) and lcd routes
 	// TODO: Make the lcd command take a list of ModuleClient
 	mc := []sdk.ModuleClients{
-		govClient.NewModuleClient(gv.StoreKey, cdc),
+		govClient.NewModuleClient(
+			gv.StoreKey, cdc,
+			paramsClient.ProposalHandler.CLIHandler(cdc),
+			upgradeClient.ProposalHandler.CLIHandler(cdc),
+			distClient.ProposalHandler.CLIHandler(cdc),
+			circuitClient.ProposalHandler.CLIHandler(cdc),
+		),
 		distClient.NewModuleClient(distcmd.StoreKey, cdc),
 		stakingClient.NewModuleClient(st.StoreKey, cdc),
 		slashingClient.NewModuleClient(sl.StoreKey, cdc),
+		upgradeClient.NewModuleClient(upgr.QuerierRoute, cdc),
+		supplyClient.NewModuleClient(sp.QuerierRoute, cdc),
+		feegrantClient.NewModuleClient(fg.QuerierRoute, cdc),
+		authzClient.NewModuleClient(az.QuerierRoute, cdc),
+		evidenceClient.NewModuleClient(ev.QuerierRoute, cdc),
+		circuitClient.NewModuleClient(cb.QuerierRoute, cdc),
 	}
 
 	rootCmd := &cobra.Command{
@@ -149,6 +181,7 @@ func txCmd(cdc *amino.Codec, mc []sdk.ModuleClients) *cobra.Command {
 	txCmd.AddCommand(
 		// TODO 普通交易
 		bankcmd.SendTxCmd(cdc),
+		bankcmd.CreateVestingAccountCmd(cdc),
 		client.LineBreak,
 		// 单签名
 		authcmd.GetSignCommand(cdc),
@@ -167,6 +200,7 @@ func txCmd(cdc *amino.Codec, mc []sdk.ModuleClients) *cobra.Command {
 		 */
 		txCmd.AddCommand(m.GetTxCmd())
 	}
+	txCmd.AddCommand(ibccmd.GetTxCmd(cdc))
 
 	return txCmd
 }
@@ -180,10 +214,21 @@ func registerRoutes(rs *lcd.RestServer) {
 	tx.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 	auth.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, at.StoreKey)
 	bank.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
+	ibc.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	dist.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, distcmd.StoreKey)
 	staking.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	slashing.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
-	gov.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	supply.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	feegrant.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	authz.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	evidence.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	circuit.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	gov.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, []gov.ProposalRESTHandler{
+		paramsClient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+		upgradeClient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+		distClient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+		circuitClient.ProposalHandler.RESTHandler(rs.CliCtx, rs.Cdc),
+	})
 }
 
 func registerSwaggerUI(rs *lcd.RestServer) {
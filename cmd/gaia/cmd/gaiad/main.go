@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 
 	"github.com/spf13/cobra"
@@ -19,6 +20,7 @@ import (
 	gaiaInit "my-cosmos/cosmos-sdk/cmd/gaia/init"
 	"my-cosmos/cosmos-sdk/server"
 	"my-cosmos/cosmos-sdk/store"
+	"my-cosmos/cosmos-sdk/store/snapshots"
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
@@ -76,6 +78,12 @@ func main() {
 	// 其实就是向 rootCmd 中注册各种 内容
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
+	// 状态快照: 离线创建、列出及恢复本地快照
+	rootCmd.AddCommand(server.SnapshotCmd(ctx, loadSnapshotter))
+
+	// 调试工具: 回放存储 trace 以比较各数据库后端的性能
+	rootCmd.AddCommand(server.DebugCmd(ctx))
+
 	// prepare and add flags
 	// 这里就是真正启动 cosmos-sdk
 	executor := cli.PrepareBaseCmd(rootCmd, "GA", app.DefaultNodeHome)
@@ -100,11 +108,34 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		// Setpruning: 在与应用程序关联的多存储上设置一个修剪选项.
 		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))),
 
+		// 按存储名称单独设置修剪选项, 覆盖上面的全局选项
+		baseapp.SetStorePruning(viper.GetStringMapString("pruning-stores")),
+
 		// Setmingasprices: 返回在应用程序上设置最低天然气价格的选项.
 		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
+
+		// 在指定高度提交区块后优雅地停止节点
+		baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
+
+		// 每隔指定高度生成一次状态快照
+		baseapp.SetSnapshot(
+			snapshots.NewStore(server.SnapshotDir(viper.GetString(cli.HomeFlag)), 0),
+			uint64(viper.GetInt(server.FlagSnapshotInterval)),
+			uint32(viper.GetInt(server.FlagSnapshotKeepRecent)),
+		),
 	)
 }
 
+// loadSnapshotter loads the latest state of the app, for the snapshot commands
+func loadSnapshotter(logger log.Logger, db dbm.DB) (snapshots.Snapshotter, int64, error) {
+	gApp := app.NewGaiaApp(logger, db, nil, true)
+	snapshotter := gApp.Snapshotter()
+	if snapshotter == nil {
+		return nil, 0, errors.New("the multistore does not support snapshots")
+	}
+	return snapshotter, gApp.LastBlockHeight(), nil
+}
+
 //
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
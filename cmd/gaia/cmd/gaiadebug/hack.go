@@ -21,14 +21,17 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	bam "my-cosmos/cosmos-sdk/baseapp"
+	"my-cosmos/cosmos-sdk/server"
 	sdk "my-cosmos/cosmos-sdk/types"
 
 	"my-cosmos/cosmos-sdk/codec"
 	"my-cosmos/cosmos-sdk/x/auth"
 	"my-cosmos/cosmos-sdk/x/bank"
+	"my-cosmos/cosmos-sdk/x/evidence"
 	"my-cosmos/cosmos-sdk/x/params"
 	"my-cosmos/cosmos-sdk/x/slashing"
 	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/supply"
 
 	gaia "my-cosmos/cosmos-sdk/cmd/gaia/app"
 )
@@ -45,7 +48,7 @@ func runHackCmd(cmd *cobra.Command, args []string) error {
 
 	// load the app
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
-	db, err := sdk.NewLevelDB("gaia", dataDir)
+	db, err := sdk.NewDB("gaia", viper.GetString(server.FlagDBBackend), dataDir)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -136,6 +139,8 @@ type GaiaApp struct {
 	keyStaking  *sdk.KVStoreKey
 	tkeyStaking *sdk.TransientStoreKey
 	keySlashing *sdk.KVStoreKey
+	keyEvidence *sdk.KVStoreKey
+	keySupply   *sdk.KVStoreKey
 	keyParams   *sdk.KVStoreKey
 	tkeyParams  *sdk.TransientStoreKey
 
@@ -145,6 +150,8 @@ type GaiaApp struct {
 	bankKeeper          bank.Keeper
 	stakingKeeper       staking.Keeper
 	slashingKeeper      slashing.Keeper
+	evidenceKeeper      evidence.Keeper
+	supplyKeeper        supply.Keeper
 	paramsKeeper        params.Keeper
 }
 
@@ -163,6 +170,8 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		keyStaking:  sdk.NewKVStoreKey(staking.StoreKey),
 		tkeyStaking: sdk.NewTransientStoreKey(staking.TStoreKey),
 		keySlashing: sdk.NewKVStoreKey(slashing.StoreKey),
+		keyEvidence: sdk.NewKVStoreKey(evidence.StoreKey),
+		keySupply:   sdk.NewKVStoreKey(supply.StoreKey),
 		keyParams:   sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:  sdk.NewTransientStoreKey(params.TStoreKey),
 	}
@@ -179,8 +188,14 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 
 	// add handlers
 	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, app.paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
-	app.stakingKeeper = staking.NewKeeper(app.cdc, app.keyStaking, app.tkeyStaking, app.bankKeeper, app.paramsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.accountKeeper)
+	app.supplyKeeper = supply.NewKeeper(app.cdc, app.keySupply, app.accountKeeper, app.bankKeeper,
+		map[string][]string{auth.FeeCollectorName: nil, staking.ModuleName: {auth.Minter, auth.Burner}})
+	app.stakingKeeper = staking.NewKeeper(app.cdc, app.keyStaking, app.tkeyStaking, app.bankKeeper, app.supplyKeeper, app.paramsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, app.keySlashing, app.stakingKeeper, app.paramsKeeper.Subspace(slashing.DefaultParamspace), slashing.DefaultCodespace)
+	evidenceRouter := evidence.NewRouter().
+		AddRoute(evidence.RouteEquivocation, slashing.NewEquivocationHandler(app.slashingKeeper))
+	app.evidenceKeeper = evidence.NewKeeper(app.cdc, app.keyEvidence, evidenceRouter)
 
 	// register message routes
 	app.Router().
@@ -192,7 +207,7 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
-	app.MountStores(app.keyMain, app.keyAccount, app.keyStaking, app.keySlashing, app.keyParams)
+	app.MountStores(app.keyMain, app.keyAccount, app.keyStaking, app.keySlashing, app.keyEvidence, app.keySupply, app.keyParams)
 	app.MountStore(app.tkeyParams, sdk.StoreTypeTransient)
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
@@ -210,6 +225,7 @@ func MakeCodec() *codec.Codec {
 	bank.RegisterCodec(cdc)
 	staking.RegisterCodec(cdc)
 	slashing.RegisterCodec(cdc)
+	evidence.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -220,6 +236,7 @@ func MakeCodec() *codec.Codec {
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
+	evidence.BeginBlocker(ctx, req, app.evidenceKeeper)
 
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
@@ -264,6 +281,15 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	}
 
 	slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.SlashingData, genesisState.StakingData.Validators.ToSDKValidators())
+	supply.InitGenesis(ctx, app.supplyKeeper, genesisState.SupplyData)
+
+	// only staking tokens are burned here, so without a recorded supply they
+	// are all that is tracked
+	if genesisState.SupplyData.Supply.Empty() {
+		app.supplyKeeper.Inflate(ctx, sdk.Coins{
+			sdk.NewCoin(app.stakingKeeper.BondDenom(ctx), app.stakingKeeper.TotalTokens(ctx)),
+		})
+	}
 
 	return abci.ResponseInitChain{
 		Validators: validators,
@@ -11,10 +11,12 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	gaia "my-cosmos/cosmos-sdk/cmd/gaia/app"
+	"my-cosmos/cosmos-sdk/server"
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/auth"
 )
@@ -31,6 +33,8 @@ func init() {
 	rootCmd.AddCommand(pubkeyCmd)
 	rootCmd.AddCommand(addrCmd)
 	rootCmd.AddCommand(hackCmd)
+	hackCmd.Flags().String(server.FlagDBBackend, "", "Database backend of the application state (default goleveldb)")
+	viper.BindPFlag(server.FlagDBBackend, hackCmd.Flags().Lookup(server.FlagDBBackend))
 	rootCmd.AddCommand(rawBytesCmd)
 }
 
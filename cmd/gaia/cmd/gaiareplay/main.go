@@ -11,6 +11,7 @@ import (
 
 	cpm "github.com/otiai10/copy"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	bcm "github.com/tendermint/tendermint/blockchain"
@@ -69,10 +70,22 @@ func run(rootDir string) {
 	dataDir := filepath.Join(rootDir, "data")
 	ctx := server.NewDefaultContext()
 
+	// The database backends are configured in config.toml (db_backend) and
+	// app.toml (db-backend)
+	for _, file := range []string{"config.toml", "app.toml"} {
+		if path := filepath.Join(configDir, file); cmn.FileExists(path) {
+			viper.SetConfigFile(path)
+			if err := viper.MergeInConfig(); err != nil {
+				panic(err)
+			}
+		}
+	}
+	tmBackend := viper.GetString("db_backend")
+
 	// App DB
 	// appDB := dbm.NewMemDB()
 	fmt.Println("Opening app database")
-	appDB, err := sdk.NewLevelDB("application", dataDir)
+	appDB, err := sdk.NewDB("application", viper.GetString(server.FlagDBBackend), dataDir)
 	if err != nil {
 		panic(err)
 	}
@@ -80,14 +93,14 @@ func run(rootDir string) {
 	// TM DB
 	// tmDB := dbm.NewMemDB()
 	fmt.Println("Opening tendermint state database")
-	tmDB, err := sdk.NewLevelDB("state", dataDir)
+	tmDB, err := sdk.NewDB("state", tmBackend, dataDir)
 	if err != nil {
 		panic(err)
 	}
 
 	// Blockchain DB
 	fmt.Println("Opening blockstore database")
-	bcDB, err := sdk.NewLevelDB("blockstore", dataDir)
+	bcDB, err := sdk.NewDB("blockstore", tmBackend, dataDir)
 	if err != nil {
 		panic(err)
 	}
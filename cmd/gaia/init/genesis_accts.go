@@ -1,7 +1,9 @@
 package init
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -53,6 +55,19 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 
+			var vestingPeriods auth.Periods
+			if scheduleFile := viper.GetString(flagVestingSchedule); scheduleFile != "" {
+				if !vestingAmt.IsZero() || vestingEnd != 0 {
+					return fmt.Errorf("--%s cannot be combined with --%s or --%s",
+						flagVestingSchedule, flagVestingAmt, flagVestingEnd)
+				}
+
+				vestingStart, vestingPeriods, err = readVestingSchedule(scheduleFile)
+				if err != nil {
+					return err
+				}
+			}
+
 			genFile := config.GenesisFile()
 			if !common.FileExists(genFile) {
 				return fmt.Errorf("%s does not exist, run `gaiad init` first", genFile)
@@ -68,7 +83,7 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 
-			appState, err = addGenesisAccount(cdc, appState, addr, coins, vestingAmt, vestingStart, vestingEnd)
+			appState, err = addGenesisAccount(cdc, appState, addr, coins, vestingAmt, vestingStart, vestingEnd, vestingPeriods)
 			if err != nil {
 				return err
 			}
@@ -87,13 +102,14 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingSchedule, "", "JSON file with the start time and periods of a periodic vesting account")
 
 	return cmd
 }
 
 func addGenesisAccount(
 	cdc *codec.Codec, appState app.GenesisState, addr sdk.AccAddress,
-	coins, vestingAmt sdk.Coins, vestingStart, vestingEnd int64,
+	coins, vestingAmt sdk.Coins, vestingStart, vestingEnd int64, vestingPeriods auth.Periods,
 ) (app.GenesisState, error) {
 
 	for _, stateAcc := range appState.Accounts {
@@ -105,7 +121,22 @@ func addGenesisAccount(
 	acc := auth.NewBaseAccountWithAddress(addr)
 	acc.Coins = coins
 
-	if !vestingAmt.IsZero() {
+	if len(vestingPeriods) > 0 {
+		for _, period := range vestingPeriods {
+			if period.Length <= 0 {
+				return appState, fmt.Errorf("vesting period length must be positive")
+			}
+			if !period.Amount.IsValid() {
+				return appState, fmt.Errorf("invalid vesting period amount: %s", period.Amount)
+			}
+		}
+		if !acc.Coins.IsAllGTE(vestingPeriods.TotalAmount()) {
+			return appState, fmt.Errorf("vesting amount cannot be greater than total amount")
+		}
+
+		pvacc := auth.NewPeriodicVestingAccount(&acc, vestingStart, vestingPeriods)
+		appState.Accounts = append(appState.Accounts, app.NewGenesisAccountI(pvacc))
+	} else if !vestingAmt.IsZero() {
 		var vacc auth.VestingAccount
 
 		bvacc := &auth.BaseVestingAccount{
@@ -139,3 +170,50 @@ func addGenesisAccount(
 
 	return appState, nil
 }
+
+// vestingSchedule is the content of a vesting schedule file, e.g.
+//
+//	{
+//	  "start_time": 1554668078,
+//	  "periods": [
+//	    {"length": 7776000, "amount": "250000stake"},
+//	    {"length": 7776000, "amount": "250000stake"}
+//	  ]
+//	}
+//
+// where the length of every period is in seconds.
+type vestingSchedule struct {
+	StartTime int64 `json:"start_time"`
+	Periods   []struct {
+		Length int64  `json:"length"`
+		Amount string `json:"amount"`
+	} `json:"periods"`
+}
+
+// readVestingSchedule reads the start time and the periods of a periodic
+// vesting account from a schedule file.
+func readVestingSchedule(path string) (int64, auth.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var schedule vestingSchedule
+	if err := json.Unmarshal(bz, &schedule); err != nil {
+		return 0, nil, fmt.Errorf("invalid vesting schedule %s: %v", path, err)
+	}
+	if len(schedule.Periods) == 0 {
+		return 0, nil, fmt.Errorf("vesting schedule %s has no periods", path)
+	}
+
+	periods := make(auth.Periods, len(schedule.Periods))
+	for i, p := range schedule.Periods {
+		amount, err := sdk.ParseCoins(p.Amount)
+		if err != nil {
+			return 0, nil, err
+		}
+		periods[i] = auth.Period{Length: p.Length, Amount: amount}
+	}
+
+	return schedule.StartTime, periods, nil
+}
@@ -1,6 +1,9 @@
 package init
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,6 +12,7 @@ import (
 	"my-cosmos/cosmos-sdk/cmd/gaia/app"
 	"my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
 )
 
 func TestAddGenesisAccount(t *testing.T) {
@@ -21,6 +25,7 @@ func TestAddGenesisAccount(t *testing.T) {
 		vestingAmt   sdk.Coins
 		vestingStart int64
 		vestingEnd   int64
+		periods      auth.Periods
 	}
 	tests := []struct {
 		name    string
@@ -36,6 +41,7 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.Coins{},
 				0,
 				0,
+				nil,
 			},
 			false,
 		},
@@ -48,6 +54,7 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.Coins{},
 				0,
 				0,
+				nil,
 			},
 			true,
 		},
@@ -60,6 +67,7 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.Coins{sdk.NewInt64Coin("stake", 100)},
 				0,
 				0,
+				nil,
 			},
 			true,
 		},
@@ -72,6 +80,52 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.Coins{sdk.NewInt64Coin("stake", 50)},
 				1654668078,
 				1554668078,
+				nil,
+			},
+			true,
+		},
+		{
+			"valid periodic vesting account",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.Coins{sdk.NewInt64Coin("stake", 50)},
+				sdk.Coins{},
+				1554668078,
+				0,
+				auth.Periods{
+					{Length: 3600, Amount: sdk.Coins{sdk.NewInt64Coin("stake", 20)}},
+					{Length: 3600, Amount: sdk.Coins{sdk.NewInt64Coin("stake", 30)}},
+				},
+			},
+			false,
+		},
+		{
+			"invalid periodic vesting amount",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.Coins{sdk.NewInt64Coin("stake", 50)},
+				sdk.Coins{},
+				1554668078,
+				0,
+				auth.Periods{
+					{Length: 3600, Amount: sdk.Coins{sdk.NewInt64Coin("stake", 50)}},
+					{Length: 3600, Amount: sdk.Coins{sdk.NewInt64Coin("stake", 50)}},
+				},
+			},
+			true,
+		},
+		{
+			"invalid periodic vesting length",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.Coins{sdk.NewInt64Coin("stake", 50)},
+				sdk.Coins{},
+				1554668078,
+				0,
+				auth.Periods{{Length: 0, Amount: sdk.Coins{sdk.NewInt64Coin("stake", 50)}}},
 			},
 			true,
 		},
@@ -80,9 +134,42 @@ func TestAddGenesisAccount(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			_, err := addGenesisAccount(
 				cdc, tt.args.appState, tt.args.addr, tt.args.coins,
-				tt.args.vestingAmt, tt.args.vestingStart, tt.args.vestingEnd,
+				tt.args.vestingAmt, tt.args.vestingStart, tt.args.vestingEnd, tt.args.periods,
 			)
 			require.Equal(t, tt.wantErr, (err != nil))
 		})
 	}
 }
+
+func TestReadVestingSchedule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vesting-schedule")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "schedule.json")
+	schedule := `{
+  "start_time": 1554668078,
+  "periods": [
+    {"length": 7776000, "amount": "250stake"},
+    {"length": 7776000, "amount": "100footoken,250stake"}
+  ]
+}`
+	require.NoError(t, ioutil.WriteFile(path, []byte(schedule), 0600))
+
+	start, periods, err := readVestingSchedule(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(1554668078), start)
+	require.Equal(t, auth.Periods{
+		{Length: 7776000, Amount: sdk.Coins{sdk.NewInt64Coin("stake", 250)}},
+		{Length: 7776000, Amount: sdk.Coins{sdk.NewInt64Coin("footoken", 100), sdk.NewInt64Coin("stake", 250)}},
+	}, periods)
+
+	// a schedule needs periods
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"start_time": 1554668078}`), 0600))
+	_, _, err = readVestingSchedule(path)
+	require.Error(t, err)
+
+	// a missing file is an error
+	_, _, err = readVestingSchedule(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
@@ -24,6 +24,8 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+
+	flagVestingSchedule = "vesting-schedule"
 )
 
 type printInfo struct {
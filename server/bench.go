@@ -0,0 +1,142 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"my-cosmos/cosmos-sdk/store/tracekv"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagBackends = "backends"
+	flagBenchDir = "bench-dir"
+)
+
+// DebugCmd returns the debugging commands of the node.
+func DebugCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Tools for debugging and tuning the node",
+	}
+
+	cmd.AddCommand(storeBenchCmd(ctx))
+	return cmd
+}
+
+func storeBenchCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-bench [trace-file]",
+		Short: "Replay a store trace against each database backend and report ops/sec",
+		Long: `Replay the key/value operations of a store trace, as written by start
+--trace-store, against a fresh database of each backend and report the number
+of operations per second. Iterations are replayed as a seek to their first key.
+The values read by the trace are loaded into each database beforehand, outside
+of the measure, so that reads of state older than the trace hit actual data.
+
+Backends which are not available in the build are reported as such; cleveldb
+requires to build with the gcc tag and badgerdb with the badgerdb tag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			ops, err := tracekv.ReadOperations(file)
+			file.Close()
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "BACKEND\tOPS\tTIME\tOPS/SEC")
+			for _, backend := range strings.Split(viper.GetString(flagBackends), ",") {
+				backend = strings.TrimSpace(backend)
+				count, elapsed, err := benchBackend(backend, viper.GetString(flagBenchDir), ops)
+				if err != nil {
+					ctx.Logger.Error("store benchmark failed", "backend", backend, "err", err)
+					fmt.Fprintf(w, "%s\t-\t-\t-\n", backend)
+					continue
+				}
+				fmt.Fprintf(w, "%s\t%d\t%v\t%.0f\n", backend, count, elapsed, float64(count)/elapsed.Seconds())
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flagBackends, "goleveldb,cleveldb,badgerdb,memdb,fsdb", "Comma-separated database backends to benchmark")
+	cmd.Flags().String(flagBenchDir, "", "Directory of the benchmark databases (default the system temporary directory)")
+	return cmd
+}
+
+// benchBackend replays the operations of a trace against a fresh database of
+// the given backend, and returns the number of replayed operations and the
+// time it took.
+func benchBackend(backend, dir string, ops []tracekv.TracedOperation) (int, time.Duration, error) {
+	dir, err := ioutil.TempDir(dir, "store-bench-")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := sdk.NewDB("bench", backend, dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer db.Close()
+
+	preloadTrace(db, ops)
+
+	start := time.Now()
+	count := replayTrace(db, ops)
+	return count, time.Since(start), nil
+}
+
+// preloadTrace writes the values read by a trace into a database.
+func preloadTrace(db dbm.DB, ops []tracekv.TracedOperation) {
+	batch := db.NewBatch()
+	for _, op := range ops {
+		if op.Operation == tracekv.OpRead && len(op.Value) > 0 {
+			batch.Set(op.Key, op.Value)
+		}
+	}
+	batch.WriteSync()
+}
+
+// replayTrace replays the operations of a trace against a database and
+// returns the number of replayed operations. Iterator values are read along
+// with their keys, so their own operations are not replayed.
+func replayTrace(db dbm.DB, ops []tracekv.TracedOperation) (count int) {
+	for _, op := range ops {
+		switch op.Operation {
+		case tracekv.OpWrite:
+			db.Set(op.Key, op.Value)
+		case tracekv.OpRead:
+			db.Get(op.Key)
+		case tracekv.OpDelete:
+			db.Delete(op.Key)
+		case tracekv.OpIterKey:
+			iter := db.Iterator(op.Key, nil)
+			if iter.Valid() {
+				iter.Key()
+				iter.Value()
+			}
+			iter.Close()
+		default:
+			continue
+		}
+		count++
+	}
+	return count
+}
@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	defaultMinGasPrices = ""
+	defaultMinGasPrices       = ""
+	defaultSnapshotKeepRecent = 2
 )
 
 // BaseConfig defines the server's basic configuration
@@ -16,6 +17,31 @@ type BaseConfig struct {
 	// transaction. A transaction's fees must meet the minimum of any denomination
 	// specified in this config (e.g. 0.01photino;0.0001stake).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
+
+	// HaltHeight contains a non-zero height at which the node gracefully
+	// shuts down once the block is committed. It can be used to coordinate
+	// upgrades by hand; upgrades scheduled through governance halt the node
+	// automatically.
+	HaltHeight uint64 `mapstructure:"halt-height"`
+
+	// DBBackend is the database backend of the application state: goleveldb,
+	// cleveldb, badgerdb, memdb or fsdb. Empty selects goleveldb, or cleveldb
+	// when built with it. cleveldb and badgerdb must be built in with the gcc
+	// and badgerdb tags. memdb keeps no state across restarts and is meant for
+	// tests.
+	DBBackend string `mapstructure:"db-backend"`
+
+	// SnapshotInterval is the interval, in heights, at which state-sync
+	// snapshots of the application state are taken. Zero disables snapshots.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent is the number of recent snapshots to keep. Zero
+	// keeps all of them.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// PruningStores contains the pruning strategies of individual stores, by
+	// store name, which override the --pruning strategy of the node.
+	PruningStores map[string]string `mapstructure:"pruning-stores"`
 }
 
 // Config defines the server's top level configuration
@@ -43,7 +69,8 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig{
-			MinGasPrices: defaultMinGasPrices,
+			MinGasPrices:       defaultMinGasPrices,
+			SnapshotKeepRecent: defaultSnapshotKeepRecent,
 		},
 	}
 }
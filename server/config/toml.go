@@ -17,7 +17,46 @@ const defaultConfigTemplate = `# This is a TOML config file.
 # transaction. A transaction's fees must meet the minimum of any denomination
 # specified in this config (e.g. 0.01photino;0.0001stake).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
-`
+
+# HaltHeight contains a non-zero height at which the node gracefully shuts
+# down once the block is committed. It can be used to coordinate upgrades by
+# hand; upgrades scheduled through governance halt the node automatically.
+halt-height = {{ .BaseConfig.HaltHeight }}
+
+# DBBackend is the database backend of the application state: goleveldb,
+# cleveldb, badgerdb, memdb or fsdb. Empty selects goleveldb, or cleveldb when
+# built with it. cleveldb and badgerdb must be built in (make WITH_CLEVELDB=yes
+# or WITH_BADGERDB=yes). badgerdb is a pure-Go LSM store like goleveldb. memdb
+# keeps no state across restarts and is meant for tests. Changing the backend
+# of an existing node requires to resync or to restore a snapshot.
+db-backend = "{{ .BaseConfig.DBBackend }}"
+
+# SnapshotInterval is the interval, in heights, at which state-sync snapshots
+# of the application state are taken into the data/snapshots directory. Zero
+# disables snapshots.
+snapshot-interval = {{ .BaseConfig.SnapshotInterval }}
+
+# SnapshotKeepRecent is the number of recent snapshots to keep. Zero keeps all
+# of them.
+snapshot-keep-recent = {{ .BaseConfig.SnapshotKeepRecent }}
+
+##### per-store pruning options #####
+
+# PruningStores contains the pruning strategies of individual stores, by store
+# name, which override the --pruning strategy of the node. A strategy is one of
+# "nothing", "everything" or "syncable", optionally followed by comma-separated
+# keep-recent=<n>, keep-every=<n> and keep-until=<height> settings. Every
+# state of a store up to its keep-until height is kept. For instance, to keep
+# the full history of accounts and staking but only the latest state of the
+# slashing store:
+#
+# acc = "nothing"
+# staking = "nothing"
+# slashing = "everything"
+# gov = "syncable,keep-until=1000000"
+[pruning-stores]
+{{ range $name, $strategy := .BaseConfig.PruningStores }}{{ $name }} = "{{ $strategy }}"
+{{ end }}`
 
 var configTemplate *template.Template
 
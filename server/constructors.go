@@ -6,6 +6,9 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/viper"
+
+	"my-cosmos/cosmos-sdk/store/snapshots"
 	sdk "my-cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -21,6 +24,10 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string) (json.RawMessage, []tmtypes.GenesisValidator, error)
+
+	// AppSnapshotter is a function that loads the application from a database
+	// and returns its snapshotter along with the latest committed height.
+	AppSnapshotter func(log.Logger, dbm.DB) (snapshots.Snapshotter, int64, error)
 )
 
 /**
@@ -28,7 +35,7 @@ type (
  */
 func openDB(rootDir string) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	db, err := sdk.NewLevelDB("application", dataDir)
+	db, err := sdk.NewDB("application", viper.GetString(FlagDBBackend), dataDir)
 	return db, err
 }
 
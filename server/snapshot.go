@@ -0,0 +1,162 @@
+package server
+
+// DONTCOVER
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"my-cosmos/cosmos-sdk/store/snapshots"
+)
+
+const (
+	flagSnapshotDir = "snapshot-dir"
+	flagAppHash     = "app-hash"
+)
+
+// SnapshotDir returns the default directory of the state-sync snapshots of a
+// node home directory.
+func SnapshotDir(rootDir string) string {
+	return filepath.Join(rootDir, "data", "snapshots")
+}
+
+// SnapshotCmd returns the commands to create, list and restore state-sync
+// snapshots of the application state. They work offline, on the local data
+// directory, and must not be run while the node is running.
+func SnapshotCmd(ctx *Context, appSnapshotter AppSnapshotter) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create, list and restore state-sync snapshots of the application state",
+	}
+
+	cmd.AddCommand(
+		snapshotCreateCmd(ctx, appSnapshotter),
+		snapshotListCmd(ctx),
+		snapshotRestoreCmd(ctx, appSnapshotter),
+	)
+	cmd.PersistentFlags().String(flagSnapshotDir, "", "Snapshot directory (default <home>/data/snapshots)")
+	return cmd
+}
+
+func snapshotCreateCmd(ctx *Context, appSnapshotter AppSnapshotter) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [height]",
+		Short: "Create a snapshot of the application state at a height, the latest one by default",
+		Long: `Create a snapshot of the application state at a height, the latest one by
+default. The height must not have been pruned, see the --pruning flag of start.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			snapshotter, height, err := appSnapshotter(ctx.Logger, db)
+			if err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				if height, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return fmt.Errorf("invalid height %s: %v", args[0], err)
+				}
+			}
+
+			manifest, err := snapshotStore(config.RootDir).Create(snapshotter, height)
+			if err != nil {
+				return err
+			}
+			fmt.Println(manifest)
+			return nil
+		},
+	}
+}
+
+func snapshotListCmd(ctx *Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the local snapshots, the most recent first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			manifests, err := snapshotStore(config.RootDir).List()
+			if err != nil {
+				return err
+			}
+			for _, manifest := range manifests {
+				fmt.Println(manifest)
+			}
+			return nil
+		},
+	}
+}
+
+func snapshotRestoreCmd(ctx *Context, appSnapshotter AppSnapshotter) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [height]",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a local snapshot into an empty data
+directory. The snapshot is verified against the app hash of its height, which
+should be taken from a trusted block header with --app-hash (the header of the
+next height). Without it the app hash recorded in the snapshot manifest is
+trusted.
+
+Only the application state is restored, the Tendermint block store and state
+of the same height must be provided separately.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %v", args[0], err)
+			}
+			appHash, err := hex.DecodeString(viper.GetString(flagAppHash))
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %v", err)
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			snapshotter, _, err := appSnapshotter(ctx.Logger, db)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := snapshotStore(config.RootDir).Restore(snapshotter, height, appHash)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("restored snapshot: %s\n", manifest)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAppHash, "", "Trusted hex-encoded app hash of the snapshot height")
+	return cmd
+}
+
+func snapshotStore(rootDir string) *snapshots.Store {
+	dir := viper.GetString(flagSnapshotDir)
+	if dir == "" {
+		dir = SnapshotDir(rootDir)
+	}
+	return snapshots.NewStore(dir, 0)
+}
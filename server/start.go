@@ -23,6 +23,11 @@ const (
 	flagTraceStore     = "trace-store"
 	flagPruning        = "pruning"
 	FlagMinGasPrices   = "minimum-gas-prices"
+	FlagHaltHeight     = "halt-height"
+	FlagDBBackend      = "db-backend"
+
+	FlagSnapshotInterval   = "snapshot-interval"
+	FlagSnapshotKeepRecent = "snapshot-keep-recent"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -68,6 +73,10 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
 	)
+	cmd.Flags().Uint64(FlagHaltHeight, 0, "Height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().String(FlagDBBackend, "", "Database backend of the application state: goleveldb, cleveldb, badgerdb, memdb or fsdb (default goleveldb)")
+	cmd.Flags().Uint64(FlagSnapshotInterval, 0, "Interval, in heights, at which to take state-sync snapshots (0 disables snapshots)")
+	cmd.Flags().Uint32(FlagSnapshotKeepRecent, 2, "Number of recent state-sync snapshots to keep (0 keeps all of them)")
 
 	// add support for all Tendermint-specific command line options
 	// 添加对所有特定于Tendermint的命令行选项的支持
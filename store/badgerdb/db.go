@@ -0,0 +1,321 @@
+// Package badgerdb implements the Tendermint database interface with Badger,
+// a pure-Go LSM key/value store which keeps the values apart from the keys in
+// a value log. It is an alternative to goleveldb which does not need cgo.
+package badgerdb
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Backend is the name of the backend in the node configuration.
+const Backend = "badgerdb"
+
+var _ dbm.DB = (*DB)(nil)
+
+// DB is a database backed by Badger. Badger does not support empty keys, so
+// that they are read as missing and panic when written.
+type DB struct {
+	db *badger.DB
+}
+
+// NewDB opens the database of the given name in a directory, creating it if
+// needed. Writes are synced only by the Sync variants, as with goleveldb.
+func NewDB(name, dir string) (*DB, error) {
+	path := filepath.Join(dir, name+".db")
+	opts := badger.DefaultOptions(path).WithSyncWrites(false).WithLogger(nil)
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{db: db}, nil
+}
+
+// Implements dbm.DB.
+func (db *DB) Get(key []byte) []byte {
+	if len(key) == 0 {
+		return nil
+	}
+
+	var value []byte
+	err := db.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		panic(err)
+	}
+	if value == nil {
+		// an empty value is not a missing key
+		value = []byte{}
+	}
+	return value
+}
+
+// Implements dbm.DB.
+func (db *DB) Has(key []byte) bool {
+	return db.Get(key) != nil
+}
+
+// Implements dbm.DB.
+func (db *DB) Set(key []byte, value []byte) {
+	db.update(func(txn *badger.Txn) error { return txn.Set(key, nonNilBytes(value)) }, false)
+}
+
+// Implements dbm.DB.
+func (db *DB) SetSync(key []byte, value []byte) {
+	db.update(func(txn *badger.Txn) error { return txn.Set(key, nonNilBytes(value)) }, true)
+}
+
+// Implements dbm.DB.
+func (db *DB) Delete(key []byte) {
+	db.update(func(txn *badger.Txn) error { return txn.Delete(key) }, false)
+}
+
+// Implements dbm.DB.
+func (db *DB) DeleteSync(key []byte) {
+	db.update(func(txn *badger.Txn) error { return txn.Delete(key) }, true)
+}
+
+func (db *DB) update(fn func(txn *badger.Txn) error, sync bool) {
+	if err := db.db.Update(fn); err != nil {
+		panic(err)
+	}
+	if sync {
+		db.sync()
+	}
+}
+
+func (db *DB) sync() {
+	if err := db.db.Sync(); err != nil {
+		panic(err)
+	}
+}
+
+// Implements dbm.DB.
+func (db *DB) Close() {
+	if err := db.db.Close(); err != nil {
+		panic(err)
+	}
+}
+
+// Implements dbm.DB.
+func (db *DB) Print() {
+	itr := db.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+}
+
+// Implements dbm.DB.
+func (db *DB) Stats() map[string]string {
+	lsmSize, vlogSize := db.db.Size()
+	return map[string]string{
+		"badger.lsm-size":  fmt.Sprintf("%d", lsmSize),
+		"badger.vlog-size": fmt.Sprintf("%d", vlogSize),
+	}
+}
+
+//----------------------------------------
+// Batch
+
+// Implements dbm.DB.
+func (db *DB) NewBatch() dbm.Batch {
+	return &batch{db: db}
+}
+
+type batchOp struct {
+	delete bool
+	key    []byte
+	value  []byte
+}
+
+// batch buffers its operations and writes them with a Badger write batch,
+// which splits them into as many transactions as needed.
+type batch struct {
+	db  *DB
+	ops []batchOp
+}
+
+// Implements dbm.Batch.
+func (b *batch) Set(key, value []byte) {
+	b.ops = append(b.ops, batchOp{key: key, value: nonNilBytes(value)})
+}
+
+// Implements dbm.Batch.
+func (b *batch) Delete(key []byte) {
+	b.ops = append(b.ops, batchOp{delete: true, key: key})
+}
+
+// Implements dbm.Batch.
+func (b *batch) Write() {
+	wb := b.db.db.NewWriteBatch()
+	for _, op := range b.ops {
+		var err error
+		if op.delete {
+			err = wb.Delete(op.key)
+		} else {
+			err = wb.Set(op.key, op.value)
+		}
+		if err != nil {
+			wb.Cancel()
+			panic(err)
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		panic(err)
+	}
+	b.ops = nil
+}
+
+// Implements dbm.Batch.
+func (b *batch) WriteSync() {
+	b.Write()
+	b.db.sync()
+}
+
+// Implements dbm.Batch.
+func (b *batch) Close() {
+	b.ops = nil
+}
+
+//----------------------------------------
+// Iterator
+
+// Implements dbm.DB.
+func (db *DB) Iterator(start, end []byte) dbm.Iterator {
+	return newIterator(db.db.NewTransaction(false), start, end, false)
+}
+
+// Implements dbm.DB.
+func (db *DB) ReverseIterator(start, end []byte) dbm.Iterator {
+	return newIterator(db.db.NewTransaction(false), start, end, true)
+}
+
+// iterator iterates over a read-only transaction, which it discards once
+// closed.
+type iterator struct {
+	txn       *badger.Txn
+	source    *badger.Iterator
+	start     []byte
+	end       []byte
+	isReverse bool
+	isInvalid bool
+}
+
+var _ dbm.Iterator = (*iterator)(nil)
+
+func newIterator(txn *badger.Txn, start, end []byte, isReverse bool) *iterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = isReverse
+	source := txn.NewIterator(opts)
+
+	if isReverse {
+		if end == nil {
+			source.Rewind()
+		} else {
+			// seeks the greatest key up to end, which is excluded
+			source.Seek(end)
+			if source.Valid() && bytes.Equal(source.Item().Key(), end) {
+				source.Next()
+			}
+		}
+	} else {
+		// an empty start seeks the first key
+		source.Seek(start)
+	}
+
+	return &iterator{
+		txn:       txn,
+		source:    source,
+		start:     start,
+		end:       end,
+		isReverse: isReverse,
+	}
+}
+
+// Implements dbm.Iterator.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Implements dbm.Iterator.
+func (itr *iterator) Valid() bool {
+	// Once invalid, forever invalid.
+	if itr.isInvalid {
+		return false
+	}
+
+	if !itr.source.Valid() {
+		itr.isInvalid = true
+		return false
+	}
+
+	key := itr.source.Item().Key()
+	if itr.isReverse {
+		if itr.start != nil && bytes.Compare(key, itr.start) < 0 {
+			itr.isInvalid = true
+			return false
+		}
+	} else {
+		if itr.end != nil && bytes.Compare(itr.end, key) <= 0 {
+			itr.isInvalid = true
+			return false
+		}
+	}
+	return true
+}
+
+// Implements dbm.Iterator.
+func (itr *iterator) Key() []byte {
+	itr.assertIsValid()
+	return itr.source.Item().KeyCopy(nil)
+}
+
+// Implements dbm.Iterator.
+func (itr *iterator) Value() []byte {
+	itr.assertIsValid()
+	value, err := itr.source.Item().ValueCopy(nil)
+	if err != nil {
+		panic(err)
+	}
+	return nonNilBytes(value)
+}
+
+// Implements dbm.Iterator.
+func (itr *iterator) Next() {
+	itr.assertIsValid()
+	itr.source.Next()
+}
+
+// Implements dbm.Iterator.
+func (itr *iterator) Close() {
+	itr.source.Close()
+	itr.txn.Discard()
+}
+
+func (itr *iterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("badgerdb iterator is invalid")
+	}
+}
+
+// nonNilBytes turns nil values into empty ones, as the other backends do.
+func nonNilBytes(bz []byte) []byte {
+	if bz == nil {
+		return []byte{}
+	}
+	return bz
+}
@@ -0,0 +1,93 @@
+package badgerdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func newTestDB(t *testing.T) (*DB, func()) {
+	dir, err := ioutil.TempDir("", "badgerdb")
+	require.Nil(t, err)
+	db, err := NewDB("test", dir)
+	require.Nil(t, err)
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestDBGetSetDelete(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	require.Nil(t, db.Get([]byte("key")))
+	require.Nil(t, db.Get(nil))
+	require.False(t, db.Has([]byte("key")))
+
+	db.Set([]byte("key"), []byte("value"))
+	require.Equal(t, []byte("value"), db.Get([]byte("key")))
+	require.True(t, db.Has([]byte("key")))
+
+	// empty values are not missing keys
+	db.SetSync([]byte("empty"), nil)
+	require.Equal(t, []byte{}, db.Get([]byte("empty")))
+
+	db.Delete([]byte("key"))
+	require.Nil(t, db.Get([]byte("key")))
+	db.DeleteSync([]byte("empty"))
+	require.False(t, db.Has([]byte("empty")))
+
+	require.Panics(t, func() { db.Set(nil, []byte("value")) })
+}
+
+func TestDBBatch(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	db.Set([]byte("deleted"), []byte("value"))
+
+	batch := db.NewBatch()
+	batch.Set([]byte("key"), []byte("value"))
+	batch.Delete([]byte("deleted"))
+	require.Nil(t, db.Get([]byte("key")))
+
+	batch.WriteSync()
+	batch.Close()
+	require.Equal(t, []byte("value"), db.Get([]byte("key")))
+	require.Nil(t, db.Get([]byte("deleted")))
+}
+
+func TestDBIterator(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	for i := 0; i < 10; i++ {
+		db.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	keys := func(itr dbm.Iterator) (keys []string) {
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			keys = append(keys, string(itr.Key()))
+		}
+		return keys
+	}
+
+	require.Len(t, keys(db.Iterator(nil, nil)), 10)
+	require.Equal(t, []string{"key3", "key4"}, keys(db.Iterator([]byte("key3"), []byte("key5"))))
+	require.Equal(t, []string{"key8", "key9"}, keys(db.Iterator([]byte("key8"), nil)))
+	require.Equal(t, []string{"key9", "key8"}, keys(db.ReverseIterator([]byte("key8"), nil)))
+	require.Equal(t, []string{"key4", "key3"}, keys(db.ReverseIterator([]byte("key3"), []byte("key5"))))
+	require.Equal(t, []string{"key1", "key0"}, keys(db.ReverseIterator(nil, []byte("key2"))))
+	require.Equal(t, []string{"key4"}, keys(db.ReverseIterator(nil, []byte("key45")))[:1])
+	require.Empty(t, keys(db.Iterator([]byte("key5"), []byte("key5"))))
+
+	itr := db.Iterator([]byte("key1"), nil)
+	require.Equal(t, []byte("value1"), itr.Value())
+	itr.Close()
+}
@@ -0,0 +1,181 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"my-cosmos/cosmos-sdk/store/types"
+)
+
+// ProofOpIAVLRange is the type of the proof operation of "/subspace" queries.
+const ProofOpIAVLRange = "iavl:range"
+
+var _ merkle.ProofOperator = RangeOp{}
+
+// RangeOp takes the value of a "/subspace" query, an encoded
+// types.QuerySubspaceResult, as argument and produces the root hash of the
+// tree. It proves that the key/value pairs are in the tree, and that no key
+// was left out between the start key of the query and the next key, or the
+// end of the prefix.
+type RangeOp struct {
+	// Encoded in ProofOp.Key, the queried prefix.
+	key []byte
+
+	// To encode in ProofOp.Data.
+	// Proof is nil for an empty tree.
+	Proof *iavl.RangeProof `json:"proof"`
+}
+
+// NewRangeOp creates a new RangeOp instance.
+func NewRangeOp(prefix []byte, proof *iavl.RangeProof) RangeOp {
+	return RangeOp{
+		key:   prefix,
+		Proof: proof,
+	}
+}
+
+// RangeOpDecoder decodes a RangeOp from a merkle.ProofOp.
+func RangeOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpIAVLRange {
+		return nil, cmn.NewError("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpIAVLRange)
+	}
+
+	var op RangeOp
+	err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op)
+	if err != nil {
+		return nil, cmn.ErrorWrap(err, "decoding ProofOp.Data into RangeOp")
+	}
+
+	return NewRangeOp(pop.Key, op.Proof), nil
+}
+
+// ProofOp implements merkle.ProofOperator.
+func (op RangeOp) ProofOp() merkle.ProofOp {
+	bz := cdc.MustMarshalBinaryLengthPrefixed(op)
+	return merkle.ProofOp{
+		Type: ProofOpIAVLRange,
+		Key:  op.key,
+		Data: bz,
+	}
+}
+
+// String implements fmt.Stringer.
+func (op RangeOp) String() string {
+	return fmt.Sprintf("RangeOp{%v}", op.GetKey())
+}
+
+// GetKey implements merkle.ProofOperator.
+func (op RangeOp) GetKey() []byte {
+	return op.key
+}
+
+// Run implements merkle.ProofOperator. It returns the root hash of the tree
+// if the query result is proven, and an error otherwise.
+func (op RangeOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, cmn.NewError("Value size is not 1")
+	}
+
+	var result types.QuerySubspaceResult
+	if err := cdc.UnmarshalBinaryLengthPrefixed(args[0], &result); err != nil {
+		return nil, cmn.ErrorWrap(err, "decoding subspace query result")
+	}
+	if !bytes.Equal(result.Prefix, op.key) {
+		return nil, cmn.NewError("result prefix %X does not match proof key %X", result.Prefix, op.key)
+	}
+
+	// an empty tree has no proof and no root hash
+	if op.Proof == nil {
+		if len(result.KVs) > 0 || len(result.NextKey) > 0 {
+			return nil, cmn.NewError("a result of an empty tree must be empty")
+		}
+		return [][]byte{nil}, nil
+	}
+
+	// Compute the root hash and assume it is valid.
+	// The caller checks the ultimate root later.
+	root := op.Proof.ComputeRootHash()
+	if err := op.Proof.Verify(root); err != nil {
+		return nil, cmn.ErrorWrap(err, "computing root hash")
+	}
+	if err := verifyRange(op.Proof, result); err != nil {
+		return nil, cmn.ErrorWrap(err, "verifying range")
+	}
+	return [][]byte{root}, nil
+}
+
+// verifyRange checks a subspace query result against a range proof whose
+// root was verified. The leaves of a range proof are contiguous in the tree,
+// so the pairs of the result must be the first proven keys from the start
+// key on, followed by the next key if any.
+func verifyRange(proof *iavl.RangeProof, result types.QuerySubspaceResult) error {
+	start, end := types.SubspaceRange(result.Prefix, result.StartKey)
+	keys := proof.Keys()
+
+	// nothing is left out before the first proven key
+	if bytes.Compare(keys[0], start) > 0 {
+		if err := proof.VerifyAbsence(start); err != nil {
+			return fmt.Errorf("keys before %X are not proven absent: %v", keys[0], err)
+		}
+	}
+
+	var inRange [][]byte
+	for _, key := range keys {
+		if bytes.Compare(key, start) >= 0 && (end == nil || bytes.Compare(key, end) < 0) {
+			inRange = append(inRange, key)
+		}
+	}
+
+	if len(result.KVs) > len(inRange) {
+		return fmt.Errorf("%d pairs for %d proven keys", len(result.KVs), len(inRange))
+	}
+	for i, kv := range result.KVs {
+		if !bytes.Equal(kv.Key, inRange[i]) {
+			return fmt.Errorf("key %X is not the proven key %X", kv.Key, inRange[i])
+		}
+		if err := proof.VerifyItem(kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	if len(result.NextKey) > 0 {
+		if len(inRange) == len(result.KVs) || !bytes.Equal(result.NextKey, inRange[len(result.KVs)]) {
+			return fmt.Errorf("next key %X is not the proven key following the result", result.NextKey)
+		}
+		return nil
+	}
+
+	// without a next key, the result must reach the end of the prefix
+	if len(inRange) != len(result.KVs) {
+		return fmt.Errorf("%d pairs for %d proven keys", len(result.KVs), len(inRange))
+	}
+	last := keys[len(keys)-1]
+	if end == nil || bytes.Compare(last, end) < 0 {
+		// the smallest key after the last proven one must be absent
+		if err := proof.VerifyAbsence(append(types.Cp(last), 0)); err != nil {
+			return fmt.Errorf("keys after %X are not proven absent: %v", last, err)
+		}
+	}
+	return nil
+}
+
+// nextKey returns the key following the given number of keys from the start
+// key on, among the keys of a range proof, if any.
+func nextKey(proof *iavl.RangeProof, result types.QuerySubspaceResult) []byte {
+	start, end := types.SubspaceRange(result.Prefix, result.StartKey)
+	count := 0
+	for _, key := range proof.Keys() {
+		if bytes.Compare(key, start) < 0 || (end != nil && bytes.Compare(key, end) >= 0) {
+			continue
+		}
+		if count == len(result.KVs) {
+			return key
+		}
+		count++
+	}
+	return nil
+}
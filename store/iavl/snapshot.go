@@ -0,0 +1,185 @@
+package iavl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Database key prefixes of the IAVL tree nodes and version roots.
+// NOTE: these must match the key formats of the iavl nodedb.
+const (
+	nodeKeyPrefix = 'n' // n<hash>
+	rootKeyPrefix = 'r' // r<version>
+)
+
+func nodeKey(hash []byte) []byte {
+	return append([]byte{nodeKeyPrefix}, hash...)
+}
+
+func rootKey(version int64) []byte {
+	key := make([]byte, 9)
+	key[0] = rootKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(version))
+	return key
+}
+
+// rawNode is a decoded IAVL node as persisted in the database.
+type rawNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func (node rawNode) isLeaf() bool {
+	return node.height == 0
+}
+
+// decodeRawNode decodes a node in the format of iavl MakeNode.
+func decodeRawNode(bz []byte) (node rawNode, err error) {
+	var n int
+	if node.height, n, err = amino.DecodeInt8(bz); err != nil {
+		return node, fmt.Errorf("decoding node height: %v", err)
+	}
+	bz = bz[n:]
+	if node.size, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, fmt.Errorf("decoding node size: %v", err)
+	}
+	bz = bz[n:]
+	if node.version, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, fmt.Errorf("decoding node version: %v", err)
+	}
+	bz = bz[n:]
+	if node.key, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, fmt.Errorf("decoding node key: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.isLeaf() {
+		if node.value, _, err = amino.DecodeByteSlice(bz); err != nil {
+			return node, fmt.Errorf("decoding node value: %v", err)
+		}
+		return node, nil
+	}
+
+	if node.leftHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, fmt.Errorf("decoding node left hash: %v", err)
+	}
+	bz = bz[n:]
+	if node.rightHash, _, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, fmt.Errorf("decoding node right hash: %v", err)
+	}
+	return node, nil
+}
+
+// hash computes the node hash the same way iavl does, from the hashes of
+// its children for inner nodes and from the key and value hash for leaves.
+func (node rawNode) hash() []byte {
+	var buf bytes.Buffer
+	// writes to a bytes.Buffer never fail
+	_ = amino.EncodeInt8(&buf, node.height)
+	_ = amino.EncodeVarint(&buf, node.size)
+	_ = amino.EncodeVarint(&buf, node.version)
+	if node.isLeaf() {
+		_ = amino.EncodeByteSlice(&buf, node.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(node.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, node.leftHash)
+		_ = amino.EncodeByteSlice(&buf, node.rightHash)
+	}
+	return tmhash.Sum(buf.Bytes())
+}
+
+// walkVersion calls fn with the raw root entry of a version, followed by
+// every node reachable from the root in pre-order.
+func walkVersion(db dbm.DB, version int64, fn func(key, value []byte, node *rawNode) error) error {
+	key := rootKey(version)
+	// the root of an empty tree is an empty value
+	if !db.Has(key) {
+		return fmt.Errorf("version %d does not exist, it may have been pruned", version)
+	}
+	rootHash := db.Get(key)
+	if err := fn(key, rootHash, nil); err != nil {
+		return err
+	}
+	if len(rootHash) == 0 {
+		return nil
+	}
+
+	stack := [][]byte{rootHash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		key := nodeKey(hash)
+		value := db.Get(key)
+		if value == nil {
+			return fmt.Errorf("node %X of version %d is missing", hash, version)
+		}
+		node, err := decodeRawNode(value)
+		if err != nil {
+			return fmt.Errorf("node %X of version %d: %v", hash, version, err)
+		}
+		if err := fn(key, value, &node); err != nil {
+			return err
+		}
+		if !node.isLeaf() {
+			stack = append(stack, node.rightHash, node.leftHash)
+		}
+	}
+	return nil
+}
+
+// ExportVersion calls fn with the raw database entries making up the tree of
+// the given version: the version root followed by all the nodes reachable
+// from it. Writing those entries to an empty database is enough for the
+// version to be loaded.
+func ExportVersion(db dbm.DB, version int64, fn func(key, value []byte) error) error {
+	return walkVersion(db, version, func(key, value []byte, _ *rawNode) error {
+		return fn(key, value)
+	})
+}
+
+// VerifyVersion checks that the tree of the given version is complete and
+// that it hashes to the expected root hash, recomputing the hash of every
+// node from its content.
+func VerifyVersion(db dbm.DB, version int64, hash []byte) error {
+	return walkVersion(db, version, func(key, value []byte, node *rawNode) error {
+		if node == nil {
+			// the version root
+			if !bytes.Equal(value, hash) {
+				return fmt.Errorf("root hash of version %d is %X, expected %X", version, value, hash)
+			}
+			return nil
+		}
+		if node.version > version {
+			return fmt.Errorf("node %X of version %d is newer than the tree", key[1:], node.version)
+		}
+		if !bytes.Equal(node.hash(), key[1:]) {
+			return fmt.Errorf("node %X of version %d does not match its hash", key[1:], version)
+		}
+		return nil
+	})
+}
+
+// ValidateExportedKey checks that a raw database key can be part of an
+// export of the given version, that is the key of a tree node or the root of
+// that version.
+func ValidateExportedKey(version int64, key []byte) error {
+	switch {
+	case len(key) == 1+tmhash.Size && key[0] == nodeKeyPrefix:
+		return nil
+	case bytes.Equal(key, rootKey(version)):
+		return nil
+	default:
+		return fmt.Errorf("unexpected key %X in the export of version %d", key, version)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"sync"
 
+	pkgerrors "github.com/pkg/errors"
 	"github.com/tendermint/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
@@ -43,7 +44,7 @@ var _ types.Queryable = (*Store)(nil)
 // TODO cosmos 主要存储结构 IAVL 树结构
 type Store struct {
 	// The underlying tree.
-	tree *iavl.MutableTree
+	tree Tree
 
 	// How many old versions we hold onto.
 	// A value of 0 means keep no recent states.
@@ -56,6 +57,19 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// Every version up to this one is kept regardless of the above.
+	// A value of 0 means no version is kept this way.
+	keepUntil int64
+
+	// Versions held from pruning, with their number of holds, and the
+	// pruned versions whose deletion waits for them to be released.
+	holdMtx sync.Mutex
+	held    map[int64]int
+	pending []int64
+
+	// The versions deleted by the last commit.
+	pruned []int64
 }
 
 // CONTRACT: tree should be fully loaded.
@@ -69,6 +83,27 @@ func UnsafeNewStore(tree *iavl.MutableTree, numRecent int64, storeEvery int64) *
 	return st
 }
 
+// GetImmutable returns a store backed by the immutable tree of a given
+// version, for queries and iteration only. Writes to the returned store
+// panic. An error is returned if the version does not exist or has been
+// pruned.
+func (st *Store) GetImmutable(version int64) (*Store, error) {
+	if !st.VersionExists(version) {
+		return nil, iavl.ErrVersionDoesNotExist
+	}
+
+	iTree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		tree:       &immutableTree{iTree},
+		numRecent:  st.numRecent,
+		storeEvery: st.storeEvery,
+	}, nil
+}
+
 // Implements Committer.
 func (st *Store) Commit() types.CommitID {
 	// Save a new version.
@@ -82,13 +117,11 @@ func (st *Store) Commit() types.CommitID {
 	previous := version - 1
 	if st.numRecent < previous {
 		toRelease := previous - st.numRecent
-		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.tree.DeleteVersion(toRelease)
-			if err != nil && err.(cmn.Error).Data() != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
+		if toRelease > st.keepUntil && (st.storeEvery == 0 || toRelease%st.storeEvery != 0) {
+			st.pending = append(st.pending, toRelease)
 		}
 	}
+	st.deleteReleasedVersions()
 
 	return types.CommitID{
 		Version: version,
@@ -96,6 +129,59 @@ func (st *Store) Commit() types.CommitID {
 	}
 }
 
+// deleteReleasedVersions deletes the pruned versions which are not held.
+func (st *Store) deleteReleasedVersions() {
+	st.holdMtx.Lock()
+	var toDelete, stillHeld []int64
+	for _, version := range st.pending {
+		if st.held[version] > 0 {
+			stillHeld = append(stillHeld, version)
+		} else {
+			toDelete = append(toDelete, version)
+		}
+	}
+	st.pending = stillHeld
+	st.holdMtx.Unlock()
+
+	st.pruned = toDelete
+
+	for _, version := range toDelete {
+		err := st.tree.DeleteVersion(version)
+		if err != nil && pkgerrors.Cause(err) != iavl.ErrVersionDoesNotExist {
+			panic(err)
+		}
+	}
+}
+
+// HoldVersion keeps a version from being deleted by pruning until it is
+// released, so that it can be read from another goroutine while new versions
+// are committed. A version pruned in the meantime is deleted by the first
+// commit after its release. It may be called concurrently with Commit.
+func (st *Store) HoldVersion(version int64) {
+	st.holdMtx.Lock()
+	defer st.holdMtx.Unlock()
+	if st.held == nil {
+		st.held = make(map[int64]int)
+	}
+	st.held[version]++
+}
+
+// PrunedVersions returns the versions deleted by the last commit.
+func (st *Store) PrunedVersions() []int64 {
+	return st.pruned
+}
+
+// ReleaseVersion releases a version held by HoldVersion.
+func (st *Store) ReleaseVersion(version int64) {
+	st.holdMtx.Lock()
+	defer st.holdMtx.Unlock()
+	if st.held[version] <= 1 {
+		delete(st.held, version)
+		return
+	}
+	st.held[version]--
+}
+
 // Implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
 	return types.CommitID{
@@ -108,6 +194,7 @@ func (st *Store) LastCommitID() types.CommitID {
 func (st *Store) SetPruning(opt types.PruningOptions) {
 	st.numRecent = opt.KeepRecent()
 	st.storeEvery = opt.KeepEvery()
+	st.keepUntil = opt.KeepUntil()
 }
 
 // VersionExists returns whether or not a given version is stored.
@@ -154,16 +241,29 @@ func (st *Store) Delete(key []byte) {
 
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
-	return newIAVLIterator(st.tree.ImmutableTree, start, end, true)
+	return newIAVLIterator(st.iterableTree(), start, end, true)
 }
 
 // Implements types.KVStore.
 func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
-	return newIAVLIterator(st.tree.ImmutableTree, start, end, false)
+	return newIAVLIterator(st.iterableTree(), start, end, false)
+}
+
+// iterableTree returns the immutable tree iterated over, which is the working
+// tree of a mutable tree
+func (st *Store) iterableTree() *iavl.ImmutableTree {
+	switch tree := st.tree.(type) {
+	case *immutableTree:
+		return tree.ImmutableTree
+	case *iavl.MutableTree:
+		return tree.ImmutableTree
+	default:
+		panic(fmt.Sprintf("unexpected IAVL tree type %T", tree))
+	}
 }
 
 // Handle gatest the latest height, if height is 0
-func getHeight(tree *iavl.MutableTree, req abci.RequestQuery) int64 {
+func getHeight(tree Tree, req abci.RequestQuery) int64 {
 	height := req.Height
 	if height == 0 {
 		latest := tree.Version()
@@ -230,19 +330,47 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 			_, res.Value = tree.GetVersioned(key, res.Height)
 		}
 
-	case "/subspace":
-		var KVs []types.KVPair
+	case "/subspace": // get a page of the pairs under a prefix
+		var params types.QuerySubspaceParams
+		if err := cdc.UnmarshalBinaryLengthPrefixed(req.Data, &params); err != nil {
+			msg := fmt.Sprintf("invalid subspace query params: %v", err)
+			return errors.ErrTxDecode(msg).QueryResult()
+		}
+		if err := params.ValidateBasic(); err != nil {
+			return errors.ErrUnknownRequest(err.Error()).QueryResult()
+		}
 
-		subspace := req.Data
-		res.Key = subspace
+		res.Key = params.Prefix
+		if !st.VersionExists(res.Height) {
+			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
+			break
+		}
 
-		iterator := types.KVStorePrefixIterator(st, subspace)
-		for ; iterator.Valid(); iterator.Next() {
-			KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+		// Besides the pairs, the proof holds the key before the start key if
+		// it is absent and the key after the last pair, used as next key.
+		limit := params.GetLimit()
+		start, end := types.SubspaceRange(params.Prefix, params.StartKey)
+		keys, values, proof, err := tree.GetVersionedRangeWithProof(start, end, int(limit)+2, res.Height)
+		if err != nil {
+			res.Log = err.Error()
+			break
 		}
 
-		iterator.Close()
-		res.Value = cdc.MustMarshalBinaryLengthPrefixed(KVs)
+		result := types.QuerySubspaceResult{
+			Prefix:   params.Prefix,
+			StartKey: params.StartKey,
+		}
+		for i := 0; i < len(keys) && uint64(i) < limit; i++ {
+			result.KVs = append(result.KVs, types.KVPair{Key: keys[i], Value: values[i]})
+		}
+		if proof != nil {
+			result.NextKey = nextKey(proof, result)
+		}
+
+		res.Value = cdc.MustMarshalBinaryLengthPrefixed(result)
+		if req.Prove {
+			res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{NewRangeOp(params.Prefix, proof).ProofOp()}}
+		}
 
 	default:
 		msg := fmt.Sprintf("Unexpected Query path: %v", req.Path)
@@ -70,6 +70,35 @@ func TestIAVLStoreGetSetHasDelete(t *testing.T) {
 	require.False(t, exists)
 }
 
+func TestIAVLStoreGetImmutable(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, _ := newAlohaTree(t, db)
+	iavlStore := UnsafeNewStore(tree, numRecent, storeEvery)
+
+	key := []byte("hello")
+	iavlStore.Set(key, []byte("notgoodbye"))
+	commitID := iavlStore.Commit()
+
+	oldStore, err := iavlStore.GetImmutable(commitID.Version - 1)
+	require.Nil(t, err)
+	require.EqualValues(t, treeData["hello"], oldStore.Get(key))
+	require.Panics(t, func() { oldStore.Set(key, []byte("value")) })
+	require.Panics(t, func() { oldStore.Delete(key) })
+
+	iter := oldStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		require.EqualValues(t, treeData[string(iter.Key())], iter.Value())
+	}
+
+	newStore, err := iavlStore.GetImmutable(commitID.Version)
+	require.Nil(t, err)
+	require.EqualValues(t, "notgoodbye", newStore.Get(key))
+
+	_, err = iavlStore.GetImmutable(commitID.Version + 1)
+	require.NotNil(t, err)
+}
+
 func TestIAVLStoreNoNilSet(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree, _ := newAlohaTree(t, db)
@@ -414,6 +443,50 @@ func TestIAVLPruneEverything(t *testing.T) {
 	}
 }
 
+func TestIAVLPruneKeepUntil(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	iavlStore.SetPruning(types.PruneEverything.WithKeepUntil(10))
+	for i := 0; i < 20; i++ {
+		nextVersion(iavlStore)
+	}
+	for j := int64(1); j <= 10; j++ {
+		require.True(t, iavlStore.VersionExists(j),
+			"Missing version %d, should keep all versions until 10", j)
+	}
+	for j := int64(11); j < 20; j++ {
+		require.False(t, iavlStore.VersionExists(j),
+			"Unpruned version %d, should prune all versions after 10", j)
+	}
+	require.True(t, iavlStore.VersionExists(20))
+}
+
+func TestIAVLHoldVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	iavlStore.SetPruning(types.PruneEverything)
+
+	nextVersion(iavlStore)
+	iavlStore.HoldVersion(1)
+	iavlStore.HoldVersion(1)
+	for i := 0; i < 3; i++ {
+		nextVersion(iavlStore)
+	}
+	require.True(t, iavlStore.VersionExists(1), "held version 1 should not be pruned")
+	require.False(t, iavlStore.VersionExists(2))
+
+	// the version is deleted by the first commit once fully released
+	iavlStore.ReleaseVersion(1)
+	nextVersion(iavlStore)
+	require.True(t, iavlStore.VersionExists(1))
+	iavlStore.ReleaseVersion(1)
+	nextVersion(iavlStore)
+	require.False(t, iavlStore.VersionExists(1))
+	require.True(t, iavlStore.VersionExists(6))
+}
+
 func TestIAVLStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, cacheSize)
@@ -433,14 +506,15 @@ func TestIAVLStoreQuery(t *testing.T) {
 		{Key: k1, Value: v3},
 		{Key: k2, Value: v2},
 	}
-	valExpSubEmpty := cdc.MustMarshalBinaryLengthPrefixed(KVs0)
-	valExpSub1 := cdc.MustMarshalBinaryLengthPrefixed(KVs1)
-	valExpSub2 := cdc.MustMarshalBinaryLengthPrefixed(KVs2)
+	valExpSubEmpty := cdc.MustMarshalBinaryLengthPrefixed(types.QuerySubspaceResult{Prefix: ksub, KVs: KVs0})
+	valExpSub1 := cdc.MustMarshalBinaryLengthPrefixed(types.QuerySubspaceResult{Prefix: ksub, KVs: KVs1})
+	valExpSub2 := cdc.MustMarshalBinaryLengthPrefixed(types.QuerySubspaceResult{Prefix: ksub, KVs: KVs2})
 
 	cid := iavlStore.Commit()
 	ver := cid.Version
 	query := abci.RequestQuery{Path: "/key", Data: k1, Height: ver}
-	querySub := abci.RequestQuery{Path: "/subspace", Data: ksub, Height: ver}
+	subParams := cdc.MustMarshalBinaryLengthPrefixed(types.NewQuerySubspaceParams(ksub, nil, 0))
+	querySub := abci.RequestQuery{Path: "/subspace", Data: subParams, Height: ver}
 
 	// query subspace before anything set
 	qres := iavlStore.Query(querySub)
@@ -469,6 +543,7 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, v1, qres.Value)
 
 	// and for the subspace
+	querySub.Height = cid.Version
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, valExpSub1, qres.Value)
@@ -493,6 +568,7 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, v2, qres.Value)
 	// and for the subspace
+	querySub.Height = cid.Version
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, valExpSub2, qres.Value)
@@ -504,6 +580,59 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, v1, qres.Value)
 }
 
+func TestIAVLStoreQuerySubspacePages(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, numRecent, storeEvery)
+
+	prefix := []byte("key")
+	for i := 0; i < 5; i++ {
+		iavlStore.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+	iavlStore.Set([]byte("other"), []byte("value"))
+	cid := iavlStore.Commit()
+
+	querySubspace := func(startKey []byte, limit uint64) types.QuerySubspaceResult {
+		params := cdc.MustMarshalBinaryLengthPrefixed(types.NewQuerySubspaceParams(prefix, startKey, limit))
+		qres := iavlStore.Query(abci.RequestQuery{Path: "/subspace", Data: params, Height: cid.Version, Prove: true})
+		require.Equal(t, uint32(errors.CodeOK), qres.Code, qres.Log)
+
+		// the result is proven by the range operation
+		op, err := RangeOpDecoder(qres.Proof.Ops[0])
+		require.Nil(t, err)
+		root, err := op.Run([][]byte{qres.Value})
+		require.Nil(t, err)
+		require.Equal(t, cid.Hash, root[0])
+
+		var result types.QuerySubspaceResult
+		cdc.MustUnmarshalBinaryLengthPrefixed(qres.Value, &result)
+		return result
+	}
+
+	// page through the prefix two keys at a time
+	var keys []string
+	var startKey []byte
+	for page := 0; page < 3; page++ {
+		result := querySubspace(startKey, 2)
+		for _, kv := range result.KVs {
+			keys = append(keys, string(kv.Key))
+		}
+		startKey = result.NextKey
+	}
+	require.Nil(t, startKey)
+	require.Equal(t, []string{"key0", "key1", "key2", "key3", "key4"}, keys)
+
+	// an absent start key starts from the following key
+	result := querySubspace([]byte("key11"), 0)
+	require.Len(t, result.KVs, 3)
+	require.Equal(t, []byte("key2"), result.KVs[0].Key)
+
+	// the start key must be under the prefix
+	params := cdc.MustMarshalBinaryLengthPrefixed(types.NewQuerySubspaceParams(prefix, []byte("other"), 0))
+	qres := iavlStore.Query(abci.RequestQuery{Path: "/subspace", Data: params, Height: cid.Version})
+	require.NotEqual(t, uint32(errors.CodeOK), qres.Code)
+}
+
 func BenchmarkIAVLIteratorNext(b *testing.B) {
 	db := dbm.NewMemDB()
 	treeSize := 1000
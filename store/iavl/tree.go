@@ -0,0 +1,86 @@
+package iavl
+
+import (
+	"fmt"
+
+	"github.com/tendermint/iavl"
+)
+
+var (
+	_ Tree = (*immutableTree)(nil)
+	_ Tree = (*iavl.MutableTree)(nil)
+)
+
+// Tree is the interface of the IAVL trees backing a Store. A mutable tree
+// implements it with an iavl.MutableTree, while an immutable tree loaded at
+// an old version is wrapped in an immutableTree.
+type Tree interface {
+	Has(key []byte) bool
+	Get(key []byte) (index int64, value []byte)
+	Set(key, value []byte) bool
+	Remove(key []byte) ([]byte, bool)
+	SaveVersion() ([]byte, int64, error)
+	DeleteVersion(version int64) error
+	Version() int64
+	Hash() []byte
+	VersionExists(version int64) bool
+	GetVersioned(key []byte, version int64) (int64, []byte)
+	GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
+	GetVersionedRangeWithProof(startKey, endKey []byte, limit int, version int64) (keys, values [][]byte, proof *iavl.RangeProof, err error)
+	GetImmutable(version int64) (*iavl.ImmutableTree, error)
+}
+
+// immutableTree is a read-only Tree at a single version. Every write panics.
+type immutableTree struct {
+	*iavl.ImmutableTree
+}
+
+func (it *immutableTree) Set(_, _ []byte) bool {
+	panic("cannot call 'Set' on an immutable IAVL tree")
+}
+
+func (it *immutableTree) Remove(_ []byte) ([]byte, bool) {
+	panic("cannot call 'Remove' on an immutable IAVL tree")
+}
+
+func (it *immutableTree) SaveVersion() ([]byte, int64, error) {
+	panic("cannot call 'SaveVersion' on an immutable IAVL tree")
+}
+
+func (it *immutableTree) DeleteVersion(_ int64) error {
+	panic("cannot call 'DeleteVersion' on an immutable IAVL tree")
+}
+
+func (it *immutableTree) VersionExists(version int64) bool {
+	return it.Version() == version
+}
+
+func (it *immutableTree) GetVersioned(key []byte, version int64) (int64, []byte) {
+	if it.Version() != version {
+		return -1, nil
+	}
+	return it.Get(key)
+}
+
+func (it *immutableTree) GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error) {
+	if it.Version() != version {
+		return nil, nil, fmt.Errorf("version mismatch on immutable IAVL tree; got: %d, expected: %d", version, it.Version())
+	}
+	return it.GetWithProof(key)
+}
+
+func (it *immutableTree) GetVersionedRangeWithProof(startKey, endKey []byte, limit int, version int64) (
+	keys, values [][]byte, proof *iavl.RangeProof, err error) {
+
+	if it.Version() != version {
+		return nil, nil, nil, fmt.Errorf("version mismatch on immutable IAVL tree; got: %d, expected: %d", version, it.Version())
+	}
+	return it.GetRangeWithProof(startKey, endKey, limit)
+}
+
+func (it *immutableTree) GetImmutable(version int64) (*iavl.ImmutableTree, error) {
+	if it.Version() != version {
+		return nil, fmt.Errorf("version mismatch on immutable IAVL tree; got: %d, expected: %d", version, it.Version())
+	}
+	return it.ImmutableTree, nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/tendermint/iavl"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	cmn "github.com/tendermint/tendermint/libs/common"
+
+	iavlstore "my-cosmos/cosmos-sdk/store/iavl"
 )
 
 // MultiStoreProof defines a collection of store proofs in a multi-store
@@ -30,10 +32,11 @@ func (proof *MultiStoreProof) ComputeRootHash() []byte {
 // RequireProof returns whether proof is required for the subpath.
 func RequireProof(subpath string) bool {
 	// XXX: create a better convention.
-	// Currently, only when query subpath is "/key", will proof be included in
-	// response. If there are some changes about proof building in iavlstore.go,
-	// we must change code here to keep consistency with iavlStore#Query.
-	return subpath == "/key"
+	// Currently, only when query subpath is "/key" or "/subspace", will proof
+	// be included in response. If there are some changes about proof building
+	// in iavlstore.go, we must change code here to keep consistency with
+	// iavlStore#Query.
+	return subpath == "/key" || subpath == "/subspace"
 }
 
 //-----------------------------------------------------------------------------
@@ -131,6 +134,7 @@ func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt.RegisterOpDecoder(merkle.ProofOpSimpleValue, merkle.SimpleValueOpDecoder)
 	prt.RegisterOpDecoder(iavl.ProofOpIAVLValue, iavl.IAVLValueOpDecoder)
 	prt.RegisterOpDecoder(iavl.ProofOpIAVLAbsence, iavl.IAVLAbsenceOpDecoder)
+	prt.RegisterOpDecoder(iavlstore.ProofOpIAVLRange, iavlstore.RangeOpDecoder)
 	prt.RegisterOpDecoder(ProofOpMultiStore, MultiStoreProofOpDecoder)
 	return
 }
@@ -174,3 +174,61 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyMultiStoreSubspaceQueryProof(t *testing.T) {
+	// Create main tree for testing.
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.LoadVersion(0)
+
+	iavlStore := store.GetCommitStore(iavlStoreKey).(*iavl.Store)
+	iavlStore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+	iavlStore.Set([]byte("MYKEY2"), []byte("MYVALUE2"))
+	iavlStore.Set([]byte("MYKEY3"), []byte("MYVALUE3"))
+	iavlStore.Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	cid := store.Commit()
+
+	// Get Proof
+	params := types.NewQuerySubspaceParams([]byte("MYKEY"), nil, 2)
+	res := store.Query(abci.RequestQuery{
+		Path:  "/iavlStoreKey/subspace",
+		Data:  cdc.MustMarshalBinaryLengthPrefixed(params),
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+
+	var result types.QuerySubspaceResult
+	cdc.MustUnmarshalBinaryLengthPrefixed(res.Value, &result)
+	require.Len(t, result.KVs, 2)
+	require.Equal(t, []byte("MYKEY3"), result.NextKey)
+
+	// Verify proof.
+	prt := DefaultProofRuntime()
+	err := prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYKEY", res.Value)
+	require.Nil(t, err)
+
+	// Verify (bad) proof.
+	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/OTHERKEY", res.Value)
+	require.NotNil(t, err)
+
+	// Verify (bad) proof, a pair is left out.
+	bad := result
+	bad.KVs = bad.KVs[1:]
+	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYKEY", cdc.MustMarshalBinaryLengthPrefixed(bad))
+	require.NotNil(t, err)
+
+	// Verify (bad) proof, the result claims to be the last page.
+	bad = result
+	bad.NextKey = nil
+	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYKEY", cdc.MustMarshalBinaryLengthPrefixed(bad))
+	require.NotNil(t, err)
+
+	// Verify (bad) proof, a value is modified.
+	bad = result
+	bad.KVs = []types.KVPair{result.KVs[0], {Key: result.KVs[1].Key, Value: []byte("MYVALUE")}}
+	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYKEY", cdc.MustMarshalBinaryLengthPrefixed(bad))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,74 @@
+package rootmulti
+
+import (
+	"io"
+
+	"my-cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.CacheKVStore = prunedStore{}
+
+// prunedStore stands for an IAVL store in a CacheMultiStoreWithVersion when
+// the store no longer has the version. Any access panics with an
+// ErrorPrunedVersion, which queries turn into an error.
+type prunedStore struct {
+	name    string
+	version int64
+}
+
+func (ps prunedStore) fail() {
+	panic(types.ErrorPrunedVersion{StoreName: ps.name, Version: ps.version})
+}
+
+// Implements Store.
+func (ps prunedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// Implements CacheWrapper. The store is its own cache as it can not be
+// accessed.
+func (ps prunedStore) CacheWrap() types.CacheWrap {
+	return ps
+}
+
+// Implements CacheWrapper.
+func (ps prunedStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ps
+}
+
+// Implements CacheWrap. There is nothing to write.
+func (ps prunedStore) Write() {}
+
+// Implements KVStore.
+func (ps prunedStore) Get(key []byte) []byte {
+	ps.fail()
+	return nil
+}
+
+// Implements KVStore.
+func (ps prunedStore) Has(key []byte) bool {
+	ps.fail()
+	return false
+}
+
+// Implements KVStore.
+func (ps prunedStore) Set(key, value []byte) {
+	ps.fail()
+}
+
+// Implements KVStore.
+func (ps prunedStore) Delete(key []byte) {
+	ps.fail()
+}
+
+// Implements KVStore.
+func (ps prunedStore) Iterator(start, end []byte) types.Iterator {
+	ps.fail()
+	return nil
+}
+
+// Implements KVStore.
+func (ps prunedStore) ReverseIterator(start, end []byte) types.Iterator {
+	ps.fail()
+	return nil
+}
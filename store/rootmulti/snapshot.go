@@ -0,0 +1,205 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"my-cosmos/cosmos-sdk/store/iavl"
+	"my-cosmos/cosmos-sdk/store/snapshots"
+	"my-cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// maxSnapshotItemSize bounds the size of an entry of a snapshot stream.
+	maxSnapshotItemSize = 64 << 20 // 64 MiB
+
+	// snapshotBatchSize is the number of entries written per batch on restore.
+	snapshotBatchSize = 10000
+)
+
+var _ snapshots.Snapshotter = (*Store)(nil)
+
+// snapshotItem is a raw database entry of an IAVL substore.
+//
+// A snapshot stream is made of the commitInfo of the snapshot height,
+// followed by the entries of every IAVL substore sorted by store name, all
+// length-prefixed. The entries of a store are the root of the version and
+// all the nodes reachable from it, see iavl.ExportVersion.
+type snapshotItem struct {
+	Store string
+	Key   []byte
+	Value []byte
+}
+
+// Snapshot implements snapshots.Snapshotter. It writes the snapshot stream
+// of the given committed height and returns the app hash of that height.
+// All the persisted substores must be IAVL stores, and the version must not
+// have been pruned.
+func (rs *Store) Snapshot(height int64, w io.Writer) ([]byte, error) {
+	// the latest height is read from the database, as new heights may be
+	// committed concurrently
+	latest := getLatestVersion(rs.db)
+	if height <= 0 || height > latest {
+		return nil, fmt.Errorf("cannot snapshot height %d; the latest height is %d", height, latest)
+	}
+	cInfo, err := getCommitInfo(rs.db, height)
+	if err != nil {
+		return nil, err
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err := cdc.MarshalBinaryLengthPrefixedWriter(bw, cInfo); err != nil {
+		return nil, err
+	}
+
+	storeInfos := make([]storeInfo, len(cInfo.StoreInfos))
+	copy(storeInfos, cInfo.StoreInfos)
+	sort.Slice(storeInfos, func(i, j int) bool {
+		return storeInfos[i].Name < storeInfos[j].Name
+	})
+
+	for _, si := range storeInfos {
+		params, err := rs.snapshotStoreParams(si.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = iavl.ExportVersion(rs.storeDB(params), height, func(key, value []byte) error {
+			_, err := cdc.MarshalBinaryLengthPrefixedWriter(bw, snapshotItem{
+				Store: si.Name,
+				Key:   key,
+				Value: value,
+			})
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to export store %s: %v", si.Name, err)
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+	return cInfo.Hash(), nil
+}
+
+// HoldHeight implements snapshots.Snapshotter. It keeps the IAVL substores
+// from pruning the given height until ReleaseHeight is called.
+func (rs *Store) HoldHeight(height int64) {
+	for _, store := range rs.stores {
+		if store, ok := store.(*iavl.Store); ok {
+			store.HoldVersion(height)
+		}
+	}
+}
+
+// ReleaseHeight implements snapshots.Snapshotter.
+func (rs *Store) ReleaseHeight(height int64) {
+	for _, store := range rs.stores {
+		if store, ok := store.(*iavl.Store); ok {
+			store.ReleaseVersion(height)
+		}
+	}
+}
+
+// Restore implements snapshots.Snapshotter. It restores a snapshot stream of
+// the given height into an empty store, verifies every restored substore
+// against its commit info and the commit info against the given app hash,
+// then loads the restored height.
+//
+// NOTE: a failed restore may leave unreferenced nodes behind, the database
+// should be discarded.
+func (rs *Store) Restore(height int64, appHash []byte, r io.Reader) error {
+	if rs.lastCommitID.Version != 0 || getLatestVersion(rs.db) != 0 {
+		return fmt.Errorf("cannot restore a snapshot into a non-empty store")
+	}
+
+	br := bufio.NewReader(r)
+	var cInfo commitInfo
+	if _, err := cdc.UnmarshalBinaryLengthPrefixedReader(br, &cInfo, maxSnapshotItemSize); err != nil {
+		return fmt.Errorf("failed to read commit info: %v", err)
+	}
+	if cInfo.Version != height {
+		return fmt.Errorf("snapshot commit info has height %d, expected %d", cInfo.Version, height)
+	}
+	if !bytes.Equal(cInfo.Hash(), appHash) {
+		return fmt.Errorf("snapshot app hash %X does not match the expected app hash %X", cInfo.Hash(), appHash)
+	}
+
+	dbs := make(map[string]dbm.DB, len(cInfo.StoreInfos))
+	for _, si := range cInfo.StoreInfos {
+		params, err := rs.snapshotStoreParams(si.Name)
+		if err != nil {
+			return err
+		}
+		dbs[si.Name] = rs.storeDB(params)
+	}
+
+	var (
+		batch dbm.Batch
+		store string
+		count int
+	)
+	for {
+		var item snapshotItem
+		n, err := cdc.UnmarshalBinaryLengthPrefixedReader(br, &item, maxSnapshotItemSize)
+		if err == io.EOF && n == 0 {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot: %v", err)
+		}
+
+		db, ok := dbs[item.Store]
+		if !ok {
+			return fmt.Errorf("unexpected store %s in snapshot", item.Store)
+		}
+		if err := iavl.ValidateExportedKey(height, item.Key); err != nil {
+			return fmt.Errorf("store %s: %v", item.Store, err)
+		}
+
+		if batch == nil || item.Store != store || count == snapshotBatchSize {
+			if batch != nil {
+				batch.Write()
+			}
+			batch, store, count = db.NewBatch(), item.Store, 0
+		}
+		batch.Set(item.Key, item.Value)
+		count++
+	}
+	if batch != nil {
+		batch.Write()
+	}
+
+	for _, si := range cInfo.StoreInfos {
+		if err := iavl.VerifyVersion(dbs[si.Name], height, si.Core.CommitID.Hash); err != nil {
+			return fmt.Errorf("failed to verify store %s: %v", si.Name, err)
+		}
+	}
+
+	batch = rs.db.NewBatch()
+	setCommitInfo(batch, height, cInfo)
+	setLatestVersion(batch, height)
+	batch.WriteSync()
+
+	return rs.LoadVersion(height)
+}
+
+// snapshotStoreParams returns the params of a mounted substore taking part
+// in snapshots.
+func (rs *Store) snapshotStoreParams(name string) (storeParams, error) {
+	key, ok := rs.keysByName[name]
+	if !ok {
+		return storeParams{}, fmt.Errorf("store %s is not mounted", name)
+	}
+	params := rs.storesParams[key]
+	if params.typ != types.StoreTypeIAVL {
+		return storeParams{}, fmt.Errorf("store %s is not an IAVL store, only IAVL stores can be snapshotted", name)
+	}
+	return params, nil
+}
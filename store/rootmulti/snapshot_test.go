@@ -0,0 +1,86 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"my-cosmos/cosmos-sdk/store/types"
+)
+
+func newSnapshotStore(t *testing.T) *Store {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, store.LoadLatestVersion())
+
+	for i := 0; i < 100; i++ {
+		store.getStoreByName("store1").(types.KVStore).Set([]byte(fmt.Sprintf("key%03d", i)), []byte("value1"))
+	}
+	store.getStoreByName("store2").(types.KVStore).Set([]byte("key"), []byte("value2"))
+	store.getStoreByName("store3").(types.KVStore).Set([]byte("key"), []byte("value3"))
+	store.Commit()
+	return store
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	store := newSnapshotStore(t)
+	commitID := store.LastCommitID()
+
+	var buf bytes.Buffer
+	appHash, err := store.Snapshot(1, &buf)
+	require.Nil(t, err)
+	require.Equal(t, commitID.Hash, appHash)
+
+	restored := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, restored.LoadLatestVersion())
+	require.Nil(t, restored.Restore(1, appHash, bytes.NewReader(buf.Bytes())))
+	require.Equal(t, commitID, restored.LastCommitID())
+	require.Equal(t, []byte("value1"), restored.getStoreByName("store1").(types.KVStore).Get([]byte("key042")))
+	require.Equal(t, []byte("value2"), restored.getStoreByName("store2").(types.KVStore).Get([]byte("key")))
+
+	// the restored store keeps committing the same state
+	for _, s := range []*Store{store, restored} {
+		s.getStoreByName("store2").(types.KVStore).Set([]byte("key2"), []byte("value2"))
+	}
+	require.Equal(t, store.Commit(), restored.Commit())
+
+	// a store can only be restored once
+	require.NotNil(t, restored.Restore(1, appHash, bytes.NewReader(buf.Bytes())))
+}
+
+func TestSnapshotRestoreInvalid(t *testing.T) {
+	store := newSnapshotStore(t)
+
+	var buf bytes.Buffer
+	appHash, err := store.Snapshot(1, &buf)
+	require.Nil(t, err)
+
+	// uncommitted height
+	_, err = store.Snapshot(2, &bytes.Buffer{})
+	require.NotNil(t, err)
+
+	// wrong app hash
+	restored := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, restored.LoadLatestVersion())
+	require.NotNil(t, restored.Restore(1, []byte("apphash"), bytes.NewReader(buf.Bytes())))
+
+	// wrong height
+	restored = newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, restored.LoadLatestVersion())
+	require.NotNil(t, restored.Restore(2, appHash, bytes.NewReader(buf.Bytes())))
+
+	// truncated snapshot
+	restored = newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, restored.LoadLatestVersion())
+	require.NotNil(t, restored.Restore(1, appHash, bytes.NewReader(buf.Bytes()[:buf.Len()/2])))
+
+	// the stream ends with the value of the store3 leaf, tamper with it
+	tampered := append([]byte{}, buf.Bytes()...)
+	tampered[len(tampered)-1]++
+	restored = newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, restored.LoadLatestVersion())
+	require.NotNil(t, restored.Restore(1, appHash, bytes.NewReader(tampered)))
+	require.Equal(t, int64(0), restored.LastCommitID().Version)
+}
@@ -3,6 +3,7 @@ package rootmulti
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -31,6 +32,7 @@ type Store struct {
 	db           dbm.DB
 	lastCommitID types.CommitID
 	pruningOpts  types.PruningOptions
+	storePruning map[string]types.PruningOptions // by store name, overrides pruningOpts
 	storesParams map[types.StoreKey]storeParams
 	stores       map[types.StoreKey]types.CommitStore
 	keysByName   map[string]types.StoreKey
@@ -46,6 +48,7 @@ var _ types.Queryable = (*Store)(nil)
 func NewStore(db dbm.DB) *Store {
 	return &Store{
 		db:           db,
+		storePruning: make(map[string]types.PruningOptions),
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		keysByName:   make(map[string]types.StoreKey),
@@ -55,11 +58,30 @@ func NewStore(db dbm.DB) *Store {
 // Implements CommitMultiStore
 func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
-	for _, substore := range rs.stores {
-		substore.SetPruning(pruningOpts)
+	for key, substore := range rs.stores {
+		substore.SetPruning(rs.storePruningOpts(key))
 	}
 }
 
+// Implements CommitMultiStore
+func (rs *Store) SetStorePruning(storeName string, pruningOpts types.PruningOptions) {
+	rs.storePruning[storeName] = pruningOpts
+	if key, ok := rs.keysByName[storeName]; ok {
+		if substore, ok := rs.stores[key]; ok {
+			substore.SetPruning(pruningOpts)
+		}
+	}
+}
+
+// storePruningOpts returns the pruning options of a store, its own ones if
+// set, the options of the multistore otherwise.
+func (rs *Store) storePruningOpts(key types.StoreKey) types.PruningOptions {
+	if opts, ok := rs.storePruning[key.Name()]; ok {
+		return opts
+	}
+	return rs.pruningOpts
+}
+
 // Implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -102,6 +124,12 @@ func (rs *Store) LoadLatestVersion() error {
 
 // Implements CommitMultiStore.
 func (rs *Store) LoadVersion(ver int64) error {
+	// Catch misspelled store names in the pruning configuration
+	for name := range rs.storePruning {
+		if _, ok := rs.keysByName[name]; !ok {
+			return fmt.Errorf("pruning options set for unknown store %s", name)
+		}
+	}
 
 	// Special logic for version 0
 	if ver == 0 {
@@ -211,6 +239,42 @@ func (rs *Store) Commit() types.CommitID {
 	return commitID
 }
 
+// PrunedVersions returns the versions pruned by the last commit which none of
+// the IAVL substores has any more. A version kept longer by some substores is
+// returned by the commit in which the last of them prunes it.
+func (rs *Store) PrunedVersions() []int64 {
+	var iavlStores []*iavl.Store
+	for _, store := range rs.stores {
+		if store, ok := store.(*iavl.Store); ok {
+			iavlStores = append(iavlStores, store)
+		}
+	}
+
+	seen := make(map[int64]bool)
+	var pruned []int64
+	for _, store := range iavlStores {
+		for _, version := range store.PrunedVersions() {
+			if seen[version] {
+				continue
+			}
+			seen[version] = true
+
+			exists := false
+			for _, other := range iavlStores {
+				if other.VersionExists(version) {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				pruned = append(pruned, version)
+			}
+		}
+	}
+	sort.Slice(pruned, func(i, j int) bool { return pruned[i] < pruned[j] })
+	return pruned
+}
+
 // Implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -233,6 +297,42 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
 }
 
+// Implements CommitMultiStore.
+// The IAVL stores are loaded read-only at the given version, while the other
+// stores, such as the transient ones, are used as they are. IAVL stores which
+// no longer have the version, as their own pruning options deleted it, panic
+// with an ErrorPrunedVersion when accessed, so that the other stores can still
+// be queried.
+func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	loaded, pruned := 0, 0
+	for k, v := range rs.stores {
+		if v.GetStoreType() != types.StoreTypeIAVL {
+			stores[k] = v
+			continue
+		}
+
+		iavlStore := v.(*iavl.Store)
+		if !iavlStore.VersionExists(version) {
+			stores[k] = prunedStore{name: k.Name(), version: version}
+			pruned++
+			continue
+		}
+
+		store, err := iavlStore.GetImmutable(version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load store %s at version %d: %v", k.Name(), version, err)
+		}
+		stores[k] = store
+		loaded++
+	}
+
+	if pruned > 0 && loaded == 0 {
+		return nil, fmt.Errorf("no store has version %d", version)
+	}
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+}
+
 // Implements MultiStore.
 // If the store does not exist, panics.
 func (rs *Store) GetStore(key types.StoreKey) types.Store {
@@ -346,20 +446,23 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 
 //----------------------------------------
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
-	var db dbm.DB
+// storeDB returns the database of a substore.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		db = dbm.NewPrefixDB(rs.db, []byte("s/k:"+params.key.Name()+"/"))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
+	return dbm.NewPrefixDB(rs.db, []byte("s/k:"+params.key.Name()+"/"))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
+	db := rs.storeDB(params)
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
 		// TODO: id?
 		// return NewCommitMultiStore(db, id)
 	case types.StoreTypeIAVL:
-		store, err = iavl.LoadStore(db, id, rs.pruningOpts)
+		store, err = iavl.LoadStore(db, id, rs.storePruningOpts(key))
 		return
 	case types.StoreTypeDB:
 		store = commitDBStoreAdapter{dbadapter.Store{db}}
@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,6 +10,7 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"my-cosmos/cosmos-sdk/store/errors"
+	"my-cosmos/cosmos-sdk/store/iavl"
 	"my-cosmos/cosmos-sdk/store/types"
 )
 
@@ -100,6 +102,104 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestCacheMultiStoreWithVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+
+	k, v1, v2 := []byte("key"), []byte("value1"), []byte("value2")
+	store1 := store.getStoreByName("store1").(types.KVStore)
+
+	store1.Set(k, v1)
+	store.Commit()
+	store1.Set(k, v2)
+	store.Commit()
+
+	// the stores are loaded at each version
+	cacheMS, err := store.CacheMultiStoreWithVersion(1)
+	require.Nil(t, err)
+	require.Equal(t, v1, cacheMS.GetKVStore(store.keysByName["store1"]).Get(k))
+
+	cacheMS, err = store.CacheMultiStoreWithVersion(2)
+	require.Nil(t, err)
+	require.Equal(t, v2, cacheMS.GetKVStore(store.keysByName["store1"]).Get(k))
+
+	// writes stay in the cache
+	cacheMS.GetKVStore(store.keysByName["store1"]).Set(k, v1)
+	require.Equal(t, v2, store1.Get(k))
+
+	// a version which was not committed can not be loaded
+	_, err = store.CacheMultiStoreWithVersion(3)
+	require.NotNil(t, err)
+}
+
+func TestMultistoreStorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.PruneEverything)
+	store.SetStorePruning("store1", types.PruneNothing)
+	store.SetStorePruning("store2", types.PruneEverything.WithKeepUntil(2))
+	require.Nil(t, store.LoadLatestVersion())
+
+	for i := 0; i < 4; i++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			store.getStoreByName(name).(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		}
+		store.Commit()
+	}
+
+	versionExists := func(name string, version int64) bool {
+		return store.getStoreByName(name).(*iavl.Store).VersionExists(version)
+	}
+	for version := int64(1); version <= 3; version++ {
+		require.True(t, versionExists("store1", version))
+		require.Equal(t, version <= 2, versionExists("store2", version))
+		require.False(t, versionExists("store3", version))
+	}
+
+	// the stores which have a past version can be read at it, but not the
+	// ones which have pruned it
+	cacheMS, err := store.CacheMultiStoreWithVersion(3)
+	require.Nil(t, err)
+	require.Equal(t, []byte("value2"), cacheMS.GetKVStore(store.keysByName["store1"]).Get([]byte("key")))
+	require.PanicsWithValue(t, types.ErrorPrunedVersion{StoreName: "store2", Version: 3}, func() {
+		cacheMS.GetKVStore(store.keysByName["store2"]).Get([]byte("key"))
+	})
+
+	// the options of a store are kept when the store is reloaded or the
+	// options of the multistore change
+	store = newMultiStoreWithMounts(db)
+	store.SetStorePruning("store1", types.PruneNothing)
+	require.Nil(t, store.LoadLatestVersion())
+	store.SetPruning(types.PruneEverything)
+	store.Commit()
+	require.True(t, versionExists("store1", 4))
+	require.False(t, versionExists("store3", 4))
+
+	// the store must be mounted
+	store = newMultiStoreWithMounts(db)
+	store.SetStorePruning("store4", types.PruneNothing)
+	require.NotNil(t, store.LoadLatestVersion())
+}
+
+func TestMultistorePrunedVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.NewPruningOptions(1, 0))
+	store.SetStorePruning("store1", types.NewPruningOptions(2, 0))
+	require.Nil(t, store.LoadLatestVersion())
+
+	var pruned [][]int64
+	for i := 0; i < 5; i++ {
+		store.Commit()
+		pruned = append(pruned, store.PrunedVersions())
+	}
+
+	// a version is reported once the store keeping the most recent versions
+	// prunes it as well
+	require.Equal(t, [][]int64{nil, nil, nil, {1}, {2}}, pruned)
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
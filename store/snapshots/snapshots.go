@@ -0,0 +1,107 @@
+/*
+Package snapshots implements local state-sync snapshots of a multistore.
+
+A snapshot of a given height is a directory holding the snapshot stream
+produced by a Snapshotter, split into numbered chunk files, along with a
+manifest.json describing it:
+
+	<dir>/<height>/manifest.json
+	<dir>/<height>/0
+	<dir>/<height>/1
+	...
+
+The manifest records the hash of every chunk and the app hash of the state
+at that height. Chunks are checked against the manifest before a snapshot
+is restored, and the Snapshotter verifies the restored state against the
+app hash, which should come from a trusted block header rather than the
+manifest itself whenever possible.
+*/
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// CurrentFormat is the format of the snapshots created by this version.
+	CurrentFormat uint32 = 1
+
+	// DefaultChunkSize is the maximum size of a chunk file.
+	DefaultChunkSize = 16 << 20 // 16 MiB
+)
+
+// Snapshotter is implemented by stores able to produce a snapshot of their
+// state and to restore it.
+type Snapshotter interface {
+	// Snapshot writes the snapshot stream of the state at the given height to
+	// w and returns the app hash of that state. It may run concurrently with
+	// new commits, provided that the height is held.
+	Snapshot(height int64, w io.Writer) (appHash []byte, err error)
+
+	// HoldHeight keeps the state at the given height from being pruned until
+	// ReleaseHeight is called with it.
+	HoldHeight(height int64)
+
+	// ReleaseHeight releases a height held by HoldHeight.
+	ReleaseHeight(height int64)
+
+	// Restore restores the state at the given height from a snapshot stream,
+	// and verifies it against the given app hash.
+	Restore(height int64, appHash []byte, r io.Reader) error
+}
+
+// Manifest describes a snapshot.
+type Manifest struct {
+	Height  int64          `json:"height"`
+	Format  uint32         `json:"format"`
+	AppHash cmn.HexBytes   `json:"app_hash"`
+	Chunks  []cmn.HexBytes `json:"chunks"` // hash of each chunk
+	Hash    cmn.HexBytes   `json:"hash"`   // hash of the chunk hashes
+}
+
+// ChunksHash returns the hash of the snapshot, computed over the hashes of
+// its chunks.
+func (m Manifest) ChunksHash() []byte {
+	hasher := tmhash.New()
+	for _, chunk := range m.Chunks {
+		hasher.Write(chunk) // nolint: errcheck
+	}
+	return hasher.Sum(nil)
+}
+
+// ValidateBasic performs basic validation of the manifest.
+func (m Manifest) ValidateBasic() error {
+	if m.Height <= 0 {
+		return fmt.Errorf("invalid snapshot height %d", m.Height)
+	}
+	if m.Format != CurrentFormat {
+		return fmt.Errorf("unsupported snapshot format %d, expected %d", m.Format, CurrentFormat)
+	}
+	if len(m.AppHash) == 0 {
+		return errors.New("snapshot app hash cannot be empty")
+	}
+	if len(m.Chunks) == 0 {
+		return errors.New("snapshot has no chunks")
+	}
+	for i, chunk := range m.Chunks {
+		if len(chunk) != tmhash.Size {
+			return fmt.Errorf("invalid hash of chunk %d", i)
+		}
+	}
+	if !bytes.Equal(m.Hash, m.ChunksHash()) {
+		return errors.New("snapshot hash does not match its chunks")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer.
+func (m Manifest) String() string {
+	return fmt.Sprintf("height %d, format %d, %d chunks, hash %s, app hash %s",
+		m.Height, m.Format, len(m.Chunks), m.Hash, m.AppHash)
+}
@@ -0,0 +1,290 @@
+package snapshots
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+const manifestFile = "manifest.json"
+
+// Store manages the snapshots kept in a local directory.
+type Store struct {
+	dir       string
+	chunkSize int64
+}
+
+// NewStore returns a snapshot store in the given directory, which is
+// created on demand. A non-positive chunk size means DefaultChunkSize.
+func NewStore(dir string, chunkSize int64) *Store {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	return &Store{dir: dir, chunkSize: chunkSize}
+}
+
+// Dir returns the directory of the store.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+func (s *Store) snapshotDir(height int64) string {
+	return filepath.Join(s.dir, strconv.FormatInt(height, 10))
+}
+
+func (s *Store) chunkPath(height int64, chunk int) string {
+	return filepath.Join(s.snapshotDir(height), strconv.Itoa(chunk))
+}
+
+// Create takes a snapshot of the given height from the snapshotter and saves
+// it in the store. The snapshot is written to a temporary directory which is
+// only moved in place once complete.
+func (s *Store) Create(source Snapshotter, height int64) (Manifest, error) {
+	if height <= 0 {
+		return Manifest{}, fmt.Errorf("invalid snapshot height %d", height)
+	}
+	dir := s.snapshotDir(height)
+	if _, err := os.Stat(dir); err == nil {
+		return Manifest{}, fmt.Errorf("snapshot of height %d already exists", height)
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return Manifest{}, err
+	}
+
+	tmpDir, err := ioutil.TempDir(s.dir, ".tmp-")
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	w := &chunkWriter{dir: tmpDir, chunkSize: s.chunkSize}
+	appHash, err := source.Snapshot(height, w)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to take snapshot of height %d: %v", height, err)
+	}
+
+	manifest := Manifest{
+		Height:  height,
+		Format:  CurrentFormat,
+		AppHash: appHash,
+		Chunks:  w.hashes,
+	}
+	manifest.Hash = manifest.ChunksHash()
+
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, manifestFile), bz, 0644); err != nil {
+		return Manifest{}, err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return Manifest{}, err
+	}
+	return manifest, nil
+}
+
+// Get returns the manifest of the snapshot of the given height.
+func (s *Store) Get(height int64) (Manifest, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(s.snapshotDir(height), manifestFile))
+	if os.IsNotExist(err) {
+		return Manifest{}, fmt.Errorf("no snapshot of height %d in %s", height, s.dir)
+	}
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("invalid manifest of snapshot %d: %v", height, err)
+	}
+	if manifest.Height != height {
+		return Manifest{}, fmt.Errorf("manifest of snapshot %d has height %d", height, manifest.Height)
+	}
+	return manifest, nil
+}
+
+// List returns the manifests of all the snapshots in the store, the most
+// recent first.
+func (s *Store) List() ([]Manifest, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []Manifest
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if !entry.IsDir() || err != nil {
+			// skip temporary directories and unrelated files
+			continue
+		}
+		manifest, err := s.Get(height)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Height > manifests[j].Height
+	})
+	return manifests, nil
+}
+
+// Delete removes the snapshot of the given height.
+func (s *Store) Delete(height int64) error {
+	if _, err := s.Get(height); err != nil {
+		return err
+	}
+	return os.RemoveAll(s.snapshotDir(height))
+}
+
+// Prune deletes all but the given number of most recent snapshots. Zero
+// keeps all of them.
+func (s *Store) Prune(keepRecent int) error {
+	if keepRecent <= 0 {
+		return nil
+	}
+	manifests, err := s.List()
+	if err != nil {
+		return err
+	}
+	for i := keepRecent; i < len(manifests); i++ {
+		if err := s.Delete(manifests[i].Height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore checks the snapshot of the given height against its manifest and
+// restores it into the snapshotter. The restored state is verified against
+// the given app hash, or against the one of the manifest if empty.
+func (s *Store) Restore(target Snapshotter, height int64, appHash []byte) (Manifest, error) {
+	manifest, err := s.Get(height)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := manifest.ValidateBasic(); err != nil {
+		return Manifest{}, err
+	}
+	if len(appHash) == 0 {
+		appHash = manifest.AppHash
+	} else if !bytes.Equal(appHash, manifest.AppHash) {
+		return Manifest{}, fmt.Errorf("snapshot app hash %s does not match the expected app hash %X",
+			manifest.AppHash, appHash)
+	}
+
+	// check every chunk before restoring anything
+	readers := make([]io.Reader, len(manifest.Chunks))
+	for i, chunkHash := range manifest.Chunks {
+		f, err := os.Open(s.chunkPath(height, i))
+		if err != nil {
+			return Manifest{}, err
+		}
+		defer f.Close() // nolint: errcheck
+
+		hasher := tmhash.New()
+		if _, err := io.Copy(hasher, f); err != nil {
+			return Manifest{}, err
+		}
+		if !bytes.Equal(hasher.Sum(nil), chunkHash) {
+			return Manifest{}, fmt.Errorf("chunk %d of snapshot %d does not match its hash", i, height)
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return Manifest{}, err
+		}
+		readers[i] = f
+	}
+
+	if err := target.Restore(height, appHash, io.MultiReader(readers...)); err != nil {
+		return Manifest{}, fmt.Errorf("failed to restore snapshot of height %d: %v", height, err)
+	}
+	return manifest, nil
+}
+
+// chunkWriter splits a snapshot stream into chunk files of a maximum size,
+// hashing each of them.
+type chunkWriter struct {
+	dir       string
+	chunkSize int64
+
+	file    *os.File
+	written int64
+	hashes  []cmn.HexBytes
+	hasher  hash.Hash
+}
+
+func (w *chunkWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		if w.file == nil {
+			if err := w.openChunk(); err != nil {
+				return n, err
+			}
+		}
+
+		size := int64(len(p))
+		if size > w.chunkSize-w.written {
+			size = w.chunkSize - w.written
+		}
+		written, err := io.MultiWriter(w.file, w.hasher).Write(p[:size])
+		n += written
+		w.written += int64(written)
+		if err != nil {
+			return n, err
+		}
+		p = p[size:]
+
+		if w.written == w.chunkSize {
+			if err := w.closeChunk(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close closes the current chunk. A snapshot always has at least one chunk.
+func (w *chunkWriter) Close() error {
+	if w.file == nil && len(w.hashes) == 0 {
+		if err := w.openChunk(); err != nil {
+			return err
+		}
+	}
+	if w.file == nil {
+		return nil
+	}
+	return w.closeChunk()
+}
+
+func (w *chunkWriter) openChunk() (err error) {
+	path := filepath.Join(w.dir, strconv.Itoa(len(w.hashes)))
+	w.file, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	w.written = 0
+	w.hasher = tmhash.New()
+	return err
+}
+
+func (w *chunkWriter) closeChunk() error {
+	err := w.file.Close()
+	w.file = nil
+	w.hashes = append(w.hashes, w.hasher.Sum(nil))
+	return err
+}
@@ -0,0 +1,113 @@
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// mockSnapshotter snapshots an opaque state, hashed as its app hash.
+type mockSnapshotter struct {
+	state    []byte
+	restored []byte
+}
+
+func (m *mockSnapshotter) Snapshot(height int64, w io.Writer) ([]byte, error) {
+	_, err := w.Write(m.state)
+	return tmhash.Sum(m.state), err
+}
+
+func (m *mockSnapshotter) HoldHeight(height int64)    {}
+func (m *mockSnapshotter) ReleaseHeight(height int64) {}
+
+func (m *mockSnapshotter) Restore(height int64, appHash []byte, r io.Reader) error {
+	state, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(tmhash.Sum(state), appHash) {
+		return errors.New("app hash mismatch")
+	}
+	m.restored = state
+	return nil
+}
+
+func newTestStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+	return NewStore(filepath.Join(dir, "snapshots"), 10), func() { os.RemoveAll(dir) }
+}
+
+func TestStoreCreateRestore(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	source := &mockSnapshotter{state: []byte("a state spanning several chunks")}
+	manifest, err := store.Create(source, 5)
+	require.Nil(t, err)
+	require.Nil(t, manifest.ValidateBasic())
+	require.Len(t, manifest.Chunks, 4)
+	require.Equal(t, tmhash.Sum(source.state), []byte(manifest.AppHash))
+
+	_, err = store.Create(source, 5)
+	require.NotNil(t, err)
+
+	stored, err := store.Get(5)
+	require.Nil(t, err)
+	require.Equal(t, manifest, stored)
+
+	target := &mockSnapshotter{}
+	_, err = store.Restore(target, 5, nil)
+	require.Nil(t, err)
+	require.Equal(t, source.state, target.restored)
+
+	// the expected app hash must match the snapshot
+	_, err = store.Restore(target, 5, tmhash.Sum([]byte("other")))
+	require.NotNil(t, err)
+
+	// a tampered chunk is rejected before restoring
+	require.Nil(t, ioutil.WriteFile(store.chunkPath(5, 1), []byte("tampered!!"), 0644))
+	target = &mockSnapshotter{}
+	_, err = store.Restore(target, 5, nil)
+	require.NotNil(t, err)
+	require.Nil(t, target.restored)
+
+	_, err = store.Restore(target, 6, nil)
+	require.NotNil(t, err)
+}
+
+func TestStoreListPrune(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	manifests, err := store.List()
+	require.Nil(t, err)
+	require.Empty(t, manifests)
+
+	source := &mockSnapshotter{state: []byte("state")}
+	for _, height := range []int64{10, 30, 20} {
+		_, err := store.Create(source, height)
+		require.Nil(t, err)
+	}
+
+	manifests, err = store.List()
+	require.Nil(t, err)
+	require.Len(t, manifests, 3)
+	require.Equal(t, int64(30), manifests[0].Height)
+	require.Equal(t, int64(10), manifests[2].Height)
+
+	require.Nil(t, store.Prune(2))
+	manifests, err = store.List()
+	require.Nil(t, err)
+	require.Len(t, manifests, 2)
+	require.Equal(t, int64(20), manifests[1].Height)
+
+	require.NotNil(t, store.Delete(10))
+}
@@ -1,6 +1,10 @@
 package store
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"my-cosmos/cosmos-sdk/store/rootmulti"
@@ -24,3 +28,47 @@ func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
 	}
 	return
 }
+
+// ParsePruningOptions parses a pruning strategy: "nothing", "everything" or
+// "syncable", optionally followed by comma-separated keep-recent=<n>,
+// keep-every=<n> and keep-until=<height> settings which override the ones of
+// the strategy, e.g. "syncable,keep-until=1000000". The strategy may be left
+// out, its settings then default to zero as with "everything".
+func ParsePruningOptions(s string) (PruningOptions, error) {
+	fields := strings.Split(s, ",")
+	recent, every, until := int64(0), int64(0), int64(0)
+
+	switch strings.TrimSpace(fields[0]) {
+	case "nothing":
+		recent, every = PruneNothing.KeepRecent(), PruneNothing.KeepEvery()
+		fields = fields[1:]
+	case "everything":
+		fields = fields[1:]
+	case "syncable":
+		recent, every = PruneSyncable.KeepRecent(), PruneSyncable.KeepEvery()
+		fields = fields[1:]
+	}
+
+	for _, field := range fields {
+		kv := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		if len(kv) != 2 {
+			return PruningOptions{}, fmt.Errorf("invalid pruning setting %q", field)
+		}
+		n, err := strconv.ParseInt(kv[1], 10, 64)
+		if err != nil || n < 0 {
+			return PruningOptions{}, fmt.Errorf("invalid value of pruning setting %q", field)
+		}
+		switch kv[0] {
+		case "keep-recent":
+			recent = n
+		case "keep-every":
+			every = n
+		case "keep-until":
+			until = n
+		default:
+			return PruningOptions{}, fmt.Errorf("unknown pruning setting %q", kv[0])
+		}
+	}
+
+	return types.NewPruningOptions(recent, every).WithKeepUntil(until), nil
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"my-cosmos/cosmos-sdk/store/types"
+)
+
+func TestParsePruningOptions(t *testing.T) {
+	cases := []struct {
+		strategy string
+		expected PruningOptions
+		expErr   bool
+	}{
+		{"nothing", PruneNothing, false},
+		{"everything", PruneEverything, false},
+		{"syncable", PruneSyncable, false},
+		{"syncable,keep-until=1000", PruneSyncable.WithKeepUntil(1000), false},
+		{"everything, keep-until=5", PruneEverything.WithKeepUntil(5), false},
+		{"keep-recent=10,keep-every=100", types.NewPruningOptions(10, 100), false},
+		{"nothing,keep-recent=10", types.NewPruningOptions(10, 1), false},
+		{"", PruningOptions{}, true},
+		{"some", PruningOptions{}, true},
+		{"syncable,keep-until", PruningOptions{}, true},
+		{"syncable,keep-until=-1", PruningOptions{}, true},
+		{"syncable,keep-older=1", PruningOptions{}, true},
+	}
+
+	for _, tc := range cases {
+		opts, err := ParsePruningOptions(tc.strategy)
+		if tc.expErr {
+			require.NotNil(t, err, tc.strategy)
+			continue
+		}
+		require.Nil(t, err, tc.strategy)
+		require.Equal(t, tc.expected, opts, tc.strategy)
+	}
+}
@@ -0,0 +1,58 @@
+package tracekv
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// The operations of a trace, see TracedOperation.
+const (
+	OpWrite     = string(writeOp)
+	OpRead      = string(readOp)
+	OpDelete    = string(deleteOp)
+	OpIterKey   = string(iterKeyOp)
+	OpIterValue = string(iterValueOp)
+)
+
+// TracedOperation is a KVStore operation read back from a trace written by a
+// Store, with its key and value decoded.
+type TracedOperation struct {
+	Operation string
+	Key       []byte
+	Value     []byte
+	Metadata  map[string]interface{}
+}
+
+// ReadOperations reads all the operations of a trace written by a Store.
+func ReadOperations(r io.Reader) ([]TracedOperation, error) {
+	var ops []TracedOperation
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var traceOp traceOperation
+		if err := json.Unmarshal(scanner.Bytes(), &traceOp); err != nil {
+			return nil, fmt.Errorf("invalid trace operation on line %d: %v", line, err)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(traceOp.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key on line %d: %v", line, err)
+		}
+		value, err := base64.StdEncoding.DecodeString(traceOp.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value on line %d: %v", line, err)
+		}
+
+		ops = append(ops, TracedOperation{
+			Operation: string(traceOp.Operation),
+			Key:       key,
+			Value:     value,
+			Metadata:  traceOp.Metadata,
+		})
+	}
+	return ops, scanner.Err()
+}
@@ -292,3 +292,27 @@ func TestTraceKVStoreCacheWrapWithTrace(t *testing.T) {
 	store := newEmptyTraceKVStore(nil)
 	require.Panics(t, func() { store.CacheWrapWithTrace(nil, nil) })
 }
+
+func TestReadOperations(t *testing.T) {
+	var buf bytes.Buffer
+	store := newTraceKVStore(&buf)
+	store.Get(kvPairs[0].Key)
+	store.Delete(kvPairs[1].Key)
+
+	ops, err := tracekv.ReadOperations(&buf)
+	require.Nil(t, err)
+	require.Len(t, ops, 5)
+	for i, kvPair := range kvPairs {
+		require.Equal(t, tracekv.OpWrite, ops[i].Operation)
+		require.Equal(t, kvPair.Key, ops[i].Key)
+		require.Equal(t, kvPair.Value, ops[i].Value)
+	}
+	require.Equal(t, tracekv.OpRead, ops[3].Operation)
+	require.Equal(t, kvPairs[0].Value, ops[3].Value)
+	require.Equal(t, tracekv.OpDelete, ops[4].Operation)
+	require.Equal(t, kvPairs[1].Key, ops[4].Key)
+	require.Equal(t, float64(64), ops[4].Metadata["blockHeight"])
+
+	_, err = tracekv.ReadOperations(bytes.NewBufferString("{\"operation\":\"read\",\"key\":\"%\"}\n"))
+	require.NotNil(t, err)
+}
@@ -5,6 +5,7 @@ package types
 type PruningOptions struct {
 	keepRecent int64
 	keepEvery  int64
+	keepUntil  int64
 }
 
 func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
@@ -24,6 +25,18 @@ func (po PruningOptions) KeepEvery() int64 {
 	return po.keepEvery
 }
 
+// Keeps every state up to this height, the strategy applies to later ones.
+func (po PruningOptions) KeepUntil() int64 {
+	return po.keepUntil
+}
+
+// WithKeepUntil returns a copy of the options which keeps every state up to
+// and including the given height.
+func (po PruningOptions) WithKeepUntil(height int64) PruningOptions {
+	po.keepUntil = height
+	return po
+}
+
 // default pruning strategies
 var (
 	// PruneEverything means all saved states will be deleted, storing only the current state
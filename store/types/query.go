@@ -0,0 +1,67 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// MaxSubspaceQueryLimit is the maximum number of key/value pairs returned by
+// a single "/subspace" store query. It is also the limit of queries which
+// don't set one.
+const MaxSubspaceQueryLimit = 1000
+
+// QuerySubspaceParams is the request data of a "/subspace" store query. The
+// key/value pairs under Prefix are returned in ascending key order from
+// StartKey on, at most Limit of them.
+type QuerySubspaceParams struct {
+	Prefix   []byte `json:"prefix"`
+	StartKey []byte `json:"start_key"` // empty to start from Prefix
+	Limit    uint64 `json:"limit"`     // zero for MaxSubspaceQueryLimit
+}
+
+// NewQuerySubspaceParams creates a new QuerySubspaceParams instance.
+func NewQuerySubspaceParams(prefix, startKey []byte, limit uint64) QuerySubspaceParams {
+	return QuerySubspaceParams{
+		Prefix:   prefix,
+		StartKey: startKey,
+		Limit:    limit,
+	}
+}
+
+// ValidateBasic checks that the start key is under the prefix.
+func (params QuerySubspaceParams) ValidateBasic() error {
+	if len(params.StartKey) > 0 && !bytes.HasPrefix(params.StartKey, params.Prefix) {
+		return fmt.Errorf("start key %X is not under prefix %X", params.StartKey, params.Prefix)
+	}
+	return nil
+}
+
+// GetLimit returns the limit of the query, capped to MaxSubspaceQueryLimit.
+func (params QuerySubspaceParams) GetLimit() uint64 {
+	if params.Limit == 0 || params.Limit > MaxSubspaceQueryLimit {
+		return MaxSubspaceQueryLimit
+	}
+	return params.Limit
+}
+
+// QuerySubspaceResult is the response value of a "/subspace" store query.
+// It repeats the prefix and start key of the query, so that a range proof
+// can be verified against the value alone. NextKey is the start key of the
+// next page, empty once all the keys under the prefix have been returned.
+type QuerySubspaceResult struct {
+	Prefix   []byte   `json:"prefix"`
+	StartKey []byte   `json:"start_key"`
+	KVs      []KVPair `json:"kvs"`
+	NextKey  []byte   `json:"next_key"`
+}
+
+// SubspaceRange returns the inclusive start and exclusive end of the keys
+// under a prefix from a start key on. An empty start key starts from the
+// prefix. The end is nil when the prefix has no upper bound.
+func SubspaceRange(prefix, startKey []byte) (start, end []byte) {
+	start = prefix
+	if len(startKey) > 0 {
+		start = startKey
+	}
+	return start, PrefixEndBytes(prefix)
+}
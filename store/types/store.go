@@ -80,6 +80,10 @@ type CommitMultiStore interface {
 	// If db == nil, the new store will use the CommitMultiStore db.
 	MountStoreWithDB(key StoreKey, typ StoreType, db dbm.DB)
 
+	// Set the pruning options of the store of the given name, overriding
+	// the options set with SetPruning.
+	SetStorePruning(storeName string, opts PruningOptions)
+
 	// Panics on a nil key.
 	GetCommitStore(key StoreKey) CommitStore
 
@@ -95,6 +99,22 @@ type CommitMultiStore interface {
 	// the next commit after loading must be idempotent (return the
 	// same commit id).  Otherwise the behavior is undefined.
 	LoadVersion(ver int64) error
+
+	// CacheMultiStoreWithVersion is analogous to CacheMultiStore except that
+	// the stores are loaded at a given version (height). It returns an error
+	// if no store has the version, for instance because it has been pruned.
+	// Stores which have been pruned on their own panic with an
+	// ErrorPrunedVersion when accessed. It is meant for queries at past
+	// heights.
+	CacheMultiStoreWithVersion(version int64) (CacheMultiStore, error)
+}
+
+// ErrorPrunedVersion is the panic value of accessing a store of a
+// CacheMultiStoreWithVersion whose version has been pruned, while other stores
+// still have it.
+type ErrorPrunedVersion struct {
+	StoreName string
+	Version   int64
 }
 
 //---------subsp-------------------------------
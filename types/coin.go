@@ -499,8 +499,10 @@ func (coins Coins) Sort() Coins {
 // Parsing
 
 var (
-	// Denominations can be 3 ~ 16 characters long.
-	reDnmString = `[a-z][a-z0-9]{2,15}`
+	// Denominations can be 3 ~ 64 characters long. IBC vouchers prefix the
+	// denomination of the coin they represent with the channels it travelled
+	// through, separated by '/'.
+	reDnmString = `[a-z][a-z0-9/]{2,63}`
 	reAmt       = `[[:digit:]]+`
 	reDecAmt    = `[[:digit:]]*\.[[:digit:]]+`
 	reSpc       = `[[:space:]]*`
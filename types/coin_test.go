@@ -397,6 +397,7 @@ func TestParse(t *testing.T) {
 		{"11me coin, 12you coin", false, nil}, // no spaces in coin names
 		{"1.2btc", false, nil},                // amount must be integer
 		{"5foo-bar", false, nil},              // once more, only letters in coin name
+		{"3chana/uatom", true, Coins{{"chana/uatom", NewInt(3)}}},
 	}
 
 	for tcIndex, tc := range cases {
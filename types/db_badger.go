@@ -0,0 +1,15 @@
+// +build badgerdb
+
+package types
+
+import (
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"my-cosmos/cosmos-sdk/store/badgerdb"
+)
+
+func init() {
+	dbCreators[badgerdb.Backend] = func(name, dir string) (dbm.DB, error) {
+		return badgerdb.NewDB(name, dir)
+	}
+}
@@ -98,6 +98,18 @@ func InclusiveEndBytes(inclusiveBytes []byte) (exclusiveBytes []byte) {
 // key-value result for iterator queries
 type KVPair = types.KVPair
 
+// request data and response value of "/subspace" store queries
+type (
+	QuerySubspaceParams = types.QuerySubspaceParams
+	QuerySubspaceResult = types.QuerySubspaceResult
+)
+
+// NewQuerySubspaceParams creates the request data of a "/subspace" store
+// query.
+func NewQuerySubspaceParams(prefix, startKey []byte, limit uint64) QuerySubspaceParams {
+	return types.NewQuerySubspaceParams(prefix, startKey, limit)
+}
+
 //----------------------------------------
 
 // TraceContext contains TraceKVStore context data. It will be written with
@@ -120,8 +132,9 @@ func NewGasMeter(limit Gas) GasMeter {
 
 // nolint - reexport
 type (
-	ErrorOutOfGas    = types.ErrorOutOfGas
-	ErrorGasOverflow = types.ErrorGasOverflow
+	ErrorOutOfGas      = types.ErrorOutOfGas
+	ErrorGasOverflow   = types.ErrorGasOverflow
+	ErrorPrunedVersion = types.ErrorPrunedVersion
 )
 
 // nolint - reexport
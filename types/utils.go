@@ -71,14 +71,31 @@ func ParseTimeBytes(bz []byte) (time.Time, error) {
 // NewLevelDB:
 // 根据DBBackend实例化一个新的LevelDB实例。
 func NewLevelDB(name, dir string) (db dbm.DB, err error) {
-	backend := dbm.GoLevelDBBackend
-	if DBBackend == string(dbm.CLevelDBBackend) {
-		backend = dbm.CLevelDBBackend
+	return NewDB(name, "", dir)
+}
+
+// dbCreators holds the database backends which are not provided by Tendermint.
+// They register themselves when built in, see db_badger.go.
+var dbCreators = make(map[string]func(name, dir string) (dbm.DB, error))
+
+// NewDB instantiates a new database of the given backend: goleveldb,
+// cleveldb (if built with the gcc tag), badgerdb (if built with the badgerdb
+// tag), memdb or fsdb. An empty backend selects the LevelDB backend of
+// DBBackend.
+func NewDB(name, backend, dir string) (db dbm.DB, err error) {
+	if backend == "" {
+		backend = string(dbm.GoLevelDBBackend)
+		if DBBackend == string(dbm.CLevelDBBackend) {
+			backend = string(dbm.CLevelDBBackend)
+		}
+	}
+	if creator, ok := dbCreators[backend]; ok {
+		return creator(name, dir)
 	}
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("couldn't create db: %v", r)
 		}
 	}()
-	return dbm.NewDB(name, backend, dir), err
+	return dbm.NewDB(name, dbm.DBBackendType(backend), dir), err
 }
@@ -64,3 +64,13 @@ func TestTimeFormatAndParse(t *testing.T) {
 		require.Equal(t, timeFromRFC.Format(SortableTimeFormat), tc.SDKSortableTimeStr)
 	}
 }
+
+func TestNewDB(t *testing.T) {
+	db, err := NewDB("test", "memdb", "")
+	require.Nil(t, err)
+	db.Set([]byte("key"), []byte("value"))
+	require.Equal(t, []byte("value"), db.Get([]byte("key")))
+
+	_, err = NewDB("test", "nodb", "")
+	require.NotNil(t, err)
+}
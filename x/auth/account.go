@@ -3,6 +3,7 @@ package auth
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -523,3 +524,164 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// Period defines a length of time, in seconds, and the coins that vest once it
+// has elapsed.
+type Period struct {
+	Length int64     `json:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount"` // amount of coins vesting at the end of the period
+}
+
+// String implements fmt.Stringer
+func (p Period) String() string {
+	return fmt.Sprintf(`Length: %d
+  Amount: %s`, p.Length, p.Amount)
+}
+
+// Periods defines a vesting schedule as consecutive periods.
+type Periods []Period
+
+// TotalLength returns the summed length of all periods
+func (p Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range p {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the coins vesting in all periods
+func (p Periods) TotalAmount() sdk.Coins {
+	total := sdk.Coins{}
+	for _, period := range p {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// String implements fmt.Stringer
+func (p Periods) String() string {
+	periods := make([]string, len(p))
+	for i, period := range p {
+		periods[i] = period.String()
+	}
+	return strings.Join(periods, "\n  ")
+}
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests
+// coins according to a schedule of consecutive periods starting at StartTime:
+// the coins of a period vest all at once at the end of that period.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the first period starts
+	VestingPeriods Periods `json:"vesting_periods"` // the vesting schedule
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount vesting the
+// coins of all periods. The end time is the end of the last period.
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, StartTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         StartTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          StartTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingPeriods:
+  %s`,
+		pva.Address, pubkey, pva.Coins, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, pva.VestingPeriods,
+	)
+}
+
+// GetVestedCoins returns the total number of vested coins: the coins of every
+// period that has elapsed. If no coins are vested, nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	periodEnd := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		periodEnd += period.Length
+		if blockTime.Unix() < periodEnd {
+			break
+		}
+		vestedCoins = vestedCoins.Add(period.Amount)
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins for a periodic
+// vesting account.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting schedule of a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
@@ -27,15 +27,28 @@ func init() {
 	copy(simSecp256k1Pubkey[:], bz)
 }
 
+// FeeGrantKeeper charges the fees of a transaction to the allowance a granter
+// gave the fee payer.
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error
+}
+
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
-// numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// numbers, checks signatures & account numbers, and deducts fees from the fee
+// payer. Transactions whose fee names a granter are rejected.
 /*
 TODO 重要
 NewAnteHandler
 返回一个AnteHandler，它检查并递增序列号，检查签名和帐号，并从第一个签名者中扣除费用
 */
 func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
+	return NewAnteHandlerWithFeeGrants(ak, fck, nil)
+}
+
+// NewAnteHandlerWithFeeGrants returns an AnteHandler like NewAnteHandler that
+// also accepts transactions whose fees are paid by a granter out of the fee
+// allowance it gave the fee payer.
+func NewAnteHandlerWithFeeGrants(ak AccountKeeper, fck FeeCollectionKeeper, fgk FeeGrantKeeper) sdk.AnteHandler {
 	/*
 	TODO 返回一个 执行tx 的 回调函数
 	*/
@@ -99,20 +112,12 @@ func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			return newCtx, res, true
 		}
 
-		// stdSigs contains the sequence number, account number, and signatures.
-		// When simulating, this would just be a 0-length slice.
 		signerAddrs := stdTx.GetSigners()
 		signerAccs := make([]Account, len(signerAddrs))
 		isGenesis := ctx.BlockHeight() == 0
 
-		// fetch first signer, who's going to pay the fees
-		signerAccs[0], res = GetSignerAcc(newCtx, ak, signerAddrs[0])
-		if !res.IsOK() {
-			return newCtx, res, true
-		}
-
 		if !stdTx.Fee.Amount.IsZero() {
-			signerAccs[0], res = DeductFees(ctx.BlockHeader().Time, signerAccs[0], stdTx.Fee)
+			res = deductTxFees(newCtx, ak, fgk, stdTx)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -125,12 +130,10 @@ func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		stdSigs := stdTx.GetSignatures()
 
 		for i := 0; i < len(stdSigs); i++ {
-			// skip the fee payer, account is cached and fees were deducted already
-			if i != 0 {
-				signerAccs[i], res = GetSignerAcc(newCtx, ak, signerAddrs[i])
-				if !res.IsOK() {
-					return newCtx, res, true
-				}
+			// fetch the signer after the fees were deducted, as it may have paid them
+			signerAccs[i], res = GetSignerAcc(newCtx, ak, signerAddrs[i])
+			if !res.IsOK() {
+				return newCtx, res, true
 			}
 
 			// check signature, return account with incremented nonce
@@ -148,6 +151,35 @@ func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 	}
 }
 
+// deductTxFees deducts the fees of a transaction from the fee payer, or from
+// the granter named in the fee after charging them to the allowance it gave
+// the fee payer.
+func deductTxFees(ctx sdk.Context, ak AccountKeeper, fgk FeeGrantKeeper, stdTx StdTx) sdk.Result {
+	payer := stdTx.FeePayer()
+	if !stdTx.Fee.Granter.Empty() {
+		if fgk == nil {
+			return sdk.ErrUnauthorized("fee grants are not supported").Result()
+		}
+		if err := fgk.UseGrantedFees(ctx, stdTx.Fee.Granter, payer, stdTx.Fee.Amount); err != nil {
+			return err.Result()
+		}
+		payer = stdTx.Fee.Granter
+	}
+
+	acc, res := GetSignerAcc(ctx, ak, payer)
+	if !res.IsOK() {
+		return res
+	}
+
+	acc, res = DeductFees(ctx.BlockHeader().Time, acc, stdTx.Fee)
+	if !res.IsOK() {
+		return res
+	}
+
+	ak.SetAccount(ctx, acc)
+	return sdk.Result{}
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (Account, sdk.Result) {
@@ -315,6 +315,110 @@ func TestAnteHandlerFees(t *testing.T) {
 	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().AmountOf("atom").Equal(sdk.NewInt(0)))
 }
 
+// Test logic around a fee payer other than the first signer.
+func TestAnteHandlerFeePayer(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+	anteHandler := NewAnteHandler(input.ak, input.fck)
+
+	// keys and addresses
+	priv1, _, addr1 := keyPubAddr()
+	priv2, _, addr2 := keyPubAddr()
+	_, _, addr3 := keyPubAddr()
+
+	// set the accounts, only the second one can pay the fee
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.ak.SetAccount(ctx, acc1)
+	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(newCoins())
+	input.ak.SetAccount(ctx, acc2)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msgs := []sdk.Msg{newTestMsg(addr1, addr2)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+
+	// the fee payer must sign the tx
+	fee := newStdFee()
+	fee.Payer = addr3
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	fee.Payer = addr2
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(fee.Amount))
+	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().Empty())
+	require.Equal(t, newCoins().Sub(fee.Amount), input.ak.GetAccount(ctx, addr2).GetCoins())
+	require.Equal(t, uint64(1), input.ak.GetAccount(ctx, addr2).GetSequence())
+}
+
+// grants fees to a single grantee up to a limit
+type testFeeGrantKeeper struct {
+	granter, grantee sdk.AccAddress
+	limit            sdk.Coins
+}
+
+func (k *testFeeGrantKeeper) UseGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	left, hasNeg := k.limit.SafeSub(fee)
+	if !granter.Equals(k.granter) || !grantee.Equals(k.grantee) || hasNeg {
+		return sdk.ErrUnauthorized("fee allowance exceeded")
+	}
+	k.limit = left
+	return nil
+}
+
+// Test logic around fees paid out of a fee allowance.
+func TestAnteHandlerFeeGranter(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	ctx := input.ctx
+	fgk := &testFeeGrantKeeper{limit: sdk.Coins{sdk.NewInt64Coin("atom", 200)}}
+	anteHandler := NewAnteHandlerWithFeeGrants(input.ak, input.fck, fgk)
+
+	// keys and addresses
+	priv1, _, addr1 := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+	fgk.granter, fgk.grantee = addr2, addr1
+
+	// set the accounts, the grantee holds no coins
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.ak.SetAccount(ctx, acc1)
+	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(newCoins())
+	input.ak.SetAccount(ctx, acc2)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := newStdFee()
+	fee.Granter = addr2
+
+	// the default ante handler does not support fee grants
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, NewAnteHandler(input.ak, input.fck), ctx, tx, false, sdk.CodeUnauthorized)
+
+	// the granter pays the fees out of the allowance
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(fee.Amount))
+	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().Empty())
+	require.Equal(t, newCoins().Sub(fee.Amount), input.ak.GetAccount(ctx, addr2).GetCoins())
+	require.Equal(t, uint64(1), input.ak.GetAccount(ctx, addr1).GetSequence())
+
+	// the allowance does not cover the fees of another tx
+	seqs = []uint64{1}
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// the granter cannot be the fee payer
+	fee.Granter = addr1
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+}
+
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
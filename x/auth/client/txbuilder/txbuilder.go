@@ -29,6 +29,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -72,6 +73,7 @@ func NewTxBuilderFromCLI() TxBuilder {
 
 	txbldr = txbldr.WithFees(viper.GetString(client.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(client.FlagGasPrices))
+	txbldr = txbldr.WithFeeGranter(viper.GetString(client.FlagFeeGranter))
 
 	return txbldr
 }
@@ -150,6 +152,23 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter,
+// who pays the fees out of the allowance it gave the signer.
+func (bldr TxBuilder) WithFeeGranter(granter string) TxBuilder {
+	if granter == "" {
+		bldr.feeGranter = nil
+		return bldr
+	}
+
+	addr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		panic(err)
+	}
+
+	bldr.feeGranter = addr
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -200,13 +219,16 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := auth.NewStdFee(bldr.gas, fees)
+	fee.Granter = bldr.feeGranter
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           auth.NewStdFee(bldr.gas, fees),
+		Fee:           fee,
 	}, nil
 }
 
@@ -1,52 +1,47 @@
 package auth
 
 import (
-	codec "my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
-var (
-	collectedFeesKey = []byte("collectedFees")
-)
-
 // FeeCollectionKeeper handles collection of fees in the anteHandler
 // and setting of MinFees for different fee tokens
 // FeeCollectionKeeper
 // 处理anteHandler中的费用收集和不同费用令牌的MinFees设置
+//
+// The collected fees are held by the fee collector module account.
 type FeeCollectionKeeper struct {
 
-	// The (unexposed) key used to access the fee store from the Context.
-	key sdk.StoreKey
-
-	// The codec codec for binary encoding/decoding of accounts.
-	cdc *codec.Codec
+	// The account keeper holding the fee collector module account.
+	ak AccountKeeper
 }
 
 // NewFeeCollectionKeeper returns a new FeeCollectionKeeper
-func NewFeeCollectionKeeper(cdc *codec.Codec, key sdk.StoreKey) FeeCollectionKeeper {
+func NewFeeCollectionKeeper(ak AccountKeeper) FeeCollectionKeeper {
 	return FeeCollectionKeeper{
-		key: key,
-		cdc: cdc,
+		ak: ak,
 	}
 }
 
 // GetCollectedFees - retrieves the collected fee pool
 func (fck FeeCollectionKeeper) GetCollectedFees(ctx sdk.Context) sdk.Coins {
-	store := ctx.KVStore(fck.key)
-	bz := store.Get(collectedFeesKey)
-	if bz == nil {
+	macc := fck.ak.GetModuleAccount(ctx, FeeCollectorName)
+	if macc == nil || macc.GetCoins() == nil {
 		return sdk.Coins{}
 	}
-
-	feePool := &(sdk.Coins{})
-	fck.cdc.MustUnmarshalBinaryLengthPrefixed(bz, feePool)
-	return *feePool
+	return macc.GetCoins()
 }
 
 func (fck FeeCollectionKeeper) setCollectedFees(ctx sdk.Context, coins sdk.Coins) {
-	bz := fck.cdc.MustMarshalBinaryLengthPrefixed(coins)
-	store := ctx.KVStore(fck.key)
-	store.Set(collectedFeesKey, bz)
+	macc := fck.ak.GetModuleAccount(ctx, FeeCollectorName)
+	if macc == nil {
+		macc = fck.ak.NewAccount(ctx, NewEmptyModuleAccount(FeeCollectorName)).(*ModuleAccount)
+	}
+
+	if err := macc.SetCoins(coins); err != nil {
+		panic(err)
+	}
+	fck.ak.SetAccount(ctx, macc)
 }
 
 // AddCollectedFees - add to the fee pool
@@ -57,3 +57,17 @@ func TestFeeCollectionKeeperClear(t *testing.T) {
 	input.fck.ClearCollectedFees(ctx)
 	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
 }
+
+func TestFeeCollectionKeeperModuleAccount(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	// the fee collector account is created with the first fees
+	require.Nil(t, input.ak.GetModuleAccount(ctx, FeeCollectorName))
+	input.fck.AddCollectedFees(ctx, oneCoin)
+
+	macc := input.ak.GetModuleAccount(ctx, FeeCollectorName)
+	require.NotNil(t, macc)
+	require.True(t, macc.GetCoins().IsEqual(oneCoin))
+	require.Empty(t, macc.GetPermissions())
+}
@@ -6,37 +6,35 @@ import (
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
-// GenesisState - all auth state that must be provided at genesis
+// GenesisState - all auth state that must be provided at genesis. The
+// collected fees are held by the fee collector module account, so they are
+// part of the genesis accounts.
 type GenesisState struct {
-	CollectedFees sdk.Coins `json:"collected_fees"`
-	Params        Params    `json:"params"`
+	Params Params `json:"params"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(collectedFees sdk.Coins, params Params) GenesisState {
+func NewGenesisState(params Params) GenesisState {
 	return GenesisState{
-		Params:        params,
-		CollectedFees: collectedFees,
+		Params: params,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(sdk.Coins{}, DefaultParams())
+	return NewGenesisState(DefaultParams())
 }
 
 // InitGenesis - Init store state from genesis data
-func InitGenesis(ctx sdk.Context, ak AccountKeeper, fck FeeCollectionKeeper, data GenesisState) {
+func InitGenesis(ctx sdk.Context, ak AccountKeeper, data GenesisState) {
 	ak.SetParams(ctx, data.Params)
-	fck.setCollectedFees(ctx, data.CollectedFees)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
-func ExportGenesis(ctx sdk.Context, ak AccountKeeper, fck FeeCollectionKeeper) GenesisState {
-	collectedFees := fck.GetCollectedFees(ctx)
+func ExportGenesis(ctx sdk.Context, ak AccountKeeper) GenesisState {
 	params := ak.GetParams(ctx)
 
-	return NewGenesisState(collectedFees, params)
+	return NewGenesisState(params)
 }
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
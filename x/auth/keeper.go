@@ -14,9 +14,6 @@ const (
 	// StoreKey is string representation of the store key for auth
 	StoreKey = "acc"
 
-	// FeeStoreKey is a string representation of the store key for fees
-	FeeStoreKey = "fee"
-
 	// QuerierRoute is the querier route for acc
 	QuerierRoute = StoreKey
 )
@@ -98,6 +95,21 @@ func (ak AccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) Account
 	return acc
 }
 
+// GetModuleAccount returns the module account of the given module name, or
+// nil if the module has no account yet.
+func (ak AccountKeeper) GetModuleAccount(ctx sdk.Context, name string) *ModuleAccount {
+	acc := ak.GetAccount(ctx, NewModuleAddress(name))
+	if acc == nil {
+		return nil
+	}
+
+	macc, ok := acc.(*ModuleAccount)
+	if !ok {
+		panic(fmt.Sprintf("account at the address of module %s is not a module account", name))
+	}
+	return macc
+}
+
 // GetAllAccounts returns all accounts in the accountKeeper.
 func (ak AccountKeeper) GetAllAccounts(ctx sdk.Context) []Account {
 	accounts := []Account{}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Permissions a module account can be granted
+const (
+	Minter  = "minter"  // can mint coins into its account
+	Burner  = "burner"  // can burn coins held by its account
+	Staking = "staking" // can delegate and undelegate coins of accounts
+)
+
+// FeeCollectorName is the name of the module account holding the collected
+// fees until they are distributed
+const FeeCollectorName = "fee_collector"
+
+var _ Account = (*ModuleAccount)(nil)
+
+// ModuleAccount is an account owned by a module rather than by a key. Its
+// address is derived from the module name, so no transaction can be signed on
+// its behalf and only the module moves its coins.
+type ModuleAccount struct {
+	*BaseAccount
+
+	Name        string   `json:"name"`        // name of the module
+	Permissions []string `json:"permissions"` // permissions of the module
+}
+
+// NewModuleAddress returns the deterministic address of the module account of
+// the given name
+func NewModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// NewEmptyModuleAccount returns a module account without coins for the given
+// module name and permissions
+func NewEmptyModuleAccount(name string, permissions ...string) *ModuleAccount {
+	baseAcc := NewBaseAccountWithAddress(NewModuleAddress(name))
+
+	return &ModuleAccount{
+		BaseAccount: &baseAcc,
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
+// GetName returns the name of the module owning the account
+func (ma ModuleAccount) GetName() string {
+	return ma.Name
+}
+
+// GetPermissions returns the permissions granted to the module account
+func (ma ModuleAccount) GetPermissions() []string {
+	return ma.Permissions
+}
+
+// HasPermission returns whether the module account has been granted the
+// given permission
+func (ma ModuleAccount) HasPermission(permission string) bool {
+	for _, perm := range ma.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPubKey - Implements Account. Module accounts have no public key.
+func (ma ModuleAccount) SetPubKey(pubKey crypto.PubKey) error {
+	return errors.New("cannot set a public key on a module account")
+}
+
+// SetSequence - Implements Account. Module accounts never sign, so their
+// sequence never changes.
+func (ma ModuleAccount) SetSequence(seq uint64) error {
+	return errors.New("cannot set the sequence of a module account")
+}
+
+// String implements fmt.Stringer
+func (ma ModuleAccount) String() string {
+	return fmt.Sprintf(`Module Account:
+  Address:       %s
+  Coins:         %s
+  AccountNumber: %d
+  Name:          %s
+  Permissions:   %s`,
+		ma.Address, ma.Coins, ma.AccountNumber, ma.Name, strings.Join(ma.Permissions, ","),
+	)
+}
@@ -18,7 +18,8 @@ var (
 )
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
-// NOTE: the first signature is the fee payer (Signatures must not be nil).
+// NOTE: the first signature is the fee payer unless the fee names another
+// signer (Signatures must not be nil).
 type StdTx struct {
 	Msgs       []sdk.Msg      `json:"msg"`
 	Fee        StdFee         `json:"fee"`
@@ -55,6 +56,12 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 	if len(stdSigs) != len(tx.GetSigners()) {
 		return sdk.ErrUnauthorized("wrong number of signers")
 	}
+	if !tx.Fee.Payer.Empty() && !tx.isSigner(tx.Fee.Payer) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("fee payer %s is not a signer", tx.Fee.Payer))
+	}
+	if !tx.Fee.Granter.Empty() && tx.Fee.Granter.Equals(tx.FeePayer()) {
+		return sdk.ErrUnauthorized("fee granter cannot be the fee payer")
+	}
 
 	sigCount := 0
 	for i := 0; i < len(stdSigs); i++ {
@@ -103,6 +110,25 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 	return signers
 }
 
+// FeePayer returns the address of the signer paying the fees: the payer named
+// in the fee, or the first signer if there is none. When the fee also names a
+// granter, the fees come out of the allowance the granter gave the fee payer.
+func (tx StdTx) FeePayer() sdk.AccAddress {
+	if !tx.Fee.Payer.Empty() {
+		return tx.Fee.Payer
+	}
+	return tx.GetSigners()[0]
+}
+
+func (tx StdTx) isSigner(addr sdk.AccAddress) bool {
+	for _, signer := range tx.GetSigners() {
+		if signer.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
@@ -121,9 +147,15 @@ func (tx StdTx) GetSignatures() []StdSignature { return tx.Signatures }
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// The fees are paid by the first signer unless Payer names another signer. If
+// Granter is set, they are paid by the granter out of the fee allowance it gave
+// the payer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount"`
-	Gas    uint64    `json:"gas"`
+	Amount  sdk.Coins      `json:"amount"`
+	Gas     uint64         `json:"gas"`
+	Payer   sdk.AccAddress `json:"payer,omitempty"`
+	Granter sdk.AccAddress `json:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -0,0 +1,186 @@
+package authz
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/bank"
+)
+
+// MsgType returns the type of a message that authorizations refer to, made of
+// its route and type, e.g. "gov/vote"
+func MsgType(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// Authorization is a permission a granter gives a grantee to execute messages
+// of one type on its behalf
+type Authorization interface {
+	// MsgType returns the type of the messages the authorization allows
+	MsgType() string
+
+	// Accept checks the authorization allows the message. It returns the
+	// authorization left and whether it is used up and can be removed, or an
+	// error if the message is not allowed.
+	Accept(msg sdk.Msg) (left Authorization, remove bool, err sdk.Error)
+
+	// ValidateBasic checks the authorization is well formed
+	ValidateBasic() sdk.Error
+}
+
+var (
+	_ Authorization = GenericAuthorization{}
+	_ Authorization = SendAuthorization{}
+)
+
+//-----------------------------------------------------------------------------
+// Generic Authorization
+
+// GenericAuthorization allows any message of the given type
+type GenericAuthorization struct {
+	Msg string `json:"msg"` // message type, e.g. "gov/vote"
+}
+
+// NewGenericAuthorization returns a new generic authorization
+func NewGenericAuthorization(msgType string) GenericAuthorization {
+	return GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements Authorization
+func (a GenericAuthorization) MsgType() string { return a.Msg }
+
+// Accept implements Authorization
+func (a GenericAuthorization) Accept(msg sdk.Msg) (Authorization, bool, sdk.Error) {
+	return a, false, nil
+}
+
+// ValidateBasic implements Authorization
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	parts := strings.Split(a.Msg, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return ErrInvalidMsgType(DefaultCodespace, fmt.Sprintf("invalid message type %q, expected route/type", a.Msg))
+	}
+	return nil
+}
+
+func (a GenericAuthorization) String() string {
+	return fmt.Sprintf("Generic Authorization: %s", a.Msg)
+}
+
+//-----------------------------------------------------------------------------
+// Send Authorization
+
+// SendAuthorization allows bank sends up to SpendLimit in total
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit"`
+}
+
+// NewSendAuthorization returns a new send authorization
+func NewSendAuthorization(spendLimit sdk.Coins) SendAuthorization {
+	return SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization
+func (a SendAuthorization) MsgType() string {
+	return MsgType(bank.MsgSend{})
+}
+
+// Accept implements Authorization. The authorization is used up once its
+// spend limit is.
+func (a SendAuthorization) Accept(msg sdk.Msg) (Authorization, bool, sdk.Error) {
+	send, ok := msg.(bank.MsgSend)
+	if !ok {
+		return nil, false, ErrInvalidMsgType(DefaultCodespace, fmt.Sprintf("send authorization cannot accept %s", MsgType(msg)))
+	}
+
+	left, hasNeg := a.SpendLimit.SafeSub(send.Amount)
+	if hasNeg {
+		return nil, false, ErrSpendLimitExceeded(DefaultCodespace, send.Amount, a.SpendLimit)
+	}
+	a.SpendLimit = left
+	return a, left.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if a.SpendLimit.Empty() || !a.SpendLimit.IsValid() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid spend limit: %s", a.SpendLimit))
+	}
+	return nil
+}
+
+func (a SendAuthorization) String() string {
+	return fmt.Sprintf("Send Authorization: %s", a.SpendLimit)
+}
+
+//-----------------------------------------------------------------------------
+// Authorization Grant
+
+// AuthorizationGrant is an authorization stored with its granter, grantee and
+// expiration
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    int64          `json:"expiration"` // unix time, never if zero
+}
+
+// NewAuthorizationGrant returns a new authorization grant
+func NewAuthorizationGrant(granter, grantee sdk.AccAddress, authorization Authorization, expiration int64) AuthorizationGrant {
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns whether the grant has expired at the given unix time
+func (g AuthorizationGrant) IsExpired(now int64) bool {
+	return g.Expiration != 0 && now >= g.Expiration
+}
+
+// ValidateBasic checks the grant is well formed
+func (g AuthorizationGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdk.ErrInvalidAddress("cannot grant an authorization to oneself")
+	}
+	if g.Authorization == nil {
+		return sdk.ErrUnknownRequest("missing authorization")
+	}
+	if g.Expiration < 0 {
+		return sdk.ErrUnknownRequest("expiration cannot be negative")
+	}
+	return g.Authorization.ValidateBasic()
+}
+
+func (g AuthorizationGrant) String() string {
+	expiration := "never"
+	if g.Expiration != 0 {
+		expiration = time.Unix(g.Expiration, 0).UTC().String()
+	}
+	return fmt.Sprintf(`Authorization Grant:
+  Granter:       %s
+  Grantee:       %s
+  Authorization: %v
+  Expiration:    %s`, g.Granter, g.Grantee, g.Authorization, expiration)
+}
+
+// AuthorizationGrants is a list of authorization grants
+type AuthorizationGrants []AuthorizationGrant
+
+func (gs AuthorizationGrants) String() string {
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,50 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/bank"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	require.NoError(t, NewGenericAuthorization("gov/vote").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("vote").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("gov/").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("gov/vote/yes").ValidateBasic())
+
+	authorization := NewGenericAuthorization("bank/send")
+	left, remove, err := authorization.Accept(bank.NewMsgSend(granter, grantee, sdk.Coins{sdk.NewInt64Coin("stake", 1)}))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, authorization, left)
+}
+
+func TestSendAuthorization(t *testing.T) {
+	authorization := NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)})
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "bank/send", authorization.MsgType())
+	require.Error(t, NewSendAuthorization(nil).ValidateBasic())
+
+	send := func(coins ...sdk.Coin) sdk.Msg { return bank.NewMsgSend(granter, grantee, coins) }
+
+	left, remove, err := authorization.Accept(send(sdk.NewInt64Coin("stake", 4)))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 6)}), left)
+
+	_, _, err = left.Accept(send(sdk.NewInt64Coin("stake", 7)))
+	require.Equal(t, CodeSpendLimitExceeded, err.Code())
+
+	_, _, err = left.Accept(send(sdk.NewInt64Coin("photon", 1)))
+	require.Equal(t, CodeSpendLimitExceeded, err.Code())
+
+	_, _, err = left.Accept(bank.NewMsgMultiSend(nil, nil))
+	require.Equal(t, CodeInvalidMsgType, err.Code())
+
+	_, remove, err = left.Accept(send(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 6)))
+	require.NoError(t, err)
+	require.True(t, remove)
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/authz"
+)
+
+// GetCmdQueryAuthorization implements the query authorization command.
+func GetCmdQueryAuthorization(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg-type]",
+		Short: "Query the authorization a granter gave a grantee for a message type",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(authz.NewQueryAuthorizationParams(granter, grantee, args[2]))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, authz.QueryAuthorization), bz)
+			if err != nil {
+				return err
+			}
+
+			var grant authz.AuthorizationGrant
+			cdc.MustUnmarshalJSON(res, &grant)
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryGrants implements the query grants command.
+func GetCmdQueryGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [granter] [grantee]",
+		Short: "Query all the authorizations a granter gave a grantee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(authz.NewQueryGrantsParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, authz.QueryGrants), bz)
+			if err != nil {
+				return err
+			}
+
+			var grants authz.AuthorizationGrants
+			cdc.MustUnmarshalJSON(res, &grants)
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
+
+// DONTCOVER
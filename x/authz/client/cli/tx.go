@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/authz"
+	"my-cosmos/cosmos-sdk/x/bank"
+)
+
+const (
+	flagSpendLimit = "spend-limit"
+	flagExpiration = "expiration"
+)
+
+// GetCmdGrantAuthorization implements the grant authorization command.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [msg-type]",
+		Short: "Authorize an account to execute messages of a type on your behalf",
+		Long: strings.TrimSpace(`
+Authorize the grantee to execute messages of the given type, made of their
+route and type, on your behalf, replacing any authorization of that type you
+gave it before. Bank sends can be capped with --spend-limit, and any
+authorization can expire at a unix time:
+
+$ gaiacli tx authz grant cosmos1... gov/vote --from mykey
+$ gaiacli tx authz grant cosmos1... distr/withdraw_delegator_reward --expiration=1600000000 --from mykey
+$ gaiacli tx authz grant cosmos1... bank/send --spend-limit=100stake --from mykey
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization authz.Authorization = authz.NewGenericAuthorization(args[1])
+			if spendLimit := viper.GetString(flagSpendLimit); spendLimit != "" {
+				if args[1] != authz.MsgType(bank.MsgSend{}) {
+					return fmt.Errorf("--%s only applies to %s", flagSpendLimit, authz.MsgType(bank.MsgSend{}))
+				}
+
+				coins, err := sdk.ParseCoins(spendLimit)
+				if err != nil {
+					return err
+				}
+				authorization = authz.NewSendAuthorization(coins)
+			}
+
+			msg := authz.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, viper.GetInt64(flagExpiration))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "total amount the grantee may send, for bank/send only")
+	cmd.Flags().Int64(flagExpiration, 0, "unix time at which the authorization expires, never if zero")
+	return client.PostCommands(cmd)[0]
+}
+
+// GetCmdRevokeAuthorization implements the revoke authorization command.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke the authorization you gave an account for a message type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return client.PostCommands(cmd)[0]
+}
+
+// GetCmdExec implements the exec command.
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-file]",
+		Short: "Execute the messages of a transaction on behalf of their signers",
+		Long: strings.TrimSpace(`
+Execute the messages of an unsigned transaction, generated with --generate-only,
+on behalf of their signers, who authorized you to do so. Only you sign the
+transaction:
+
+$ gaiacli tx gov vote 1 yes --from cosmos1cold... --generate-only > vote.json
+$ gaiacli tx authz exec vote.json --from hotkey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgExec(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return client.PostCommands(cmd)[0]
+}
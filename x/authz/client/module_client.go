@@ -0,0 +1,53 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/x/authz"
+	"my-cosmos/cosmos-sdk/x/authz/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	authzQueryCmd := &cobra.Command{
+		Use:   authz.ModuleName,
+		Short: "Querying commands for the authz module",
+	}
+
+	authzQueryCmd.AddCommand(
+		client.GetCommands(
+			cli.GetCmdQueryAuthorization(mc.storeKey, mc.cdc),
+			cli.GetCmdQueryGrants(mc.storeKey, mc.cdc),
+		)...,
+	)
+
+	return authzQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:   authz.ModuleName,
+		Short: "Authz transactions subcommands",
+	}
+
+	authzTxCmd.AddCommand(
+		cli.GetCmdGrantAuthorization(mc.cdc),
+		cli.GetCmdRevokeAuthorization(mc.cdc),
+		cli.GetCmdExec(mc.cdc),
+	)
+
+	return authzTxCmd
+}
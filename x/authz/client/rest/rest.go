@@ -0,0 +1,189 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	clientrest "my-cosmos/cosmos-sdk/client/rest"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/types/rest"
+	"my-cosmos/cosmos-sdk/x/authz"
+)
+
+// RegisterRoutes registers authz related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/authz/grants/{granter}/{grantee}",
+		grantsHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/authz/grantees/{grantee}/grant",
+		grantAuthorizationHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/authz/grantees/{grantee}/revoke",
+		revokeAuthorizationHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/authz/exec",
+		execHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+}
+
+// GrantAuthorizationReq defines the properties of a grant authorization
+// request's body. The authorization is encoded with its amino type.
+type GrantAuthorizationReq struct {
+	BaseReq       rest.BaseReq        `json:"base_req"`
+	Authorization authz.Authorization `json:"authorization"`
+	Expiration    int64               `json:"expiration"`
+}
+
+// RevokeAuthorizationReq defines the properties of a revoke authorization
+// request's body.
+type RevokeAuthorizationReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	MsgType string       `json:"msg_type"`
+}
+
+// ExecReq defines the properties of an exec request's body. The messages are
+// encoded with their amino types.
+type ExecReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Msgs    []sdk.Msg    `json:"msgs"`
+}
+
+func grantsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		granter, err := sdk.AccAddressFromBech32(vars["granter"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(authz.NewQueryGrantsParams(granter, grantee))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", authz.QuerierRoute, authz.QueryGrants)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func grantAuthorizationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GrantAuthorizationReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := authz.NewMsgGrantAuthorization(granter, grantee, req.Authorization, req.Expiration)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeAuthorizationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req RevokeAuthorizationReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := authz.NewMsgRevokeAuthorization(granter, grantee, req.MsgType)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func execHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExecReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := authz.NewMsgExec(grantee, req.Msgs)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
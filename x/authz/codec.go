@@ -0,0 +1,23 @@
+package authz
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the authorizations and messages of the authz module
+// on the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+
+	cdc.RegisterConcrete(MsgGrantAuthorization{}, "cosmos-sdk/MsgGrantAuthorization", nil)
+	cdc.RegisterConcrete(MsgRevokeAuthorization{}, "cosmos-sdk/MsgRevokeAuthorization", nil)
+	cdc.RegisterConcrete(MsgExec{}, "cosmos-sdk/MsgExec", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
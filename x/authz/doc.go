@@ -0,0 +1,17 @@
+/*
+Package authz lets an account authorize another to execute messages on its
+behalf.
+
+A granter gives a grantee an Authorization for one message type with
+MsgGrantAuthorization, optionally until an expiration time. The grantee then
+wraps messages signed by the granter in a MsgExec, which it signs itself:
+
+	msg := authz.NewMsgExec(hotKey, []sdk.Msg{gov.NewMsgVote(coldKey, proposalID, gov.OptionYes)})
+
+The handler checks that the grantee holds an authorization from every signer of
+every wrapped message, then dispatches the messages to their handlers as if the
+signers had sent them. A GenericAuthorization allows any message of its type,
+and a SendAuthorization allows bank sends up to a spend limit. A granter
+withdraws an authorization with MsgRevokeAuthorization.
+*/
+package authz
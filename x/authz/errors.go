@@ -0,0 +1,37 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Authz errors reserve 100 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoAuthorization      sdk.CodeType = 101
+	CodeAuthorizationExpired sdk.CodeType = 102
+	CodeSpendLimitExceeded   sdk.CodeType = 103
+	CodeInvalidMsgType       sdk.CodeType = 104
+)
+
+// ErrNoAuthorization is an error
+func ErrNoAuthorization(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization, fmt.Sprintf("%s is not authorized by %s to execute %s", grantee, granter, msgType))
+}
+
+// ErrAuthorizationExpired is an error
+func ErrAuthorizationExpired(codespace sdk.CodespaceType, expiration int64) sdk.Error {
+	return sdk.NewError(codespace, CodeAuthorizationExpired, fmt.Sprintf("authorization expired at %d", expiration))
+}
+
+// ErrSpendLimitExceeded is an error
+func ErrSpendLimitExceeded(codespace sdk.CodespaceType, amount, limit sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeSpendLimitExceeded, fmt.Sprintf("%s exceeds the spend limit %s", amount, limit))
+}
+
+// ErrInvalidMsgType is an error
+func ErrInvalidMsgType(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMsgType, msg)
+}
@@ -0,0 +1,11 @@
+package authz
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Router defines the expected message router, that routes the messages
+// executed on behalf of granters to their handlers
+type Router interface {
+	Route(path string) sdk.Handler
+}
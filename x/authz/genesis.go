@@ -0,0 +1,49 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the authorization grants at genesis
+type GenesisState struct {
+	Grants []AuthorizationGrant `json:"grants"`
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(grants []AuthorizationGrant) GenesisState {
+	return GenesisState{Grants: grants}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]AuthorizationGrant{})
+}
+
+// InitGenesis sets the authorization grants from genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, grant := range data.Grants {
+		keeper.Grant(ctx, grant)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	grants := []AuthorizationGrant{}
+	keeper.IterateAuthorizationGrants(ctx, func(grant AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return NewGenesisState(grants)
+}
+
+// ValidateGenesis checks that every grant is well formed
+func ValidateGenesis(data GenesisState) error {
+	for _, grant := range data.Grants {
+		if err := grant.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid authorization grant from %s to %s: %s", grant.Granter, grant.Grantee, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package authz
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Tag keys
+const (
+	TagKeyGranter = "granter"
+	TagKeyGrantee = "grantee"
+)
+
+// NewHandler returns a handler for "authz" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgGrantAuthorization:
+			return handleMsgGrantAuthorization(ctx, k, msg)
+		case MsgRevokeAuthorization:
+			return handleMsgRevokeAuthorization(ctx, k, msg)
+		case MsgExec:
+			return handleMsgExec(ctx, k, msg)
+		default:
+			errMsg := "Unrecognized authz Msg type: " + msg.Type()
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle MsgGrantAuthorization.
+func handleMsgGrantAuthorization(ctx sdk.Context, k Keeper, msg MsgGrantAuthorization) sdk.Result {
+	k.Grant(ctx, msg.Grant())
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeyGranter, msg.Granter.String(),
+			TagKeyGrantee, msg.Grantee.String(),
+		),
+	}
+}
+
+// Handle MsgRevokeAuthorization.
+func handleMsgRevokeAuthorization(ctx sdk.Context, k Keeper, msg MsgRevokeAuthorization) sdk.Result {
+	if err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.MsgType); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeyGranter, msg.Granter.String(),
+			TagKeyGrantee, msg.Grantee.String(),
+		),
+	}
+}
+
+// Handle MsgExec.
+func handleMsgExec(ctx sdk.Context, k Keeper, msg MsgExec) sdk.Result {
+	res := k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+	if !res.IsOK() {
+		return res
+	}
+
+	res.Tags = append(res.Tags, sdk.MakeTag(TagKeyGrantee, msg.Grantee.String()))
+	return res
+}
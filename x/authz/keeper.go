@@ -0,0 +1,174 @@
+package authz
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authorizations
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authorizations
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authorizations
+	QuerierRoute = ModuleName
+)
+
+// AuthorizationKeyPrefix is the prefix for the authorizations, stored by
+// granter, grantee and then message type
+var AuthorizationKeyPrefix = []byte{0x00}
+
+// AuthorizationKey returns the key under which the authorization of a granter
+// to a grantee for a message type is stored
+func AuthorizationKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(AuthorizationsKey(granter, grantee), []byte(msgType)...)
+}
+
+// AuthorizationsKey returns the prefix of the authorizations of a granter to a
+// grantee
+func AuthorizationsKey(granter, grantee sdk.AccAddress) []byte {
+	key := append(AuthorizationKeyPrefix, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// Keeper of the authz store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	router   Router // message router, dispatching the messages executed on behalf of granters
+}
+
+// NewKeeper returns an authz keeper. The router must route every message that
+// can be executed on behalf of a granter.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, router Router) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Grant sets the authorization of a grant, replacing any previous one between
+// the same accounts for the same message type
+func (k Keeper) Grant(ctx sdk.Context, grant AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := AuthorizationKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+// Revoke removes the authorization of a granter to a grantee for a message
+// type
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := AuthorizationKey(granter, grantee, msgType)
+	if !store.Has(key) {
+		return ErrNoAuthorization(DefaultCodespace, granter, grantee, msgType)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetAuthorizationGrant returns the grant of a granter to a grantee for a
+// message type
+func (k Keeper) GetAuthorizationGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) (grant AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(AuthorizationKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// GetAuthorizationGrants returns all the grants of a granter to a grantee
+func (k Keeper) GetAuthorizationGrants(ctx sdk.Context, granter, grantee sdk.AccAddress) (grants []AuthorizationGrant) {
+	k.iterateAuthorizationGrants(ctx, AuthorizationsKey(granter, grantee), func(grant AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return grants
+}
+
+// IterateAuthorizationGrants iterates over all the grants until the callback
+// returns true
+func (k Keeper) IterateAuthorizationGrants(ctx sdk.Context, cb func(grant AuthorizationGrant) (stop bool)) {
+	k.iterateAuthorizationGrants(ctx, AuthorizationKeyPrefix, cb)
+}
+
+func (k Keeper) iterateAuthorizationGrants(ctx sdk.Context, prefix []byte, cb func(grant AuthorizationGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// DispatchActions executes messages on behalf of their signers. Every signer
+// other than the grantee must have authorized the grantee to execute the
+// message, and the authorization is charged for it. It stops at the first
+// message that fails.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	var tags sdk.Tags
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if signer.Equals(grantee) {
+				continue
+			}
+			if err := k.useAuthorization(ctx, signer, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("Unrecognized Msg type: " + msg.Route()).Result()
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		tags = append(tags, sdk.MakeTag(sdk.TagAction, msg.Type()))
+		tags = append(tags, res.Tags...)
+	}
+
+	return sdk.Result{Tags: tags}
+}
+
+// useAuthorization charges a message to the authorization of a granter to a
+// grantee, removing the authorization once it is used up
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgType := MsgType(msg)
+	grant, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found {
+		return ErrNoAuthorization(DefaultCodespace, granter, grantee, msgType)
+	}
+	if grant.IsExpired(ctx.BlockHeader().Time.Unix()) {
+		return ErrAuthorizationExpired(DefaultCodespace, grant.Expiration)
+	}
+
+	left, remove, err := grant.Authorization.Accept(msg)
+	if err != nil {
+		return err
+	}
+	if remove {
+		return k.Revoke(ctx, granter, grantee, msgType)
+	}
+
+	grant.Authorization = left
+	k.Grant(ctx, grant)
+	return nil
+}
@@ -0,0 +1,136 @@
+package authz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"my-cosmos/cosmos-sdk/baseapp"
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/store"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/bank"
+	"my-cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	granter   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	sendMsgType = MsgType(bank.MsgSend{})
+)
+
+func createTestInput(t *testing.T) (sdk.Context, bank.Keeper, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	RegisterCodec(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(1000, 0)}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	bank.InitGenesis(ctx, bk, bank.DefaultGenesisState())
+
+	router := baseapp.NewRouter()
+	keeper := NewKeeper(cdc, key, router)
+	router.
+		AddRoute(bank.RouterKey, bank.NewHandler(bk)).
+		AddRoute(RouterKey, NewHandler(keeper))
+
+	return ctx, bk, keeper
+}
+
+func TestGrantRevokeAuthorization(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	vote := NewGenericAuthorization("gov/vote")
+	send := NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+
+	keeper.Grant(ctx, NewAuthorizationGrant(granter, grantee, vote, 0))
+	keeper.Grant(ctx, NewAuthorizationGrant(granter, grantee, send, 2000))
+
+	grant, found := keeper.GetAuthorizationGrant(ctx, granter, grantee, "gov/vote")
+	require.True(t, found)
+	require.Equal(t, vote, grant.Authorization)
+	_, found = keeper.GetAuthorizationGrant(ctx, grantee, granter, "gov/vote")
+	require.False(t, found)
+	require.Len(t, keeper.GetAuthorizationGrants(ctx, granter, grantee), 2)
+
+	require.NoError(t, keeper.Revoke(ctx, granter, grantee, "gov/vote"))
+	require.Error(t, keeper.Revoke(ctx, granter, grantee, "gov/vote"))
+	require.Len(t, keeper.GetAuthorizationGrants(ctx, granter, grantee), 1)
+
+	genesis := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genesis))
+	ctx2, _, keeper2 := createTestInput(t)
+	InitGenesis(ctx2, keeper2, genesis)
+	require.Equal(t, genesis, ExportGenesis(ctx2, keeper2))
+}
+
+func TestDispatchActions(t *testing.T) {
+	ctx, bk, keeper := createTestInput(t)
+	_, _, err := bk.AddCoins(ctx, granter, sdk.Coins{sdk.NewInt64Coin("stake", 1000)})
+	require.Nil(t, err)
+	_, _, err = bk.AddCoins(ctx, grantee, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.Nil(t, err)
+
+	send := func(from sdk.AccAddress, amount int64) sdk.Msg {
+		return bank.NewMsgSend(from, recipient, sdk.Coins{sdk.NewInt64Coin("stake", amount)})
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Result {
+		return NewHandler(keeper)(ctx, NewMsgExec(grantee, msgs))
+	}
+
+	// the grantee needs an authorization
+	res := exec(send(granter, 40))
+	require.Equal(t, CodeNoAuthorization, res.Code)
+
+	keeper.Grant(ctx, NewAuthorizationGrant(granter, grantee, NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), 0))
+	res = exec(send(granter, 40), send(grantee, 10))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(50), bk.GetCoins(ctx, recipient).AmountOf("stake"))
+	require.Equal(t, sdk.NewInt(960), bk.GetCoins(ctx, granter).AmountOf("stake"))
+
+	grant, _ := keeper.GetAuthorizationGrant(ctx, granter, grantee, sendMsgType)
+	require.Equal(t, NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 60)}), grant.Authorization)
+
+	// the spend limit applies
+	res = exec(send(granter, 70))
+	require.Equal(t, CodeSpendLimitExceeded, res.Code)
+
+	// the authorization is removed once used up
+	res = exec(send(granter, 60))
+	require.True(t, res.IsOK(), res.Log)
+	_, found := keeper.GetAuthorizationGrant(ctx, granter, grantee, sendMsgType)
+	require.False(t, found)
+
+	// expired authorizations are rejected
+	keeper.Grant(ctx, NewAuthorizationGrant(granter, grantee, NewGenericAuthorization(sendMsgType), 1000))
+	res = exec(send(granter, 1))
+	require.Equal(t, CodeAuthorizationExpired, res.Code)
+
+	keeper.Grant(ctx, NewAuthorizationGrant(granter, grantee, NewGenericAuthorization(sendMsgType), 1001))
+	res = exec(send(granter, 1))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(899), bk.GetCoins(ctx, granter).AmountOf("stake"))
+}
@@ -0,0 +1,162 @@
+package authz
+
+import (
+	"encoding/json"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// MsgGrantAuthorization - authorizes a grantee to execute messages of one type
+// on behalf of the granter until Expiration, replacing any previous
+// authorization for the same type
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    int64          `json:"expiration"` // unix time, never if zero
+}
+
+var _ sdk.Msg = MsgGrantAuthorization{}
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization
+func NewMsgGrantAuthorization(granter, grantee sdk.AccAddress, authorization Authorization, expiration int64) MsgGrantAuthorization {
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgGrantAuthorization) Type() string { return "grant_authorization" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	return msg.Grant().ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// Grant returns the authorization grant of the message
+func (msg MsgGrantAuthorization) Grant() AuthorizationGrant {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+}
+
+// MsgRevokeAuthorization - removes the authorization of a granter to a grantee
+// for a message type
+type MsgRevokeAuthorization struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+}
+
+var _ sdk.Msg = MsgRevokeAuthorization{}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevokeAuthorization) Type() string { return "revoke_authorization" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	return NewGenericAuthorization(msg.MsgType).ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec - executes messages on behalf of their signers, who authorized the
+// grantee to do so. Only the grantee signs the transaction.
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs"`
+}
+
+var _ sdk.Msg = MsgExec{}
+
+// NewMsgExec creates a new MsgExec
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{Grantee: grantee, Msgs: msgs}
+}
+
+// Route Implements Msg.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgExec) Type() string { return "exec" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdk.ErrUnknownRequest("no messages to execute")
+	}
+	for _, m := range msg.Msgs {
+		if _, ok := m.(MsgExec); ok {
+			return ErrInvalidMsgType(DefaultCodespace, "cannot nest exec messages")
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execSignDoc is the document signed for a MsgExec, made of the sign bytes of
+// the messages it executes, which the module codec does not know about
+type execSignDoc struct {
+	Grantee sdk.AccAddress    `json:"grantee"`
+	Msgs    []json.RawMessage `json:"msgs"`
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgExec) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = json.RawMessage(m.GetSignBytes())
+	}
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(execSignDoc{Grantee: msg.Grantee, Msgs: msgs}))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// GetMsgs returns the messages executed by the grantee
+func (msg MsgExec) GetMsgs() []sdk.Msg {
+	return msg.Msgs
+}
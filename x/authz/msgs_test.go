@@ -0,0 +1,42 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/bank"
+)
+
+func TestMsgGrantAuthorizationValidateBasic(t *testing.T) {
+	vote := NewGenericAuthorization("gov/vote")
+
+	require.Nil(t, NewMsgGrantAuthorization(granter, grantee, vote, 0).ValidateBasic())
+	require.NotNil(t, NewMsgGrantAuthorization(nil, grantee, vote, 0).ValidateBasic())
+	require.NotNil(t, NewMsgGrantAuthorization(granter, nil, vote, 0).ValidateBasic())
+	require.NotNil(t, NewMsgGrantAuthorization(granter, granter, vote, 0).ValidateBasic())
+	require.NotNil(t, NewMsgGrantAuthorization(granter, grantee, nil, 0).ValidateBasic())
+	require.NotNil(t, NewMsgGrantAuthorization(granter, grantee, vote, -1).ValidateBasic())
+	require.NotNil(t, NewMsgGrantAuthorization(granter, grantee, NewGenericAuthorization("vote"), 0).ValidateBasic())
+}
+
+func TestMsgRevokeAuthorizationValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgRevokeAuthorization(granter, grantee, "gov/vote").ValidateBasic())
+	require.NotNil(t, NewMsgRevokeAuthorization(nil, grantee, "gov/vote").ValidateBasic())
+	require.NotNil(t, NewMsgRevokeAuthorization(granter, grantee, "").ValidateBasic())
+}
+
+func TestMsgExec(t *testing.T) {
+	send := bank.NewMsgSend(granter, recipient, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+
+	msg := NewMsgExec(grantee, []sdk.Msg{send})
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+	require.Contains(t, string(msg.GetSignBytes()), string(send.GetSignBytes()))
+
+	require.NotNil(t, NewMsgExec(nil, []sdk.Msg{send}).ValidateBasic())
+	require.NotNil(t, NewMsgExec(grantee, nil).ValidateBasic())
+	require.NotNil(t, NewMsgExec(grantee, []sdk.Msg{msg}).ValidateBasic())
+	require.NotNil(t, NewMsgExec(grantee, []sdk.Msg{bank.NewMsgSend(granter, recipient, nil)}).ValidateBasic())
+}
@@ -0,0 +1,91 @@
+package authz
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the authz Querier
+const (
+	QueryAuthorization = "authorization"
+	QueryGrants        = "grants"
+)
+
+// QueryAuthorizationParams are the params for the authorization of a granter
+// to a grantee for a message type query
+type QueryAuthorizationParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+}
+
+// NewQueryAuthorizationParams creates a new instance of QueryAuthorizationParams
+func NewQueryAuthorizationParams(granter, grantee sdk.AccAddress, msgType string) QueryAuthorizationParams {
+	return QueryAuthorizationParams{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// QueryGrantsParams are the params for the grants of a granter to a grantee
+// query
+type QueryGrantsParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewQueryGrantsParams creates a new instance of QueryGrantsParams
+func NewQueryGrantsParams(granter, grantee sdk.AccAddress) QueryGrantsParams {
+	return QueryGrantsParams{Granter: granter, Grantee: grantee}
+}
+
+// NewQuerier creates a querier for the authz module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryAuthorization:
+			return queryAuthorization(ctx, req, k)
+		case QueryGrants:
+			return queryGrants(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown authz query endpoint")
+		}
+	}
+}
+
+func queryAuthorization(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAuthorizationParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grant, found := k.GetAuthorizationGrant(ctx, params.Granter, params.Grantee, params.MsgType)
+	if !found {
+		return nil, ErrNoAuthorization(DefaultCodespace, params.Granter, params.Grantee, params.MsgType)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grant)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryGrants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryGrantsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grants := k.GetAuthorizationGrants(ctx, params.Granter, params.Grantee)
+	if grants == nil {
+		grants = []AuthorizationGrant{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"my-cosmos/cosmos-sdk/client"
 	"my-cosmos/cosmos-sdk/client/context"
@@ -12,11 +13,13 @@ import (
 	"my-cosmos/cosmos-sdk/x/bank"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 const (
-	flagTo     = "to"
-	flagAmount = "amount"
+	flagTo      = "to"
+	flagAmount  = "amount"
+	flagDelayed = "delayed"
 )
 
 // SendTxCmd will create a send tx and sign it with the given key.
@@ -68,3 +71,49 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	return client.PostCommands(cmd)[0]
 }
+
+// CreateVestingAccountCmd will create a tx funding a new vesting account and
+// sign it with the given key.
+func CreateVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create and sign a tx funding a new vesting account",
+		Long: `Create a new vesting account at to_address funded with amount from the
+sender's coins. The coins vest continuously from the block time until end_time
+(unix epoch), or all at once at end_time with --delayed. The transaction fails
+if an account already exists at to_address.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end time %s: %v", args[2], err)
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := bank.NewMsgCreateVestingAccount(from, to, coins, endTime, viper.GetBool(flagDelayed))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().Bool(flagDelayed, false, "vest all coins at the end time instead of continuously")
+	return client.PostCommands(cmd)[0]
+}
@@ -18,6 +18,7 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keys.Keybase) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/vesting", CreateVestingAccountRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
 }
 
 // SendReq defines the properties of a send request's body.
@@ -26,6 +27,15 @@ type SendReq struct {
 	Amount  sdk.Coins    `json:"amount"`
 }
 
+// CreateVestingAccountReq defines the properties of a create vesting account
+// request's body.
+type CreateVestingAccountReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  sdk.Coins    `json:"amount"`
+	EndTime int64        `json:"end_time"`
+	Delayed bool         `json:"delayed"`
+}
+
 var msgCdc = codec.New()
 
 func init() {
@@ -64,3 +74,37 @@ func SendRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIC
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// CreateVestingAccountRequestHandlerFn - http request handler to fund a new
+// vesting account at a address.
+func CreateVestingAccountRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32Addr := vars["address"]
+
+		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := bank.NewMsgCreateVestingAccount(fromAddr, toAddr, req.Amount, req.EndTime, req.Delayed)
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -1,6 +1,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +12,11 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeSendToModuleAccount  sdk.CodeType = 103
+	CodeUnknownModuleAccount sdk.CodeType = 104
+	CodeModulePermission     sdk.CodeType = 105
+	CodeAccountExists        sdk.CodeType = 106
+	CodeInvalidVestingTime   sdk.CodeType = 107
 )
 
 // ErrNoInputs is an error
@@ -31,3 +38,28 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrSendToModuleAccount is an error
+func ErrSendToModuleAccount(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeSendToModuleAccount, fmt.Sprintf("%s is a module account and cannot receive transfers", addr))
+}
+
+// ErrUnknownModuleAccount is an error
+func ErrUnknownModuleAccount(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownModuleAccount, fmt.Sprintf("module account %s does not exist", name))
+}
+
+// ErrModulePermission is an error
+func ErrModulePermission(codespace sdk.CodespaceType, name, permission string) sdk.Error {
+	return sdk.NewError(codespace, CodeModulePermission, fmt.Sprintf("module account %s does not have %s permission", name, permission))
+}
+
+// ErrAccountExists is an error
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("account %s already exists", addr))
+}
+
+// ErrInvalidVestingTime is an error
+func ErrInvalidVestingTime(codespace sdk.CodespaceType, endTime int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVestingTime, fmt.Sprintf("vesting end time %d must be after the block time", endTime))
+}
@@ -12,6 +12,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSend(ctx, k, msg)
 		case MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
+		case MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
 		default:
 			errMsg := "Unrecognized bank Msg type: %s" + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -24,6 +26,9 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 	if !k.GetSendEnabled(ctx) {
 		return ErrSendDisabled(k.Codespace()).Result()
 	}
+	if k.BlockedAddr(ctx, msg.ToAddress) {
+		return ErrSendToModuleAccount(k.Codespace(), msg.ToAddress).Result()
+	}
 	tags, err := k.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 	if err != nil {
 		return err.Result()
@@ -40,6 +45,11 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg MsgMultiSend) sdk.Result
 	if !k.GetSendEnabled(ctx) {
 		return ErrSendDisabled(k.Codespace()).Result()
 	}
+	for _, out := range msg.Outputs {
+		if k.BlockedAddr(ctx, out.Address) {
+			return ErrSendToModuleAccount(k.Codespace(), out.Address).Result()
+		}
+	}
 	tags, err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return err.Result()
@@ -49,3 +59,21 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg MsgMultiSend) sdk.Result
 		Tags: tags,
 	}
 }
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(ctx sdk.Context, k Keeper, msg MsgCreateVestingAccount) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return ErrSendDisabled(k.Codespace()).Result()
+	}
+	if k.BlockedAddr(ctx, msg.ToAddress) {
+		return ErrSendToModuleAccount(k.Codespace(), msg.ToAddress).Result()
+	}
+	tags, err := k.CreateVestingAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.EndTime, msg.Delayed)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
@@ -23,6 +23,14 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+
+	CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, endTime int64, delayed bool) (sdk.Tags, sdk.Error)
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -132,12 +140,156 @@ func (keeper BaseKeeper) UndelegateCoins(
 	return undelegateCoins(ctx, keeper.ak, addr, amt)
 }
 
+// SendCoinsFromModuleToAccount moves coins from the account of a module to
+// another account.
+func (keeper BaseKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+
+	senderAcc, err := keeper.moduleAccount(ctx, senderModule)
+	if err != nil {
+		return nil, err
+	}
+	return keeper.SendCoins(ctx, senderAcc.GetAddress(), recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule moves coins from an account to the account of a
+// module.
+func (keeper BaseKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+
+	recipientAcc, err := keeper.moduleAccount(ctx, recipientModule)
+	if err != nil {
+		return nil, err
+	}
+	return keeper.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// SendCoinsFromModuleToModule moves coins from the account of a module to the
+// account of another module.
+func (keeper BaseKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+
+	senderAcc, err := keeper.moduleAccount(ctx, senderModule)
+	if err != nil {
+		return nil, err
+	}
+	recipientAcc, err := keeper.moduleAccount(ctx, recipientModule)
+	if err != nil {
+		return nil, err
+	}
+	return keeper.SendCoins(ctx, senderAcc.GetAddress(), recipientAcc.GetAddress(), amt)
+}
+
+// DelegateCoinsFromAccountToModule delegates coins of an account to the
+// account of a module, tracking the delegation of vesting coins. The module
+// must have the staking permission.
+func (keeper BaseKeeper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+
+	recipientAcc, err := keeper.moduleAccount(ctx, recipientModule)
+	if err != nil {
+		return nil, err
+	}
+	if !recipientAcc.HasPermission(auth.Staking) {
+		return nil, ErrModulePermission(keeper.Codespace(), recipientModule, auth.Staking)
+	}
+
+	tags, err := keeper.DelegateCoins(ctx, senderAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	_, addTags, err := addCoins(ctx, keeper.ak, recipientAcc.GetAddress(), amt)
+	if err != nil {
+		return nil, err
+	}
+	return tags.AppendTags(addTags), nil
+}
+
+// UndelegateCoinsFromModuleToAccount returns delegated coins from the account
+// of a module to the delegator account, tracking the undelegation of vesting
+// coins. The module must have the staking permission.
+func (keeper BaseKeeper) UndelegateCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+
+	senderAcc, err := keeper.moduleAccount(ctx, senderModule)
+	if err != nil {
+		return nil, err
+	}
+	if !senderAcc.HasPermission(auth.Staking) {
+		return nil, ErrModulePermission(keeper.Codespace(), senderModule, auth.Staking)
+	}
+
+	if !amt.IsValid() {
+		return nil, sdk.ErrInvalidCoins(amt.String())
+	}
+	_, subTags, err := subtractCoins(ctx, keeper.ak, senderAcc.GetAddress(), amt)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := keeper.UndelegateCoins(ctx, recipientAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	return subTags.AppendTags(tags), nil
+}
+
+// CreateVestingAccount moves amt from fromAddr to a new vesting account at
+// toAddr. The account vests amt continuously from the block time until
+// endTime, or all at once at endTime if delayed. It fails if an account
+// already exists at toAddr.
+func (keeper BaseKeeper) CreateVestingAccount(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, endTime int64, delayed bool,
+) (sdk.Tags, sdk.Error) {
+
+	if keeper.ak.GetAccount(ctx, toAddr) != nil {
+		return nil, ErrAccountExists(keeper.Codespace(), toAddr)
+	}
+
+	startTime := ctx.BlockHeader().Time.Unix()
+	if endTime <= startTime {
+		return nil, ErrInvalidVestingTime(keeper.Codespace(), endTime)
+	}
+
+	_, tags, err := subtractCoins(ctx, keeper.ak, fromAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAcc := auth.NewBaseAccountWithAddress(toAddr)
+	baseAcc.Coins = amt
+
+	var vacc auth.VestingAccount
+	if delayed {
+		vacc = auth.NewDelayedVestingAccount(&baseAcc, endTime)
+	} else {
+		vacc = auth.NewContinuousVestingAccount(&baseAcc, startTime, endTime)
+	}
+	keeper.ak.SetAccount(ctx, keeper.ak.NewAccount(ctx, vacc))
+
+	return tags.AppendTags(sdk.NewTags(TagKeyRecipient, toAddr.String())), nil
+}
+
+// moduleAccount returns the account of the given module, which must exist.
+func (keeper BaseKeeper) moduleAccount(ctx sdk.Context, name string) (*auth.ModuleAccount, sdk.Error) {
+	macc := keeper.ak.GetModuleAccount(ctx, name)
+	if macc == nil {
+		return nil, ErrUnknownModuleAccount(keeper.Codespace(), name)
+	}
+	return macc, nil
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
 	ViewKeeper
 
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
@@ -179,6 +331,13 @@ func (keeper BaseSendKeeper) SendCoins(
 	return sendCoins(ctx, keeper.ak, fromAddr, toAddr, amt)
 }
 
+// BlockedAddr returns whether the address belongs to a module account, which
+// only receives coins from its module and never from transfers.
+func (keeper BaseSendKeeper) BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := keeper.ak.GetAccount(ctx, addr).(*auth.ModuleAccount)
+	return ok
+}
+
 // GetSendEnabled returns the current SendEnabled
 // nolint: errcheck
 func (keeper BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
@@ -358,3 +358,144 @@ func TestUndelegateCoins(t *testing.T) {
 	vacc = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
 	require.Equal(t, origCoins, vacc.GetCoins())
 }
+
+func TestModuleAccountSend(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+
+	coins := sdk.Coins{sdk.NewInt64Coin("steak", 100)}
+	addr := sdk.AccAddress([]byte("addr1"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	bankKeeper.SetCoins(ctx, addr, coins)
+
+	// module accounts must exist before coins can move through them
+	_, err := bankKeeper.SendCoinsFromAccountToModule(ctx, addr, "pool", coins)
+	require.Error(t, err)
+	require.Equal(t, CodeUnknownModuleAccount, err.Code())
+
+	pool := input.ak.NewAccount(ctx, auth.NewEmptyModuleAccount("pool"))
+	input.ak.SetAccount(ctx, pool)
+	other := input.ak.NewAccount(ctx, auth.NewEmptyModuleAccount("other"))
+	input.ak.SetAccount(ctx, other)
+
+	require.True(t, bankKeeper.BlockedAddr(ctx, pool.GetAddress()))
+	require.False(t, bankKeeper.BlockedAddr(ctx, addr))
+
+	_, err = bankKeeper.SendCoinsFromAccountToModule(ctx, addr, "pool", coins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, pool.GetAddress()).IsEqual(coins))
+
+	_, err = bankKeeper.SendCoinsFromModuleToModule(ctx, "pool", "other", coins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, other.GetAddress()).IsEqual(coins))
+
+	_, err = bankKeeper.SendCoinsFromModuleToAccount(ctx, "other", addr, coins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(coins))
+	require.True(t, bankKeeper.GetCoins(ctx, other.GetAddress()).Empty())
+
+	// transfers to module accounts are rejected
+	msg := NewMsgSend(addr, pool.GetAddress(), coins)
+	bankKeeper.SetSendEnabled(ctx, true)
+	res := NewHandler(bankKeeper)(ctx, msg)
+	require.Equal(t, CodeSendToModuleAccount, res.Code)
+}
+
+func TestDelegateCoinsToModule(t *testing.T) {
+	input := setupTestInput()
+	now := tmtime.Now()
+	ctx := input.ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin("steak", 100)}
+	delCoins := sdk.Coins{sdk.NewInt64Coin("steak", 50)}
+	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	bacc := auth.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	vacc := auth.NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	input.ak.SetAccount(ctx, vacc)
+
+	pool := input.ak.NewAccount(ctx, auth.NewEmptyModuleAccount("pool"))
+	input.ak.SetAccount(ctx, pool)
+	staking := input.ak.NewAccount(ctx, auth.NewEmptyModuleAccount("staking", auth.Staking))
+	input.ak.SetAccount(ctx, staking)
+
+	// only modules with the staking permission hold delegations
+	_, err := bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr, "pool", delCoins)
+	require.Error(t, err)
+	require.Equal(t, CodeModulePermission, err.Code())
+
+	_, err = bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr, "staking", delCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, staking.GetAddress()).IsEqual(delCoins))
+	vacc = input.ak.GetAccount(ctx, addr).(*auth.ContinuousVestingAccount)
+	require.Equal(t, delCoins, vacc.GetDelegatedVesting())
+
+	_, err = bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, "staking", addr, delCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, staking.GetAddress()).Empty())
+	vacc = input.ak.GetAccount(ctx, addr).(*auth.ContinuousVestingAccount)
+	require.Equal(t, origCoins, vacc.GetCoins())
+}
+
+func TestCreateVestingAccount(t *testing.T) {
+	input := setupTestInput()
+	now := tmtime.Now()
+	ctx := input.ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin("steak", 100)}
+	vestingCoins := sdk.Coins{sdk.NewInt64Coin("steak", 60)}
+	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+	bankKeeper.SetSendEnabled(ctx, true)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	acc := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc.SetCoins(origCoins)
+	input.ak.SetAccount(ctx, acc)
+
+	// create a continuous vesting account starting at the block time
+	_, err := bankKeeper.CreateVestingAccount(ctx, addr1, addr2, vestingCoins, endTime.Unix(), false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 40)}, bankKeeper.GetCoins(ctx, addr1))
+
+	cva, ok := input.ak.GetAccount(ctx, addr2).(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, vestingCoins, cva.GetCoins())
+	require.Equal(t, vestingCoins, cva.GetOriginalVesting())
+	require.Equal(t, now.Unix(), cva.GetStartTime())
+	require.Equal(t, endTime.Unix(), cva.GetEndTime())
+
+	// require that the new account cannot spend its vesting coins
+	_, err = bankKeeper.SendCoins(ctx, addr2, addr1, vestingCoins)
+	require.Error(t, err)
+
+	// require that existing accounts are rejected
+	_, err = bankKeeper.CreateVestingAccount(ctx, addr1, addr2, sdk.Coins{sdk.NewInt64Coin("steak", 10)}, endTime.Unix(), false)
+	require.Error(t, err)
+
+	// require an end time after the block time
+	_, err = bankKeeper.CreateVestingAccount(ctx, addr1, addr3, sdk.Coins{sdk.NewInt64Coin("steak", 10)}, now.Unix(), true)
+	require.Error(t, err)
+	require.Nil(t, input.ak.GetAccount(ctx, addr3))
+
+	// require the sender to have the coins
+	_, err = bankKeeper.CreateVestingAccount(ctx, addr1, addr3, origCoins, endTime.Unix(), true)
+	require.Error(t, err)
+	require.Nil(t, input.ak.GetAccount(ctx, addr3))
+
+	// create a delayed vesting account
+	_, err = bankKeeper.CreateVestingAccount(ctx, addr1, addr3, sdk.Coins{sdk.NewInt64Coin("steak", 40)}, endTime.Unix(), true)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr1).Empty())
+
+	dva, ok := input.ak.GetAccount(ctx, addr3).(*auth.DelayedVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 40)}, dva.GetOriginalVesting())
+	require.Equal(t, endTime.Unix(), dva.GetEndTime())
+}
@@ -54,6 +54,68 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// MsgCreateVestingAccount - funds a new vesting account from the sender's
+// coins. The account vests continuously from the block time until EndTime, or
+// all at once at EndTime if Delayed is set.
+type MsgCreateVestingAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.Coins      `json:"amount"`
+	EndTime     int64          `json:"end_time"` // vesting end time (UNIX Epoch time)
+	Delayed     bool           `json:"delayed"`  // vest all coins at the end time
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// NewMsgCreateVestingAccount - construct a msg to create a vesting account.
+func NewMsgCreateVestingAccount(
+	fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool,
+) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+	if msg.EndTime <= 0 {
+		return ErrInvalidVestingTime(DefaultCodespace, msg.EndTime)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
 // MsgMultiSend - high level transaction of the coin module
 type MsgMultiSend struct {
 	Inputs  []Input  `json:"inputs"`
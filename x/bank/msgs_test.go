@@ -69,6 +69,47 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%v", res), "[696E70757431]")
 }
 
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.Coins{sdk.NewInt64Coin("atom", 123)}
+	atom0 := sdk.Coins{sdk.NewInt64Coin("atom", 0)}
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 1554668078, false)},      // valid continuous vesting
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 1554668078, true)},       // valid delayed vesting
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 1554668078, false)},       // non positive coin
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom123, 1554668078, false)}, // empty from addr
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom123, 1554668078, false)}, // empty to addr
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, false)},              // missing end time
+	}
+
+	for i, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+func TestMsgCreateVestingAccountGetSignBytes(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	addr2 := sdk.AccAddress([]byte("output"))
+	coins := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
+	var msg = NewMsgCreateVestingAccount(addr1, addr2, coins, 1554668078, true)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgCreateVestingAccount","value":{"amount":[{"amount":"10","denom":"atom"}],"delayed":true,"end_time":"1554668078","from_address":"cosmos1d9h8qat57ljhcm","to_address":"cosmos1da6hgur4wsmpnjyg"}}`
+	require.Equal(t, expected, string(res))
+}
+
 func TestMsgMultiSendRoute(t *testing.T) {
 	// Construct a MsgSend
 	addr1 := sdk.AccAddress([]byte("input"))
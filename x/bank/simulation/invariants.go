@@ -6,6 +6,7 @@ import (
 
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/supply"
 )
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
@@ -43,3 +44,27 @@ func TotalCoinsInvariant(ak auth.AccountKeeper, totalSupplyFn func() sdk.Coins)
 		return nil
 	}
 }
+
+// TotalSupplyInvariant checks that the accounts, together with the coins held
+// outside of them by other modules, hold exactly the recorded total supply
+func TotalSupplyInvariant(ak auth.AccountKeeper, sk supply.Keeper, heldFn func(sdk.Context) sdk.DecCoins) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		total := sdk.DecCoins{}
+
+		ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+			total = total.Add(sdk.NewDecCoins(acc.GetCoins()))
+			return false
+		})
+		held := heldFn(ctx)
+		total = total.Add(held)
+
+		supply := sk.GetTotalSupply(ctx)
+		if diff, _ := total.SafeSub(sdk.NewDecCoins(supply)); !diff.Empty() {
+			return fmt.Errorf("total supply invariance:\n"+
+				"\tsupply: %v\n"+
+				"\tcoins held by accounts and modules: %v\n"+
+				"\tof which held by modules: %v", supply, total, held)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+package circuit
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// msgsExecutor is implemented by the messages executing other messages, such
+// as the authz MsgExec, whose messages are checked as well
+type msgsExecutor interface {
+	GetMsgs() []sdk.Msg
+}
+
+// NewAnteHandler returns an AnteHandler which rejects the transactions holding
+// a disabled message before running the next AnteHandler. Since it runs in
+// CheckTx, such transactions do not reach the mempool.
+func NewAnteHandler(k Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(
+		ctx sdk.Context, tx sdk.Tx, simulate bool,
+	) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+		if err := k.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+			return ctx, err.Result(), true
+		}
+
+		return next(ctx, tx, simulate)
+	}
+}
+
+func (k Keeper) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) sdk.Error {
+	for _, msg := range msgs {
+		if k.IsMsgDisabled(ctx, msg) {
+			return ErrMsgDisabled(DefaultCodespace, msg)
+		}
+		if exec, ok := msg.(msgsExecutor); ok {
+			if err := k.checkMsgs(ctx, exec.GetMsgs()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/circuit"
+)
+
+// GetCmdQueryDisabled implements the query disabled message types command.
+func GetCmdQueryDisabled(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disabled",
+		Short: "Query the message types disabled by the circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, circuit.QueryDisabled), nil)
+			if err != nil {
+				return err
+			}
+
+			var msgTypes circuit.MsgTypes
+			cdc.MustUnmarshalJSON(res, &msgTypes)
+			return cliCtx.PrintOutput(msgTypes)
+		},
+	}
+}
+
+// GetCmdQueryAuthorities implements the query authorities command.
+func GetCmdQueryAuthorities(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorities",
+		Short: "Query the accounts allowed to disable and enable message types",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, circuit.QueryAuthorities), nil)
+			if err != nil {
+				return err
+			}
+
+			var authorities circuit.Authorities
+			cdc.MustUnmarshalJSON(res, &authorities)
+			return cliCtx.PrintOutput(authorities)
+		},
+	}
+}
+
+// DONTCOVER
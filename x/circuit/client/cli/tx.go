@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/circuit"
+	"my-cosmos/cosmos-sdk/x/gov"
+)
+
+// circuitBreakerProposal defines a circuit breaker proposal read from a file
+type circuitBreakerProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Disable     []circuit.MsgType `json:"disable"`
+	Enable      []circuit.MsgType `json:"enable"`
+	Deposit     string            `json:"deposit"`
+}
+
+func parseMsgTypes(args []string) ([]circuit.MsgType, error) {
+	msgTypes := make([]circuit.MsgType, len(args))
+	for i, arg := range args {
+		m, err := circuit.ParseMsgType(arg)
+		if err != nil {
+			return nil, err
+		}
+		msgTypes[i] = m
+	}
+	return msgTypes, nil
+}
+
+// GetCmdDisableMsgs implements the disable message types command.
+func GetCmdDisableMsgs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [msg-type...]",
+		Short: "Disable message types as a circuit breaker authority",
+		Long: strings.TrimSpace(`
+Disable the messages of the given types, written as route/type, or as route
+alone to disable every message of the route. Only the circuit breaker
+authorities may do so:
+
+$ gaiacli tx circuit disable bank/send staking --from mykey
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			msgTypes, err := parseMsgTypes(args)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgDisableMsgs(cliCtx.GetFromAddress(), msgTypes)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return client.PostCommands(cmd)[0]
+}
+
+// GetCmdEnableMsgs implements the enable message types command.
+func GetCmdEnableMsgs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable [msg-type...]",
+		Short: "Enable disabled message types as a circuit breaker authority",
+		Long: strings.TrimSpace(`
+Enable again the messages of the given types, written as they were disabled:
+
+$ gaiacli tx circuit enable bank/send staking --from mykey
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			msgTypes, err := parseMsgTypes(args)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgEnableMsgs(cliCtx.GetFromAddress(), msgTypes)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return client.PostCommands(cmd)[0]
+}
+
+// GetCmdSubmitProposal implements a command handler for submitting a circuit
+// breaker proposal transaction.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "circuit-breaker [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a circuit breaker proposal",
+		Long: strings.TrimSpace(`
+Submit a proposal to disable and enable message types along with an initial deposit. The proposal details must be supplied via a JSON file. An empty type disables every message of the route:
+
+$ gaiacli tx gov submit-proposal circuit-breaker <path/to/proposal.json> --from mykey
+
+where proposal.json contains:
+
+{
+  "title": "Disable bank sends",
+  "description": "Disable bank sends until the fix is released",
+  "disable": [
+    {
+      "route": "bank",
+      "type": "send"
+    }
+  ],
+  "enable": [],
+  "deposit": "10stake"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal := circuitBreakerProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := circuit.NewCircuitBreakerProposal(proposal.Title, proposal.Description, proposal.Disable, proposal.Enable)
+
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
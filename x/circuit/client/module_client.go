@@ -0,0 +1,52 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/x/circuit"
+	"my-cosmos/cosmos-sdk/x/circuit/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	circuitQueryCmd := &cobra.Command{
+		Use:   circuit.ModuleName,
+		Short: "Querying commands for the circuit breaker module",
+	}
+
+	circuitQueryCmd.AddCommand(
+		client.GetCommands(
+			cli.GetCmdQueryDisabled(mc.storeKey, mc.cdc),
+			cli.GetCmdQueryAuthorities(mc.storeKey, mc.cdc),
+		)...,
+	)
+
+	return circuitQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:   circuit.ModuleName,
+		Short: "Circuit breaker transactions subcommands",
+	}
+
+	circuitTxCmd.AddCommand(
+		cli.GetCmdDisableMsgs(mc.cdc),
+		cli.GetCmdEnableMsgs(mc.cdc),
+	)
+
+	return circuitTxCmd
+}
@@ -0,0 +1,159 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	clientrest "my-cosmos/cosmos-sdk/client/rest"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/types/rest"
+	"my-cosmos/cosmos-sdk/x/circuit"
+	"my-cosmos/cosmos-sdk/x/gov"
+	govrest "my-cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+// RegisterRoutes registers circuit breaker related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/circuit/disabled",
+		queryHandlerFn(cdc, cliCtx, circuit.QueryDisabled),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/circuit/authorities",
+		queryHandlerFn(cdc, cliCtx, circuit.QueryAuthorities),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/circuit/disable",
+		disableMsgsHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/circuit/enable",
+		enableMsgsHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+}
+
+// MsgTypesReq defines the properties of a disable or enable message types
+// request's body.
+type MsgTypesReq struct {
+	BaseReq  rest.BaseReq      `json:"base_req"`
+	MsgTypes []circuit.MsgType `json:"msg_types"`
+}
+
+// CircuitBreakerProposalReq defines the properties of a circuit breaker
+// proposal request's body.
+type CircuitBreakerProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string            `json:"title"`       // Title of the proposal
+	Description string            `json:"description"` // Description of the proposal
+	Disable     []circuit.MsgType `json:"disable"`     // Message types disabled when the proposal passes
+	Enable      []circuit.MsgType `json:"enable"`      // Message types enabled when the proposal passes
+	Proposer    sdk.AccAddress    `json:"proposer"`    // Address of the proposer
+	Deposit     sdk.Coins         `json:"deposit"`     // Coins to add to the proposal's deposit
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the circuit
+// breaker REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "circuit_breaker",
+		Handler:  postProposalHandlerFn(cdc, cliCtx),
+	}
+}
+
+func queryHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", circuit.QuerierRoute, endpoint)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func disableMsgsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, authority, ok := readMsgTypesReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		msg := circuit.NewMsgDisableMsgs(authority, req.MsgTypes)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func enableMsgsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, authority, ok := readMsgTypesReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		msg := circuit.NewMsgEnableMsgs(authority, req.MsgTypes)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func readMsgTypesReq(w http.ResponseWriter, r *http.Request, cdc *codec.Codec) (req MsgTypesReq, authority sdk.AccAddress, ok bool) {
+	if !rest.ReadRESTReq(w, r, cdc, &req) {
+		return req, nil, false
+	}
+
+	req.BaseReq = req.BaseReq.Sanitize()
+	if !req.BaseReq.ValidateBasic(w) {
+		return req, nil, false
+	}
+
+	authority, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, false
+	}
+
+	return req, authority, true
+}
+
+func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CircuitBreakerProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := circuit.NewCircuitBreakerProposal(req.Title, req.Description, req.Disable, req.Enable)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.Deposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,19 @@
+package circuit
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the messages and the governance proposal contents
+// of the circuit module on the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgDisableMsgs{}, "cosmos-sdk/MsgDisableMsgs", nil)
+	cdc.RegisterConcrete(MsgEnableMsgs{}, "cosmos-sdk/MsgEnableMsgs", nil)
+	cdc.RegisterConcrete(CircuitBreakerProposal{}, "circuit/CircuitBreakerProposal", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
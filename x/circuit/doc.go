@@ -0,0 +1,18 @@
+/*
+Package circuit lets governance or a set of authorized accounts disable the
+messages of a module, or a single message type, when a bug is found in their
+handler.
+
+The ante handler returned by NewAnteHandler rejects the transactions holding
+a disabled message, including the messages executed by an authz MsgExec,
+before they reach the mempool:
+
+	app.SetAnteHandler(circuit.NewAnteHandler(circuitKeeper, auth.NewAnteHandler(ak, fck)))
+
+The authorities set at genesis disable and enable messages with
+MsgDisableMsgs and MsgEnableMsgs, and a passed CircuitBreakerProposal does so
+on behalf of governance. A message type is written as "route/type", as in
+"bank/send", or as "route" alone to disable every message of the route. The
+messages of the circuit module itself can not be disabled.
+*/
+package circuit
@@ -0,0 +1,29 @@
+// nolint
+package circuit
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeMsgDisabled    sdk.CodeType = 1
+	CodeUnauthorized   sdk.CodeType = 2
+	CodeInvalidMsgType sdk.CodeType = 3
+)
+
+func ErrMsgDisabled(codespace sdk.CodespaceType, msg sdk.Msg) sdk.Error {
+	return sdk.NewError(codespace, CodeMsgDisabled,
+		fmt.Sprintf("message %s/%s is disabled by the circuit breaker", msg.Route(), msg.Type()))
+}
+
+func ErrUnauthorized(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, fmt.Sprintf("%s is not a circuit breaker authority", addr))
+}
+
+func ErrInvalidMsgType(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMsgType, fmt.Sprintf("invalid message type: %s", msg))
+}
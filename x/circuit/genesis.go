@@ -0,0 +1,66 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the circuit breaker authorities and disabled message types
+// at genesis
+type GenesisState struct {
+	Authorities      []sdk.AccAddress `json:"authorities"`
+	DisabledMsgTypes []MsgType        `json:"disabled_msg_types"`
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(authorities []sdk.AccAddress, disabledMsgTypes []MsgType) GenesisState {
+	return GenesisState{
+		Authorities:      authorities,
+		DisabledMsgTypes: disabledMsgTypes,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]sdk.AccAddress{}, []MsgType{})
+}
+
+// InitGenesis sets the authorities and disabled message types from genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, addr := range data.Authorities {
+		keeper.SetAuthority(ctx, addr)
+	}
+	for _, m := range data.DisabledMsgTypes {
+		keeper.DisableMsgType(ctx, m)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	authorities := keeper.GetAuthorities(ctx)
+	if authorities == nil {
+		authorities = []sdk.AccAddress{}
+	}
+	disabledMsgTypes := keeper.GetDisabledMsgTypes(ctx)
+	if disabledMsgTypes == nil {
+		disabledMsgTypes = []MsgType{}
+	}
+	return NewGenesisState(authorities, disabledMsgTypes)
+}
+
+// ValidateGenesis checks that the authorities and disabled message types are
+// well formed
+func ValidateGenesis(data GenesisState) error {
+	for _, addr := range data.Authorities {
+		if addr.Empty() {
+			return fmt.Errorf("empty circuit breaker authority")
+		}
+	}
+	for _, m := range data.DisabledMsgTypes {
+		if err := m.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid disabled message type %s: %s", m, err.Error())
+		}
+	}
+	return nil
+}
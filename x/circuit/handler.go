@@ -0,0 +1,59 @@
+package circuit
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Tag keys
+const (
+	TagKeyAuthority = "authority"
+)
+
+// NewHandler returns a handler for "circuit" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgDisableMsgs:
+			return handleMsgDisableMsgs(ctx, k, msg)
+		case MsgEnableMsgs:
+			return handleMsgEnableMsgs(ctx, k, msg)
+		default:
+			errMsg := "Unrecognized circuit Msg type: " + msg.Type()
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle MsgDisableMsgs.
+func handleMsgDisableMsgs(ctx sdk.Context, k Keeper, msg MsgDisableMsgs) sdk.Result {
+	if !k.IsAuthority(ctx, msg.Authority) {
+		return ErrUnauthorized(DefaultCodespace, msg.Authority).Result()
+	}
+
+	for _, m := range msg.MsgTypes {
+		k.DisableMsgType(ctx, m)
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeyAuthority, msg.Authority.String(),
+		),
+	}
+}
+
+// Handle MsgEnableMsgs.
+func handleMsgEnableMsgs(ctx sdk.Context, k Keeper, msg MsgEnableMsgs) sdk.Result {
+	if !k.IsAuthority(ctx, msg.Authority) {
+		return ErrUnauthorized(DefaultCodespace, msg.Authority).Result()
+	}
+
+	for _, m := range msg.MsgTypes {
+		k.EnableMsgType(ctx, m)
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeyAuthority, msg.Authority.String(),
+		),
+	}
+}
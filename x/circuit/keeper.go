@@ -0,0 +1,114 @@
+package circuit
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "circuit"
+
+	// StoreKey is the store key string for the circuit breaker
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the circuit breaker
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the circuit breaker
+	QuerierRoute = ModuleName
+)
+
+// Keys for circuit breaker store
+var (
+	// DisabledMsgKeyPrefix is the prefix for the disabled message types
+	DisabledMsgKeyPrefix = []byte{0x00}
+
+	// AuthorityKeyPrefix is the prefix for the accounts allowed to disable
+	// and enable message types
+	AuthorityKeyPrefix = []byte{0x01}
+)
+
+// DisabledMsgKey returns the key under which a disabled message type is stored
+func DisabledMsgKey(m MsgType) []byte {
+	return append(DisabledMsgKeyPrefix, []byte(m.String())...)
+}
+
+// AuthorityKey returns the key under which an authority is stored
+func AuthorityKey(addr sdk.AccAddress) []byte {
+	return append(AuthorityKeyPrefix, addr.Bytes()...)
+}
+
+// Keeper of the circuit breaker store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+}
+
+// NewKeeper returns a circuit breaker keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// DisableMsgType disables the messages of a message type
+func (k Keeper) DisableMsgType(ctx sdk.Context, m MsgType) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(DisabledMsgKey(m), k.cdc.MustMarshalBinaryLengthPrefixed(m))
+}
+
+// EnableMsgType enables again the messages of a disabled message type. It
+// does not enable a type whose whole route is disabled.
+func (k Keeper) EnableMsgType(ctx sdk.Context, m MsgType) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(DisabledMsgKey(m))
+}
+
+// IsMsgDisabled returns true if the message type of the message, or its whole
+// route, is disabled
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, msg sdk.Msg) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(DisabledMsgKey(NewMsgType(msg.Route(), ""))) ||
+		store.Has(DisabledMsgKey(NewMsgType(msg.Route(), msg.Type())))
+}
+
+// GetDisabledMsgTypes returns all the disabled message types
+func (k Keeper) GetDisabledMsgTypes(ctx sdk.Context) (msgTypes []MsgType) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DisabledMsgKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var m MsgType
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &m)
+		msgTypes = append(msgTypes, m)
+	}
+	return msgTypes
+}
+
+// SetAuthority allows an account to disable and enable message types
+func (k Keeper) SetAuthority(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(AuthorityKey(addr), []byte{})
+}
+
+// IsAuthority returns true if the account may disable and enable message types
+func (k Keeper) IsAuthority(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(AuthorityKey(addr))
+}
+
+// GetAuthorities returns all the accounts allowed to disable and enable
+// message types
+func (k Keeper) GetAuthorities(ctx sdk.Context) (authorities []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, AuthorityKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		authorities = append(authorities, sdk.AccAddress(iterator.Key()[len(AuthorityKeyPrefix):]))
+	}
+	return authorities
+}
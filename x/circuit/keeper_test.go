@@ -0,0 +1,154 @@
+package circuit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/store"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/authz"
+	"my-cosmos/cosmos-sdk/x/bank"
+)
+
+var (
+	authority = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr1     = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2     = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	bankSend = NewMsgType("bank", "send")
+	sendMsg  = bank.NewMsgSend(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10, Time: time.Unix(1000, 0)}, true, log.NewNopLogger())
+	keeper := NewKeeper(codec.New(), key)
+	InitGenesis(ctx, keeper, NewGenesisState([]sdk.AccAddress{authority}, []MsgType{}))
+
+	return ctx, keeper
+}
+
+// testTx is a transaction holding the given messages
+type testTx []sdk.Msg
+
+func (tx testTx) GetMsgs() []sdk.Msg       { return tx }
+func (tx testTx) ValidateBasic() sdk.Error { return nil }
+
+func nextAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+	return ctx, sdk.Result{}, false
+}
+
+func TestDisableEnableMsgType(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	require.False(t, keeper.IsMsgDisabled(ctx, sendMsg))
+
+	keeper.DisableMsgType(ctx, bankSend)
+	require.True(t, keeper.IsMsgDisabled(ctx, sendMsg))
+	require.False(t, keeper.IsMsgDisabled(ctx, bank.NewMsgMultiSend(nil, nil)))
+	require.Equal(t, []MsgType{bankSend}, keeper.GetDisabledMsgTypes(ctx))
+
+	keeper.EnableMsgType(ctx, bankSend)
+	require.False(t, keeper.IsMsgDisabled(ctx, sendMsg))
+	require.Empty(t, keeper.GetDisabledMsgTypes(ctx))
+
+	// an empty type disables the whole route
+	keeper.DisableMsgType(ctx, NewMsgType("bank", ""))
+	require.True(t, keeper.IsMsgDisabled(ctx, sendMsg))
+	require.True(t, keeper.IsMsgDisabled(ctx, bank.NewMsgMultiSend(nil, nil)))
+
+	// enabling a single type does not enable the disabled route
+	keeper.EnableMsgType(ctx, bankSend)
+	require.True(t, keeper.IsMsgDisabled(ctx, sendMsg))
+}
+
+func TestAnteHandler(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	anteHandler := NewAnteHandler(keeper, nextAnteHandler)
+
+	_, res, abort := anteHandler(ctx, testTx{sendMsg}, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+
+	keeper.DisableMsgType(ctx, bankSend)
+	_, res, abort = anteHandler(ctx, testTx{sendMsg}, false)
+	require.True(t, abort)
+	require.Equal(t, CodeMsgDisabled, res.Code)
+	require.Contains(t, res.Log, "bank/send is disabled")
+
+	// the messages executed by an authz grantee are checked as well
+	exec := authz.NewMsgExec(addr2, []sdk.Msg{sendMsg})
+	_, res, abort = anteHandler(ctx, testTx{exec}, false)
+	require.True(t, abort)
+	require.Equal(t, CodeMsgDisabled, res.Code)
+
+	keeper.EnableMsgType(ctx, bankSend)
+	_, res, abort = anteHandler(ctx, testTx{exec}, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+}
+
+func TestHandleMsgDisableEnableMsgs(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	handler := NewHandler(keeper)
+
+	res := handler(ctx, NewMsgDisableMsgs(addr1, []MsgType{bankSend}))
+	require.Equal(t, CodeUnauthorized, res.Code)
+	require.False(t, keeper.IsMsgDisabled(ctx, sendMsg))
+
+	res = handler(ctx, NewMsgDisableMsgs(authority, []MsgType{bankSend}))
+	require.True(t, res.IsOK())
+	require.True(t, keeper.IsMsgDisabled(ctx, sendMsg))
+
+	res = handler(ctx, NewMsgEnableMsgs(addr1, []MsgType{bankSend}))
+	require.Equal(t, CodeUnauthorized, res.Code)
+	require.True(t, keeper.IsMsgDisabled(ctx, sendMsg))
+
+	res = handler(ctx, NewMsgEnableMsgs(authority, []MsgType{bankSend}))
+	require.True(t, res.IsOK())
+	require.False(t, keeper.IsMsgDisabled(ctx, sendMsg))
+}
+
+func TestCircuitBreakerProposalHandler(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	handler := NewCircuitBreakerProposalHandler(keeper)
+	keeper.DisableMsgType(ctx, NewMsgType("staking", ""))
+
+	proposal := NewCircuitBreakerProposal("Test", "description", []MsgType{bankSend}, []MsgType{NewMsgType("staking", "")})
+	require.Nil(t, proposal.ValidateBasic())
+	require.Nil(t, handler(ctx, proposal))
+	require.Equal(t, []MsgType{bankSend}, keeper.GetDisabledMsgTypes(ctx))
+
+	require.NotNil(t, NewCircuitBreakerProposal("Test", "description", nil, nil).ValidateBasic())
+	require.NotNil(t, NewCircuitBreakerProposal("Test", "", []MsgType{bankSend}, nil).ValidateBasic())
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	keeper.DisableMsgType(ctx, bankSend)
+
+	genesis := ExportGenesis(ctx, keeper)
+	require.Equal(t, []sdk.AccAddress{authority}, genesis.Authorities)
+	require.Equal(t, []MsgType{bankSend}, genesis.DisabledMsgTypes)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	ctx2, keeper2 := createTestInput(t)
+	InitGenesis(ctx2, keeper2, genesis)
+	require.Equal(t, genesis, ExportGenesis(ctx2, keeper2))
+
+	genesis.DisabledMsgTypes = []MsgType{NewMsgType(RouterKey, "")}
+	require.Error(t, ValidateGenesis(genesis))
+}
@@ -0,0 +1,76 @@
+package circuit
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// MsgType identifies the messages of a route and type. An empty type stands
+// for every message of the route.
+type MsgType struct {
+	Route string `json:"route"`
+	Type  string `json:"type"`
+}
+
+// NewMsgType creates a new MsgType
+func NewMsgType(route, msgType string) MsgType {
+	return MsgType{Route: route, Type: msgType}
+}
+
+// ParseMsgType parses a message type written as "route/type", or "route" for
+// every message of the route
+func ParseMsgType(s string) (MsgType, error) {
+	parts := strings.SplitN(strings.TrimSpace(s), "/", 2)
+	m := NewMsgType(parts[0], "")
+	if len(parts) == 2 {
+		m.Type = parts[1]
+	}
+	if err := m.ValidateBasic(); err != nil {
+		return MsgType{}, err
+	}
+	return m, nil
+}
+
+// ValidateBasic checks that the message type names a route other than the one
+// of the circuit module
+func (m MsgType) ValidateBasic() sdk.Error {
+	if m.Route == "" || strings.Contains(m.Route, "/") {
+		return ErrInvalidMsgType(DefaultCodespace, fmt.Sprintf("invalid route %q", m.Route))
+	}
+	if strings.Contains(m.Type, "/") {
+		return ErrInvalidMsgType(DefaultCodespace, fmt.Sprintf("invalid type %q", m.Type))
+	}
+	if m.Route == RouterKey {
+		return ErrInvalidMsgType(DefaultCodespace, "the circuit messages can not be disabled")
+	}
+	return nil
+}
+
+func (m MsgType) String() string {
+	if m.Type == "" {
+		return m.Route
+	}
+	return fmt.Sprintf("%s/%s", m.Route, m.Type)
+}
+
+// MsgTypes is a list of message types
+type MsgTypes []MsgType
+
+func (ms MsgTypes) String() string {
+	out := make([]string, len(ms))
+	for i, m := range ms {
+		out[i] = m.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+func validateMsgTypes(msgTypes []MsgType) sdk.Error {
+	for _, m := range msgTypes {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
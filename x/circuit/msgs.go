@@ -0,0 +1,83 @@
+package circuit
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// MsgDisableMsgs - disables the messages of the given message types
+type MsgDisableMsgs struct {
+	Authority sdk.AccAddress `json:"authority"`
+	MsgTypes  []MsgType      `json:"msg_types"`
+}
+
+var _ sdk.Msg = MsgDisableMsgs{}
+
+// NewMsgDisableMsgs creates a new MsgDisableMsgs
+func NewMsgDisableMsgs(authority sdk.AccAddress, msgTypes []MsgType) MsgDisableMsgs {
+	return MsgDisableMsgs{Authority: authority, MsgTypes: msgTypes}
+}
+
+// Route Implements Msg.
+func (msg MsgDisableMsgs) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgDisableMsgs) Type() string { return "disable_msgs" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgDisableMsgs) ValidateBasic() sdk.Error {
+	return validateAuthorityMsg(msg.Authority, msg.MsgTypes)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDisableMsgs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDisableMsgs) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// MsgEnableMsgs - enables again the messages of the given message types
+type MsgEnableMsgs struct {
+	Authority sdk.AccAddress `json:"authority"`
+	MsgTypes  []MsgType      `json:"msg_types"`
+}
+
+var _ sdk.Msg = MsgEnableMsgs{}
+
+// NewMsgEnableMsgs creates a new MsgEnableMsgs
+func NewMsgEnableMsgs(authority sdk.AccAddress, msgTypes []MsgType) MsgEnableMsgs {
+	return MsgEnableMsgs{Authority: authority, MsgTypes: msgTypes}
+}
+
+// Route Implements Msg.
+func (msg MsgEnableMsgs) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgEnableMsgs) Type() string { return "enable_msgs" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgEnableMsgs) ValidateBasic() sdk.Error {
+	return validateAuthorityMsg(msg.Authority, msg.MsgTypes)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEnableMsgs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEnableMsgs) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+func validateAuthorityMsg(authority sdk.AccAddress, msgTypes []MsgType) sdk.Error {
+	if authority.Empty() {
+		return sdk.ErrInvalidAddress("missing authority address")
+	}
+	if len(msgTypes) == 0 {
+		return ErrInvalidMsgType(DefaultCodespace, "no message types")
+	}
+	return validateMsgTypes(msgTypes)
+}
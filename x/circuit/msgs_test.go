@@ -0,0 +1,41 @@
+package circuit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDisableMsgsValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgDisableMsgs(authority, []MsgType{bankSend}).ValidateBasic())
+	require.NotNil(t, NewMsgDisableMsgs(nil, []MsgType{bankSend}).ValidateBasic())
+	require.NotNil(t, NewMsgDisableMsgs(authority, nil).ValidateBasic())
+	require.NotNil(t, NewMsgDisableMsgs(authority, []MsgType{NewMsgType("", "send")}).ValidateBasic())
+
+	// the circuit messages can not be disabled
+	err := NewMsgDisableMsgs(authority, []MsgType{NewMsgType(RouterKey, "")}).ValidateBasic()
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidMsgType, err.Code())
+
+	require.Nil(t, NewMsgEnableMsgs(authority, []MsgType{bankSend}).ValidateBasic())
+	require.NotNil(t, NewMsgEnableMsgs(nil, []MsgType{bankSend}).ValidateBasic())
+}
+
+func TestParseMsgType(t *testing.T) {
+	m, err := ParseMsgType("bank/send")
+	require.NoError(t, err)
+	require.Equal(t, bankSend, m)
+	require.Equal(t, "bank/send", m.String())
+
+	m, err = ParseMsgType("staking")
+	require.NoError(t, err)
+	require.Equal(t, NewMsgType("staking", ""), m)
+	require.Equal(t, "staking", m.String())
+
+	_, err = ParseMsgType("")
+	require.Error(t, err)
+	_, err = ParseMsgType("bank/send/extra")
+	require.Error(t, err)
+	_, err = ParseMsgType(RouterKey + "/disable_msgs")
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCircuitBreaker is the type of a CircuitBreakerProposal
+	ProposalTypeCircuitBreaker = "CircuitBreaker"
+)
+
+// Assert CircuitBreakerProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = CircuitBreakerProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(CircuitBreakerProposal{}, "circuit/CircuitBreakerProposal")
+}
+
+// CircuitBreakerProposal is a governance proposal content which, once the
+// proposal passes, disables and enables the given message types
+type CircuitBreakerProposal struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Disable     []MsgType `json:"disable"`
+	Enable      []MsgType `json:"enable"`
+}
+
+// NewCircuitBreakerProposal creates a CircuitBreakerProposal
+func NewCircuitBreakerProposal(title, description string, disable, enable []MsgType) CircuitBreakerProposal {
+	return CircuitBreakerProposal{title, description, disable, enable}
+}
+
+// nolint
+func (cbp CircuitBreakerProposal) GetTitle() string       { return cbp.Title }
+func (cbp CircuitBreakerProposal) GetDescription() string { return cbp.Description }
+func (cbp CircuitBreakerProposal) ProposalRoute() string  { return RouterKey }
+func (cbp CircuitBreakerProposal) ProposalType() string   { return ProposalTypeCircuitBreaker }
+
+// ValidateBasic validates the title and description of the proposal along with
+// its message types
+func (cbp CircuitBreakerProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(DefaultCodespace, cbp); err != nil {
+		return err
+	}
+
+	if len(cbp.Disable) == 0 && len(cbp.Enable) == 0 {
+		return ErrInvalidMsgType(DefaultCodespace, "no message types")
+	}
+
+	if err := validateMsgTypes(cbp.Disable); err != nil {
+		return err
+	}
+	return validateMsgTypes(cbp.Enable)
+}
+
+func (cbp CircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Circuit Breaker Proposal:
+  Title:       %s
+  Description: %s
+  Disable:     %v
+  Enable:      %v
+`, cbp.Title, cbp.Description, cbp.Disable, cbp.Enable)
+}
@@ -0,0 +1,29 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewCircuitBreakerProposalHandler returns the governance handler disabling
+// and enabling the message types of passed CircuitBreakerProposals
+func NewCircuitBreakerProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case CircuitBreakerProposal:
+			for _, m := range c.Disable {
+				k.DisableMsgType(ctx, m)
+			}
+			for _, m := range c.Enable {
+				k.EnableMsgType(ctx, m)
+			}
+			return nil
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized circuit proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package circuit
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the circuit Querier
+const (
+	QueryDisabled    = "disabled"
+	QueryAuthorities = "authorities"
+)
+
+// NewQuerier creates a querier for the circuit module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryDisabled:
+			return queryDisabled(ctx, k)
+		case QueryAuthorities:
+			return queryAuthorities(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown circuit query endpoint")
+		}
+	}
+}
+
+// Authorities is the list of accounts allowed to disable and enable message
+// types
+type Authorities []sdk.AccAddress
+
+func (as Authorities) String() string {
+	out := make([]string, len(as))
+	for i, a := range as {
+		out[i] = a.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+func queryDisabled(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	msgTypes := k.GetDisabledMsgTypes(ctx)
+	if msgTypes == nil {
+		msgTypes = []MsgType{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, msgTypes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryAuthorities(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	authorities := k.GetAuthorities(ctx)
+	if authorities == nil {
+		authorities = []sdk.AccAddress{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, authorities)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
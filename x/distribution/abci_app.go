@@ -47,3 +47,21 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 
 }
+
+// compound the rewards of the delegators which enabled auto-compounding. A
+// sweep starts every auto-compound period and goes through at most
+// max-compounds-per-block delegations per block, resuming at the next block
+// until it is over. A period of zero disables the sweeps.
+//
+// 每隔 AutoCompoundPeriod 个区块开始一轮扫描, 将开启了自动复投的委托人的奖励重新委托,
+// 每个区块最多处理 MaxCompoundsPerBlock 个委托, 未处理完的在下一个区块继续
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	period := k.GetAutoCompoundPeriod(ctx)
+	if period <= 0 {
+		return
+	}
+	if _, _, found := k.GetAutoCompoundCursor(ctx); !found && ctx.BlockHeight()%period != 0 {
+		return
+	}
+	k.CompoundDelegatorRewards(ctx, k.GetMaxCompoundsPerBlock(ctx))
+}
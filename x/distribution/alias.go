@@ -15,11 +15,16 @@ type (
 	MsgWithdrawDelegatorReward     = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission = types.MsgWithdrawValidatorCommission
 
+	MsgWithdrawTokenizeShareRecordReward = types.MsgWithdrawTokenizeShareRecordReward
+	MsgSetAutoCompound                   = types.MsgSetAutoCompound
+
+	CommunityPoolSpendProposal = types.CommunityPoolSpendProposal
+
 	GenesisState = types.GenesisState
 
 	// expected keepers
 	StakingKeeper       = types.StakingKeeper
-	BankKeeper          = types.BankKeeper
+	SupplyKeeper        = types.SupplyKeeper
 	FeeCollectionKeeper = types.FeeCollectionKeeper
 
 	// querier param types
@@ -30,18 +35,24 @@ type (
 )
 
 const (
+	ModuleName       = types.ModuleName
 	DefaultCodespace = types.DefaultCodespace
 	CodeInvalidInput = types.CodeInvalidInput
 	StoreKey         = types.StoreKey
 	TStoreKey        = types.TStoreKey
 	RouterKey        = types.RouterKey
 	QuerierRoute     = types.QuerierRoute
+
+	ProposalTypeCommunityPoolSpend = types.ProposalTypeCommunityPoolSpend
 )
 
 var (
 	ErrNilDelegatorAddr = types.ErrNilDelegatorAddr
 	ErrNilWithdrawAddr  = types.ErrNilWithdrawAddr
 	ErrNilValidatorAddr = types.ErrNilValidatorAddr
+	ErrBadDistribution  = types.ErrBadDistribution
+
+	ErrNoTokenizeShareRecords = types.ErrNoTokenizeShareRecords
 
 	TagValidator = tags.Validator
 	TagDelegator = tags.Delegator
@@ -49,6 +60,10 @@ var (
 	NewMsgSetWithdrawAddress          = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward     = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission = types.NewMsgWithdrawValidatorCommission
+	NewCommunityPoolSpendProposal     = types.NewCommunityPoolSpendProposal
+
+	NewMsgWithdrawTokenizeShareRecordReward = types.NewMsgWithdrawTokenizeShareRecordReward
+	NewMsgSetAutoCompound                   = types.NewMsgSetAutoCompound
 
 	NewKeeper                                 = keeper.NewKeeper
 	NewQuerier                                = keeper.NewQuerier
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// communityPoolSpendProposal defines a community pool spend proposal read
+// from a file
+type communityPoolSpendProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Recipient   string `json:"recipient"`
+	Amount      string `json:"amount"`
+	Deposit     string `json:"deposit"`
+}
+
+func parseCommunityPoolSpendProposal(proposalFile string) (*communityPoolSpendProposal, error) {
+	proposal := &communityPoolSpendProposal{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, proposal); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
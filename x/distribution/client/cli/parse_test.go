@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommunityPoolSpendProposal(t *testing.T) {
+	okJSON, err := ioutil.TempFile("", "proposal")
+	require.Nil(t, err, "unexpected error")
+	okJSON.WriteString(`
+{
+  "title": "Community Pool Spend",
+  "description": "Pay me",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000test",
+  "deposit": "10test"
+}
+`)
+
+	// nonexistent json
+	_, err = parseCommunityPoolSpendProposal("fileDoesNotExist")
+	require.Error(t, err)
+
+	// ok json
+	proposal, err := parseCommunityPoolSpendProposal(okJSON.Name())
+	require.Nil(t, err, "unexpected error")
+	require.Equal(t, "Community Pool Spend", proposal.Title)
+	require.Equal(t, "Pay me", proposal.Description)
+	require.Equal(t, "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq", proposal.Recipient)
+	require.Equal(t, "1000test", proposal.Amount)
+	require.Equal(t, "10test", proposal.Deposit)
+
+	err = okJSON.Close()
+	require.Nil(t, err, "unexpected error")
+}
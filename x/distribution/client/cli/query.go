@@ -163,3 +163,35 @@ $ gaiacli query distr rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosm
 		},
 	}
 }
+
+// GetCmdQueryAutoCompound implements the query delegator auto-compound command.
+func GetCmdQueryAutoCompound(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the rewards of a delegator are compounded",
+		Long: strings.TrimSpace(`Query whether the rewards of a delegator in the bond denom are delegated again when they are withdrawn:
+
+$ gaiacli query distr auto-compound cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(distr.NewQueryDelegatorParams(delAddr))
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/auto_compound", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var enabled bool
+			cdc.MustUnmarshalJSON(res, &enabled)
+			fmt.Println(enabled)
+			return nil
+		},
+	}
+}
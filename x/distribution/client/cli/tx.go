@@ -2,6 +2,7 @@
 package cli
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ import (
 	"my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/gov"
 
 	"my-cosmos/cosmos-sdk/x/distribution/client/common"
 	"my-cosmos/cosmos-sdk/x/distribution/types"
@@ -103,6 +105,31 @@ $ gaiacli tx distr withdraw-all-rewards --from mykey
 	}
 }
 
+// command to withdraw the rewards of the delegations tokenized by the
+// tokenize share records of an owner
+func GetCmdWithdrawTokenizeShareRecordRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Short: "withdraw the rewards of the delegations you tokenized",
+		Long: strings.TrimSpace(`Withdraw the rewards of the delegations tokenized by the tokenize share records
+you own, whoever holds their share tokens:
+
+$ gaiacli tx distr withdraw-tokenize-share-rewards --from mykey
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
 // command to replace a delegator's withdrawal address
 func GetCmdSetWithdrawAddr(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -132,3 +159,93 @@ $ gaiacli tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --f
 	}
 	return cmd
 }
+
+// command to enable or disable the compounding of a delegator's rewards
+func GetCmdSetAutoCompound(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Short: "enable or disable the re-delegation of the rewards associated with an address",
+		Long: strings.TrimSpace(`Enable or disable the re-delegation of the rewards of a delegator. Once enabled, the rewards in the bond denom are delegated again to the validator they were earned from when they are withdrawn, and periodically by the chain. Rewards in other denoms are still paid to the withdraw address:
+
+$ gaiacli tx distr set-auto-compound true --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(cliCtx.GetFromAddress(), enabled)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return cmd
+}
+
+// GetCmdSubmitProposal implements the command to submit a community pool
+// spend proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool spend proposal",
+		Long: strings.TrimSpace(`Submit a proposal to pay coins out of the community pool along with an initial deposit. The proposal details must be supplied via a JSON file. The community pool must hold the amount on submission. If it no longer does once the proposal passes, the proposal fails and nothing is paid out.
+
+$ gaiacli tx gov submit-proposal community-pool-spend <path/to/proposal.json> --from mykey
+
+where proposal.json contains:
+
+{
+  "title": "Community Pool Spend",
+  "description": "Fund the development of a block explorer",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000stake",
+  "deposit": "10stake"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := parseCommunityPoolSpendProposal(args[0])
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolSpendProposal(proposal.Title, proposal.Description, recipient, amount)
+
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return cmd
+}
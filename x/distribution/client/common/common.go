@@ -36,8 +36,20 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, er
 		return PrettyParams{}, err
 	}
 
+	route = fmt.Sprintf("custom/%s/params/auto_compound_period", queryRoute)
+	retAutoCompoundPeriod, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/max_compounds_per_block", queryRoute)
+	retMaxCompoundsPerBlock, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
 	return NewPrettyParams(retCommunityTax, retBaseProposerReward,
-		retBonusProposerReward, retWithdrawAddrEnabled), nil
+		retBonusProposerReward, retWithdrawAddrEnabled, retAutoCompoundPeriod, retMaxCompoundsPerBlock), nil
 }
 
 // QueryDelegatorTotalRewards queries delegator total rewards.
@@ -7,19 +7,24 @@ import (
 
 // Convenience struct for CLI output
 type PrettyParams struct {
-	CommunityTax        json.RawMessage `json:"community_tax"`
-	BaseProposerReward  json.RawMessage `json:"base_proposer_reward"`
-	BonusProposerReward json.RawMessage `json:"bonus_proposer_reward"`
-	WithdrawAddrEnabled json.RawMessage `json:"withdraw_addr_enabled"`
+	CommunityTax         json.RawMessage `json:"community_tax"`
+	BaseProposerReward   json.RawMessage `json:"base_proposer_reward"`
+	BonusProposerReward  json.RawMessage `json:"bonus_proposer_reward"`
+	WithdrawAddrEnabled  json.RawMessage `json:"withdraw_addr_enabled"`
+	AutoCompoundPeriod   json.RawMessage `json:"auto_compound_period"`
+	MaxCompoundsPerBlock json.RawMessage `json:"max_compounds_per_block"`
 }
 
 // Construct a new PrettyParams
-func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage) PrettyParams {
+func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage,
+	withdrawAddrEnabled json.RawMessage, autoCompoundPeriod json.RawMessage, maxCompoundsPerBlock json.RawMessage) PrettyParams {
 	return PrettyParams{
-		CommunityTax:        communityTax,
-		BaseProposerReward:  baseProposerReward,
-		BonusProposerReward: bonusProposerReward,
-		WithdrawAddrEnabled: withdrawAddrEnabled,
+		CommunityTax:         communityTax,
+		BaseProposerReward:   baseProposerReward,
+		BonusProposerReward:  bonusProposerReward,
+		WithdrawAddrEnabled:  withdrawAddrEnabled,
+		AutoCompoundPeriod:   autoCompoundPeriod,
+		MaxCompoundsPerBlock: maxCompoundsPerBlock,
 	}
 }
 
@@ -28,7 +33,10 @@ func (pp PrettyParams) String() string {
   Community Tax:          %s
   Base Proposer Reward:   %s
   Bonus Proposer Reward:  %s
-  Withdraw Addr Enabled:  %s`, pp.CommunityTax,
-		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled)
+  Withdraw Addr Enabled:  %s
+  Auto Compound Period:   %s
+  Max Compounds Per Block: %s`, pp.CommunityTax,
+		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled, pp.AutoCompoundPeriod,
+		pp.MaxCompoundsPerBlock)
 
 }
@@ -31,6 +31,7 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		distCmds.GetCmdQueryValidatorCommission(mc.storeKey, mc.cdc),
 		distCmds.GetCmdQueryValidatorSlashes(mc.storeKey, mc.cdc),
 		distCmds.GetCmdQueryDelegatorRewards(mc.storeKey, mc.cdc),
+		distCmds.GetCmdQueryAutoCompound(mc.storeKey, mc.cdc),
 	)...)
 
 	return distQueryCmd
@@ -47,6 +48,8 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 		distCmds.GetCmdWithdrawRewards(mc.cdc),
 		distCmds.GetCmdSetWithdrawAddr(mc.cdc),
 		distCmds.GetCmdWithdrawAllRewards(mc.cdc, mc.storeKey),
+		distCmds.GetCmdWithdrawTokenizeShareRecordRewards(mc.cdc),
+		distCmds.GetCmdSetAutoCompound(mc.cdc),
 	)...)
 
 	return distTxCmd
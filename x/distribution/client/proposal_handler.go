@@ -0,0 +1,10 @@
+package client
+
+import (
+	"my-cosmos/cosmos-sdk/x/distribution/client/cli"
+	"my-cosmos/cosmos-sdk/x/distribution/client/rest"
+	govclient "my-cosmos/cosmos-sdk/x/gov/client"
+)
+
+// ProposalHandler is the community pool spend proposal handler of the gov client
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
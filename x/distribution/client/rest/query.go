@@ -37,6 +37,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router,
 		delegatorWithdrawalAddrHandlerFn(cliCtx, cdc, queryRoute),
 	).Methods("GET")
 
+	// Get whether the rewards of a delegator are compounded
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		delegatorAutoCompoundHandlerFn(cliCtx, cdc, queryRoute),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -116,6 +122,27 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, cdc *codec.Code
 	}
 }
 
+// HTTP request handler to query whether the rewards of a delegator are compounded
+func delegatorAutoCompoundHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec,
+	queryRoute string) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		bz := cdc.MustMarshalJSON(distribution.NewQueryDelegatorParams(delegatorAddr))
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/auto_compound", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -10,6 +10,8 @@ import (
 	"my-cosmos/cosmos-sdk/codec"
 	"my-cosmos/cosmos-sdk/x/distribution/client/common"
 	"my-cosmos/cosmos-sdk/x/distribution/types"
+	"my-cosmos/cosmos-sdk/x/gov"
+	govrest "my-cosmos/cosmos-sdk/x/gov/client/rest"
 
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/types/rest"
@@ -30,12 +32,24 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router,
 		withdrawDelegationRewardsHandlerFn(cdc, cliCtx),
 	).Methods("POST")
 
+	// Withdraw the rewards of the delegations tokenized by the records of an owner
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/tokenize_share_rewards",
+		withdrawTokenizeShareRecordRewardHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
 	// Replace the rewards withdrawal address
 	r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/withdraw_address",
 		setDelegatorWithdrawalAddrHandlerFn(cdc, cliCtx),
 	).Methods("POST")
 
+	// Enable or disable the compounding of the rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		setDelegatorAutoCompoundHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
@@ -53,8 +67,35 @@ type (
 		BaseReq         rest.BaseReq   `json:"base_req"`
 		WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
 	}
+
+	setAutoCompoundReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Enabled bool         `json:"enabled"`
+	}
+
+	// CommunityPoolSpendProposalReq defines a community pool spend proposal
+	// request body.
+	CommunityPoolSpendProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Recipient   sdk.AccAddress `json:"recipient"`
+		Amount      sdk.Coins      `json:"amount"`
+		Proposer    sdk.AccAddress `json:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit"`
+	}
 )
 
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the community
+// pool spend REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_spend",
+		Handler:  postProposalHandlerFn(cdc, cliCtx),
+	}
+}
+
 // Withdraw delegator rewards
 func withdrawDelegatorRewardsHandlerFn(
 	cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string,
@@ -152,6 +193,66 @@ func setDelegatorWithdrawalAddrHandlerFn(cdc *codec.Codec, cliCtx context.CLICon
 	}
 }
 
+// Enable or disable the compounding of a delegator's rewards
+func setDelegatorAutoCompoundHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAutoCompoundReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoCompound(delAddr, req.Enabled)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// Withdraw the rewards of the delegations tokenized by the records of an owner
+func withdrawTokenizeShareRecordRewardHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRewardsReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		ownerAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Withdraw validator rewards and commission
 func withdrawValidatorRewardsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -183,6 +284,31 @@ func withdrawValidatorRewardsHandlerFn(cdc *codec.Codec, cliCtx context.CLIConte
 	}
 }
 
+// Submit a community pool spend proposal
+func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolSpendProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.Deposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Auxiliary
 
 func checkDelegatorAddressVar(w http.ResponseWriter, r *http.Request) (sdk.AccAddress, bool) {
@@ -24,9 +24,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	TODO
 	*/
 	keeper.SetWithdrawAddrEnabled(ctx, data.WithdrawAddrEnabled)
+	keeper.SetAutoCompoundPeriod(ctx, data.AutoCompoundPeriod)
+	keeper.SetMaxCompoundsPerBlock(ctx, data.MaxCompoundsPerBlock)
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
 	}
+	for _, del := range data.AutoCompoundDelegators {
+		keeper.SetDelegatorAutoCompound(ctx, del, true)
+	}
 	keeper.SetPreviousProposerConsAddr(ctx, data.PreviousProposer)
 	for _, rew := range data.OutstandingRewards {
 		keeper.SetValidatorOutstandingRewards(ctx, rew.ValidatorAddress, rew.OutstandingRewards)
@@ -55,6 +60,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	baseProposerRewards := keeper.GetBaseProposerReward(ctx)
 	bonusProposerRewards := keeper.GetBonusProposerReward(ctx)
 	withdrawAddrEnabled := keeper.GetWithdrawAddrEnabled(ctx)
+	autoCompoundPeriod := keeper.GetAutoCompoundPeriod(ctx)
+	maxCompoundsPerBlock := keeper.GetMaxCompoundsPerBlock(ctx)
 	dwi := make([]types.DelegatorWithdrawInfo, 0)
 	keeper.IterateDelegatorWithdrawAddrs(ctx, func(del sdk.AccAddress, addr sdk.AccAddress) (stop bool) {
 		dwi = append(dwi, types.DelegatorWithdrawInfo{
@@ -63,6 +70,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		})
 		return false
 	})
+	autoCompounds := make([]sdk.AccAddress, 0)
+	keeper.IterateDelegatorAutoCompounds(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompounds = append(autoCompounds, del)
+		return false
+	})
 	pp := keeper.GetPreviousProposerConsAddr(ctx)
 	outstanding := make([]types.ValidatorOutstandingRewardsRecord, 0)
 	keeper.IterateValidatorOutstandingRewards(ctx,
@@ -128,5 +140,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		autoCompoundPeriod, maxCompoundsPerBlock, dwi, autoCompounds, pp, outstanding, acc, his, cur, dels, slashes)
 }
@@ -32,6 +32,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			提取质押佣金
 			 */
 			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
+		case types.MsgWithdrawTokenizeShareRecordReward:
+
+			/**
+			提取代币化委托的奖励
+			 */
+			return handleMsgWithdrawTokenizeShareRecordReward(ctx, msg, k)
+		case types.MsgSetAutoCompound:
+
+			/**
+			开启或关闭奖励的自动复投
+			 */
+			return handleMsgSetAutoCompound(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in distribution module").Result()
 		}
@@ -88,3 +100,30 @@ func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg types.MsgWithdraw
 		Tags: tags,
 	}
 }
+
+func handleMsgWithdrawTokenizeShareRecordReward(ctx sdk.Context, msg types.MsgWithdrawTokenizeShareRecordReward, k keeper.Keeper) sdk.Result {
+
+	err := k.WithdrawTokenizeShareRecordReward(ctx, msg.OwnerAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.OwnerAddress.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgSetAutoCompound(ctx sdk.Context, msg types.MsgSetAutoCompound, k keeper.Keeper) sdk.Result {
+
+	k.SetDelegatorAutoCompound(ctx, msg.DelegatorAddress, msg.Enabled)
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddress.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
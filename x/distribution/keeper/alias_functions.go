@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // get outstanding rewards
@@ -13,3 +14,14 @@ func (k Keeper) GetValidatorOutstandingRewardsCoins(ctx sdk.Context, val sdk.Val
 func (k Keeper) GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins {
 	return k.GetFeePool(ctx).CommunityPool
 }
+
+// get all coins held by distribution: the community pool and the rewards not
+// yet withdrawn from any validator
+func (k Keeper) GetHeldCoins(ctx sdk.Context) sdk.DecCoins {
+	held := k.GetFeePoolCommunityCoins(ctx)
+	k.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+		held = held.Add(rewards)
+		return false
+	})
+	return held
+}
@@ -6,6 +6,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // allocate fees handles distribution of the collected fees
@@ -28,10 +30,16 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, sumPrecommitPower, totalPower in
 	feesCollected := sdk.NewDecCoins(feesCollectedInt)
 	feePool := k.GetFeePool(ctx)
 
-	// clear collected fees, which will now be distributed
+	// move the collected fees, which will now be distributed, from the fee
+	// collector to the distribution account
 	//
 	// 清空 积攒的金额
-	k.feeCollectionKeeper.ClearCollectedFees(ctx)
+	if !feesCollectedInt.Empty() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, auth.FeeCollectorName, types.ModuleName, feesCollectedInt)
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://my-cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
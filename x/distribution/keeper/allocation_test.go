@@ -75,7 +75,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	fees := sdk.Coins{
 		{sdk.DefaultBondDenom, sdk.NewInt(100)},
 	}
-	fck.SetCollectedFees(fees)
+	fck.AddCollectedFees(ctx, fees)
 	votes := []abci.VoteInfo{
 		{
 			Validator:       abciValA,
@@ -153,7 +153,7 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	fees := sdk.Coins{
 		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(634195840)),
 	}
-	fck.SetCollectedFees(fees)
+	fck.AddCollectedFees(ctx, fees)
 	votes := []abci.VoteInfo{
 		{
 			Validator:       abciValA,
@@ -131,12 +131,12 @@ func (k Keeper) calculateDelegationRewards(ctx sdk.Context, val sdk.Validator, d
 /**
 提取当前委托人在本周起获得的奖励，并更新周期为新的一轮周期
  */
-func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val sdk.Validator, del sdk.Delegation) sdk.Error {
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val sdk.Validator, del sdk.Delegation) (sdk.Coins, sdk.Error) {
 
 	// check existence of delegator starting info
 	// 检查委托人起始信息的存在
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
-		return types.ErrNoDelegationDistInfo(k.codespace)
+		return nil, types.ErrNoDelegationDistInfo(k.codespace)
 	}
 
 	// end current period and calculate rewards
@@ -195,19 +195,43 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val sdk.Validator, de
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder)
 	k.SetFeePool(ctx, feePool)
 
+	// remove delegator starting info
+	// 移除掉当前委托人的 其实委托信息
+	k.DeleteDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+
+	// the withdrawn coins are left in the module account, the caller pays them out
+	// 提取出的奖励仍在模块账户中, 由调用方发放
+	return coins, nil
+}
+
+// pay withdrawn delegation rewards to the withdraw address of the delegator.
+// When compounding, the rewards in the bond denom are delegated again to the
+// validator instead.
+func (k Keeper) payDelegationRewards(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress,
+	rewards sdk.Coins, compound bool) sdk.Error {
+
+	if compound {
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+		if amount := rewards.AmountOf(bondDenom); amount.IsPositive() {
+			bondCoins := sdk.Coins{sdk.NewCoin(bondDenom, amount)}
+			if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, bondCoins); err != nil {
+				return err
+			}
+			if err := k.stakingKeeper.DelegateTokens(ctx, delAddr, valAddr, amount); err != nil {
+				return err
+			}
+			rewards = rewards.Sub(bondCoins)
+		}
+	}
+
 	// add coins to user account
-	if !coins.IsZero() {
+	if !rewards.IsZero() {
 		// 获取 减持质押的(委托人)地址
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
 		// 将撤回的钱追加接受撤回钱的账户上, 并存储起来
-		if _, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coins); err != nil {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rewards); err != nil {
 			return err
 		}
 	}
-
-	// remove delegator starting info
-	// 移除掉当前委托人的 其实委托信息
-	k.DeleteDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
-
 	return nil
 }
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/distribution/types"
 	"my-cosmos/cosmos-sdk/x/staking"
 )
 
@@ -606,3 +609,159 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
 }
+
+func TestWithdrawDelegationRewardsAutoCompound(t *testing.T) {
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromTendermintPower(100)
+	commission := staking.NewCommissionMsg(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// back rewards in another denom with coins in the distribution account
+	initial := sdk.TokensFromTendermintPower(10)
+	distrAcc := ak.GetAccount(ctx, auth.NewModuleAddress(types.ModuleName))
+	require.Nil(t, distrAcc.SetCoins(distrAcc.GetCoins().Add(sdk.Coins{sdk.NewCoin("foocoin", initial.MulRaw(2))})))
+	ak.SetAccount(ctx, distrAcc)
+
+	// allocate some rewards
+	tokens := sdk.DecCoins{
+		sdk.NewDecCoin("foocoin", initial),
+		sdk.NewDecCoin(sdk.DefaultBondDenom, initial),
+	}
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+
+	// enable auto-compounding and pay the other rewards to another address
+	k.SetDelegatorAutoCompound(ctx, valAccAddr1, true)
+	k.SetDelegatorWithdrawAddr(ctx, valAccAddr1, delAddr1)
+	require.True(t, k.GetDelegatorAutoCompound(ctx, valAccAddr1))
+	balance := ak.GetAccount(ctx, valAccAddr1).GetCoins()
+	withdrawBalance := ak.GetAccount(ctx, delAddr1).GetCoins()
+
+	// withdraw rewards
+	require.Nil(t, k.WithdrawDelegationRewards(ctx, valAccAddr1, valOpAddr1))
+
+	// the rewards in the bond denom are delegated again
+	require.Equal(t, valTokens.Add(initial.QuoRaw(2)), sk.Validator(ctx, valOpAddr1).GetTokens())
+	require.Equal(t, balance, ak.GetAccount(ctx, valAccAddr1).GetCoins())
+	require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, valAccAddr1))
+
+	// the rewards in other denoms are paid to the withdraw address
+	require.Equal(t,
+		withdrawBalance.Add(sdk.Coins{sdk.NewCoin("foocoin", initial.QuoRaw(2))}),
+		ak.GetAccount(ctx, delAddr1).GetCoins(),
+	)
+
+	// allocate more rewards in the next block, which are compounded by the sweep
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	k.CompoundDelegatorRewards(ctx, 100)
+	compounded := sk.Validator(ctx, valOpAddr1).GetTokens()
+	require.True(t, compounded.GT(valTokens.Add(initial.QuoRaw(2))))
+	require.Equal(t, balance, ak.GetAccount(ctx, valAccAddr1).GetCoins())
+
+	// once disabled, the rewards are paid to the withdraw address
+	k.SetDelegatorAutoCompound(ctx, valAccAddr1, false)
+	require.False(t, k.GetDelegatorAutoCompound(ctx, valAccAddr1))
+	withdrawBalance = ak.GetAccount(ctx, delAddr1).GetCoins()
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	require.Nil(t, k.WithdrawDelegationRewards(ctx, valAccAddr1, valOpAddr1))
+	require.Equal(t, compounded, sk.Validator(ctx, valOpAddr1).GetTokens())
+	require.True(t, ak.GetAccount(ctx, delAddr1).GetCoins().AmountOf(sdk.DefaultBondDenom).
+		GT(withdrawBalance.AmountOf(sdk.DefaultBondDenom)))
+}
+
+func TestCompoundDelegatorRewardsPaginated(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create two validators without commission
+	valTokens := sdk.TokensFromTendermintPower(100)
+	commission := staking.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+	msg = staking.NewMsgCreateValidator(
+		valOpAddr2, valConsPk2,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// delegate to both validators
+	for _, valAddr := range []sdk.ValAddress{valOpAddr1, valOpAddr2} {
+		delMsg := staking.NewMsgDelegate(delAddr1, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, valTokens))
+		require.True(t, sh(ctx, delMsg).IsOK())
+	}
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(10))}
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr2), tokens)
+
+	// the sweep goes through the delegators by address, and through their
+	// delegations by validator address
+	k.SetDelegatorAutoCompound(ctx, valAccAddr1, true)
+	k.SetDelegatorAutoCompound(ctx, delAddr1, true)
+	type delegation struct {
+		delAddr sdk.AccAddress
+		valAddr sdk.ValAddress
+	}
+	delValAddrs := []sdk.ValAddress{valOpAddr1, valOpAddr2}
+	if bytes.Compare(valOpAddr2, valOpAddr1) < 0 {
+		delValAddrs = []sdk.ValAddress{valOpAddr2, valOpAddr1}
+	}
+	delegations := []delegation{{valAccAddr1, valOpAddr1}, {delAddr1, delValAddrs[0]}, {delAddr1, delValAddrs[1]}}
+	if bytes.Compare(delAddr1, valAccAddr1) < 0 {
+		delegations = []delegation{{delAddr1, delValAddrs[0]}, {delAddr1, delValAddrs[1]}, {valAccAddr1, valOpAddr1}}
+	}
+
+	pending := func(del delegation) sdk.DecCoins {
+		cacheCtx, _ := ctx.CacheContext()
+		val := sk.Validator(cacheCtx, del.valAddr)
+		endingPeriod := k.incrementValidatorPeriod(cacheCtx, val)
+		return k.calculateDelegationRewards(cacheCtx, val, sk.Delegation(cacheCtx, del.delAddr, del.valAddr), endingPeriod)
+	}
+	for _, del := range delegations {
+		require.False(t, pending(del).IsZero())
+	}
+
+	// the first block compounds two delegations and resumes from the third
+	k.CompoundDelegatorRewards(ctx, 2)
+	require.True(t, pending(delegations[0]).IsZero())
+	require.True(t, pending(delegations[1]).IsZero())
+	require.False(t, pending(delegations[2]).IsZero())
+	cursorDel, cursorVal, found := k.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, delegations[2].delAddr, cursorDel)
+	require.Equal(t, delegations[2].valAddr, cursorVal)
+
+	// the next block completes the sweep
+	k.CompoundDelegatorRewards(ctx, 2)
+	require.True(t, pending(delegations[2]).IsZero())
+	_, _, found = k.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+}
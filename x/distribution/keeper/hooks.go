@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // Wrapper struct
@@ -57,7 +58,7 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 			accAddr := sdk.AccAddress(valAddr)
 			withdrawAddr := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
 
-			if _, _, err := h.k.bankKeeper.AddCoins(ctx, withdrawAddr, coins); err != nil {
+			if err := h.k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
 				panic(err)
 			}
 		}
@@ -107,7 +108,14 @@ func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAd
 
 	// withdraw delegation rewards (which also increments period)
 	// (因为更改委托信息,所以需要撤回本周期获得的的奖励)退回委托奖励（也增加新的周期）
-	if err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	rewards, err := h.k.withdrawDelegationRewards(ctx, val, del)
+	if err != nil {
+		panic(err)
+	}
+
+	// the rewards are never compounded here, as that would modify the
+	// delegation again
+	if err := h.k.payDelegationRewards(ctx, valAddr, delAddr, rewards, false); err != nil {
 		panic(err)
 	}
 }
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+
 	"my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/distribution/types"
@@ -12,7 +15,7 @@ type Keeper struct {
 	storeKey            sdk.StoreKey
 	cdc                 *codec.Codec
 	paramSpace          params.Subspace
-	bankKeeper          types.BankKeeper
+	supplyKeeper        types.SupplyKeeper
 	stakingKeeper       types.StakingKeeper
 	feeCollectionKeeper types.FeeCollectionKeeper
 
@@ -21,13 +24,13 @@ type Keeper struct {
 }
 
 // create a new keeper
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ck types.BankKeeper,
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper,
 	sk types.StakingKeeper, fck types.FeeCollectionKeeper, codespace sdk.CodespaceType) Keeper {
 	keeper := Keeper{
 		storeKey:            key,
 		cdc:                 cdc,
 		paramSpace:          paramSpace.WithKeyTable(ParamKeyTable()),
-		bankKeeper:          ck,
+		supplyKeeper:        supplyKeeper,
 		stakingKeeper:       sk,
 		feeCollectionKeeper: fck,
 		codespace:           codespace,
@@ -64,7 +67,8 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 
 	// withdraw rewards
 	// 提取委托奖励
-	if err := k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	if err != nil {
 		return err
 	}
 
@@ -73,9 +77,94 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	// 重新初始化委托人信息
 	k.initializeDelegation(ctx, valAddr, delAddr)
 
+	// the delegation has to be reinitialized before compounding delegates to it
+	// 开启了自动复投的委托人, 其质押币种的奖励会重新委托给该验证人
+	return k.payDelegationRewards(ctx, valAddr, delAddr, rewards, k.GetDelegatorAutoCompound(ctx, delAddr))
+}
+
+// withdraw the rewards of the delegations tokenized by the tokenize share
+// records of an owner, which are paid to the owner
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) sdk.Error {
+	delegations := k.stakingKeeper.TokenizeShareRecordDelegations(ctx, ownerAddr)
+	if len(delegations) == 0 {
+		return types.ErrNoTokenizeShareRecords(k.codespace)
+	}
+
+	for _, del := range delegations {
+		if err := k.WithdrawDelegationRewards(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// withdraw the rewards of the delegations of the delegators which enabled
+// auto-compounding, so that their rewards in the bond denom are delegated
+// again. The sweep resumes from the auto-compound cursor and goes through at
+// most maxSteps delegations, a delegator without any delegation to compound
+// counting as one. The cursor is then set to where the next block resumes, or
+// deleted once the sweep is over. A delegation whose rewards can not be
+// compounded is skipped.
+func (k Keeper) CompoundDelegatorRewards(ctx sdk.Context, maxSteps int64) {
+	startDel, startVal, _ := k.GetAutoCompoundCursor(ctx)
+
+	// collect the delegations to compound before modifying the store
+	var (
+		delAddrs []sdk.AccAddress
+		valAddrs []sdk.ValAddress
+		steps    int64
+		stopped  bool
+		nextDel  sdk.AccAddress
+		nextVal  sdk.ValAddress
+	)
+	k.IterateDelegatorAutoCompoundsFrom(ctx, startDel, func(delAddr sdk.AccAddress) (stop bool) {
+		found := false
+
+		// the delegations of a delegator are iterated by validator address
+		k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del sdk.Delegation) (stop bool) {
+			valAddr := del.GetValidatorAddr()
+			if delAddr.Equals(startDel) && bytes.Compare(valAddr, startVal) < 0 {
+				return false
+			}
+			if steps == maxSteps {
+				nextDel, nextVal, stopped = delAddr, valAddr, true
+				return true
+			}
+			delAddrs = append(delAddrs, delAddr)
+			valAddrs = append(valAddrs, valAddr)
+			steps++
+			found = true
+			return false
+		})
+		if stopped || found {
+			return stopped
+		}
+
+		if steps == maxSteps {
+			nextDel, stopped = delAddr, true
+			return true
+		}
+		steps++
+		return false
+	})
+	if stopped {
+		k.SetAutoCompoundCursor(ctx, nextDel, nextVal)
+	} else {
+		k.DeleteAutoCompoundCursor(ctx)
+	}
+
+	logger := ctx.Logger().With("module", "x/distr")
+	for i, delAddr := range delAddrs {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddrs[i]); err != nil {
+			logger.Info(fmt.Sprintf("could not compound the rewards of delegator %s from validator %s: %s",
+				delAddr, valAddrs[i], err.Error()))
+			continue
+		}
+		writeCache()
+	}
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Error {
 
@@ -98,10 +187,32 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		accAddr := sdk.AccAddress(valAddr)
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
 
-		if _, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coins); err != nil {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// DistributeFromFeePool distributes funds from the community pool to the
+// receiver address. It fails if the pool does not hold enough coins.
+func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) sdk.Error {
+	feePool := k.GetFeePool(ctx)
+
+	// NOTE: the community pool is a DecCoins, the recipient is only ever paid
+	// whole coins
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoins(amount))
+	if negative {
+		return types.ErrBadDistribution(k.codespace)
+	}
+
+	feePool.CommunityPool = newPool
+	k.SetFeePool(ctx, feePool)
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiveAddr, amount); err != nil {
+		return err
+	}
+
+	return nil
+}
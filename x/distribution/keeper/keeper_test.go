@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -37,6 +38,11 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 		sdk.NewCoin("stake", sdk.TokensFromTendermintPower(1000)),
 	}, balance)
 
+	// back the commission in mytoken with coins in the distribution account
+	distrAcc := ak.GetModuleAccount(ctx, types.ModuleName)
+	require.Nil(t, distrAcc.SetCoins(distrAcc.GetCoins().Add(sdk.Coins{sdk.NewInt64Coin("mytoken", 1)})))
+	ak.SetAccount(ctx, distrAcc)
+
 	// set outstanding rewards
 	keeper.SetValidatorOutstandingRewards(ctx, valOpAddr3, valCommission)
 
@@ -62,3 +68,28 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 
 	require.True(t, true)
 }
+
+func TestDistributeFromFeePool(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(10).Quo(sdk.NewDec(4))),
+	}
+	keeper.SetFeePool(ctx, feePool)
+
+	recipient := sdk.AccAddress([]byte("recipient"))
+
+	// cannot spend more than the community pool holds
+	err := keeper.DistributeFromFeePool(ctx, sdk.Coins{sdk.NewInt64Coin("stake", 3)}, recipient)
+	require.NotNil(t, err)
+	require.Equal(t, feePool, keeper.GetFeePool(ctx))
+
+	err = keeper.DistributeFromFeePool(ctx, sdk.Coins{sdk.NewInt64Coin("stake", 2)}, recipient)
+	require.Nil(t, err)
+
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(1).Quo(sdk.NewDec(2))),
+	}, keeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 2)}, ak.GetAccount(ctx, recipient).GetCoins())
+}
@@ -35,6 +35,10 @@ var (
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	//
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	// 开启了奖励自动复投的委托人的key前缀
+	DelegatorAutoCompoundPrefix          = []byte{0x09} // key for delegator auto-compound setting
+	// 自动复投扫描的游标的key, 记录下一个区块从哪个委托继续扫描
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the next delegation of the auto-compound sweep
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 
@@ -45,6 +49,12 @@ var (
 
 	// 奖励是否可用标识 key?
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	// 自动复投的扫描周期 (区块数)
+	ParamStoreKeyAutoCompoundPeriod = []byte("autocompoundperiod")
+
+	// 每个区块最多复投的委托数
+	ParamStoreKeyMaxCompoundsPerBlock = []byte("maxcompoundsperblock")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -65,6 +75,15 @@ func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
 	return sdk.AccAddress(addr)
 }
 
+// gets an address from a delegator's auto-compound key
+func GetDelegatorAutoCompoundAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
 // gets the addresses from a delegator starting info key
 func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -138,6 +157,11 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the key for a delegator's auto-compound setting
+func GetDelegatorAutoCompoundKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorAutoCompoundPrefix, delAddr.Bytes()...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
@@ -12,6 +12,8 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
 		ParamStoreKeyWithdrawAddrEnabled, false,
+		ParamStoreKeyAutoCompoundPeriod, int64(0),
+		ParamStoreKeyMaxCompoundsPerBlock, int64(0),
 	)
 }
 
@@ -68,3 +70,29 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the number of blocks between two auto-compound sweeps
+// nolint: errcheck
+func (k Keeper) GetAutoCompoundPeriod(ctx sdk.Context) int64 {
+	var period int64
+	k.paramSpace.Get(ctx, ParamStoreKeyAutoCompoundPeriod, &period)
+	return period
+}
+
+// nolint: errcheck
+func (k Keeper) SetAutoCompoundPeriod(ctx sdk.Context, period int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAutoCompoundPeriod, &period)
+}
+
+// returns the maximum number of delegations compounded per block
+// nolint: errcheck
+func (k Keeper) GetMaxCompoundsPerBlock(ctx sdk.Context) int64 {
+	var max int64
+	k.paramSpace.Get(ctx, ParamStoreKeyMaxCompoundsPerBlock, &max)
+	return max
+}
+
+// nolint: errcheck
+func (k Keeper) SetMaxCompoundsPerBlock(ctx sdk.Context, max int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyMaxCompoundsPerBlock, &max)
+}
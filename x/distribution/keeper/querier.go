@@ -20,11 +20,14 @@ const (
 	QueryDelegatorTotalRewards       = "delegator_total_rewards"
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
-
-	ParamCommunityTax        = "community_tax"
-	ParamBaseProposerReward  = "base_proposer_reward"
-	ParamBonusProposerReward = "bonus_proposer_reward"
-	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+	QueryAutoCompound                = "auto_compound"
+
+	ParamCommunityTax         = "community_tax"
+	ParamBaseProposerReward   = "base_proposer_reward"
+	ParamBonusProposerReward  = "bonus_proposer_reward"
+	ParamWithdrawAddrEnabled  = "withdraw_addr_enabled"
+	ParamAutoCompoundPeriod   = "auto_compound_period"
+	ParamMaxCompoundsPerBlock = "max_compounds_per_block"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -54,6 +57,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryWithdrawAddr:
 			return queryDelegatorWithdrawAddress(ctx, path[1:], req, k)
 
+		case QueryAutoCompound:
+			return queryDelegatorAutoCompound(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
@@ -86,6 +92,18 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case ParamAutoCompoundPeriod:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAutoCompoundPeriod(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case ParamMaxCompoundsPerBlock:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetMaxCompoundsPerBlock(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -314,3 +332,18 @@ func queryDelegatorWithdrawAddress(ctx sdk.Context, _ []string, req abci.Request
 
 	return bz, nil
 }
+
+func queryDelegatorAutoCompound(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetDelegatorAutoCompound(ctx, params.DelegatorAddress))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -5,11 +5,15 @@ import (
 	"my-cosmos/cosmos-sdk/x/distribution/types"
 )
 
-// get the delegator withdraw address, defaulting to the delegator address
+// get the delegator withdraw address, defaulting to the delegator address.
+// The rewards of a tokenized delegation are paid to the owner of its record.
 func (k Keeper) GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress {
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(GetDelegatorWithdrawAddrKey(delAddr))
 	if b == nil {
+		if owner, found := k.stakingKeeper.TokenizeShareRecordOwner(ctx, delAddr); found {
+			return owner
+		}
 		return delAddr
 	}
 	return sdk.AccAddress(b)
@@ -41,6 +45,66 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get whether the rewards of a delegator in the bond denom are re-delegated
+func (k Keeper) GetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDelegatorAutoCompoundKey(delAddr))
+}
+
+// set whether the rewards of a delegator in the bond denom are re-delegated
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete(GetDelegatorAutoCompoundKey(delAddr))
+		return
+	}
+	store.Set(GetDelegatorAutoCompoundKey(delAddr), []byte{0x01})
+}
+
+// iterate over the delegators which enabled auto-compounding
+func (k Keeper) IterateDelegatorAutoCompounds(ctx sdk.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	k.IterateDelegatorAutoCompoundsFrom(ctx, nil, handler)
+}
+
+// iterate over the delegators which enabled auto-compounding, starting from
+// the given delegator, or from the first one if nil
+func (k Keeper) IterateDelegatorAutoCompoundsFrom(ctx sdk.Context, start sdk.AccAddress,
+	handler func(del sdk.AccAddress) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(GetDelegatorAutoCompoundKey(start), sdk.PrefixEndBytes(DelegatorAutoCompoundPrefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del := GetDelegatorAutoCompoundAddress(iter.Key())
+		if handler(del) {
+			break
+		}
+	}
+}
+
+// get the delegation the auto-compound sweep resumes from, the validator
+// address is empty to resume from the first delegation of the delegator
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (delAddr sdk.AccAddress, valAddr sdk.ValAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(AutoCompoundCursorKey)
+	if b == nil {
+		return nil, nil, false
+	}
+	return sdk.AccAddress(b[:sdk.AddrLen]), sdk.ValAddress(b[sdk.AddrLen:]), true
+}
+
+// set the delegation the auto-compound sweep resumes from at the next block
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(AutoCompoundCursorKey, append(append([]byte{}, delAddr...), valAddr...))
+}
+
+// delete the auto-compound cursor once the sweep is over
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(AutoCompoundCursorKey)
+}
+
 // get the global fee pool distribution info
 // 获取全局的费用池分配信息
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
@@ -18,6 +18,7 @@ import (
 	"my-cosmos/cosmos-sdk/x/bank"
 	"my-cosmos/cosmos-sdk/x/params"
 	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/supply"
 
 	"my-cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -72,7 +73,7 @@ func MakeTestCodec() *codec.Codec {
 
 // test input with default values
 func CreateTestInputDefault(t *testing.T, isCheckTx bool, initPower int64) (
-	sdk.Context, auth.AccountKeeper, Keeper, staking.Keeper, DummyFeeCollectionKeeper) {
+	sdk.Context, auth.AccountKeeper, Keeper, staking.Keeper, auth.FeeCollectionKeeper) {
 
 	communityTax := sdk.NewDecWithPrec(2, 2)
 	return CreateTestInputAdvanced(t, isCheckTx, initPower, communityTax)
@@ -81,7 +82,7 @@ func CreateTestInputDefault(t *testing.T, isCheckTx bool, initPower int64) (
 // hogpodge of all sorts of input required for testing
 func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	communityTax sdk.Dec) (
-	sdk.Context, auth.AccountKeeper, Keeper, staking.Keeper, DummyFeeCollectionKeeper) {
+	sdk.Context, auth.AccountKeeper, Keeper, staking.Keeper, auth.FeeCollectionKeeper) {
 
 	initCoins := sdk.TokensFromTendermintPower(initPower)
 
@@ -89,7 +90,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
-	keyFeeCollection := sdk.NewKVStoreKey(auth.FeeStoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 
@@ -100,7 +101,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, nil)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyFeeCollection, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 
@@ -113,11 +114,17 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, isCheckTx, log.NewNopLogger())
 	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 	ck := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
-	sk := staking.NewKeeper(cdc, keyStaking, tkeyStaking, ck, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, ck, map[string][]string{
+		auth.FeeCollectorName: nil,
+		types.ModuleName:      nil,
+		staking.ModuleName:    {auth.Minter, auth.Burner},
+	})
+	sk := staking.NewKeeper(cdc, keyStaking, tkeyStaking, ck, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 	sk.SetPool(ctx, staking.InitialPool())
 	sk.SetParams(ctx, staking.DefaultParams())
 
-	// fill all the addresses with some coins, set the loose pool tokens simultaneously
+	// fill all the addresses with some coins, set the loose pool tokens and the
+	// supply simultaneously
 	for _, addr := range addrs {
 		pool := sk.GetPool(ctx)
 		_, _, err := ck.AddCoins(ctx, addr, sdk.Coins{
@@ -126,10 +133,21 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 		require.Nil(t, err)
 		pool.NotBondedTokens = pool.NotBondedTokens.Add(initCoins)
 		sk.SetPool(ctx, pool)
+		supplyKeeper.Inflate(ctx, sdk.Coins{sdk.NewCoin(sk.GetParams(ctx).BondDenom, initCoins)})
 	}
 
-	fck := DummyFeeCollectionKeeper{}
-	keeper := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), ck, sk, fck, types.DefaultCodespace)
+	fck := auth.NewFeeCollectionKeeper(accountKeeper)
+	keeper := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), supplyKeeper, sk, fck, types.DefaultCodespace)
+
+	// tests often set rewards directly rather than allocating them from fees,
+	// so back them with coins in the distribution account
+	if initCoins.IsPositive() {
+		distrCoins := sdk.Coins{sdk.NewCoin(sk.GetParams(ctx).BondDenom, initCoins.MulRaw(int64(len(addrs))))}
+		distrAcc := supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+		_, _, err := ck.AddCoins(ctx, distrAcc.GetAddress(), distrCoins)
+		require.Nil(t, err)
+		supplyKeeper.Inflate(ctx, distrCoins)
+	}
 
 	// set the distribution hooks on staking
 	sk.SetHooks(keeper.Hooks())
@@ -142,21 +160,3 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 
 	return ctx, accountKeeper, keeper, sk, fck
 }
-
-//__________________________________________________________________________________
-// fee collection keeper used only for testing
-type DummyFeeCollectionKeeper struct{}
-
-var heldFees sdk.Coins
-var _ types.FeeCollectionKeeper = DummyFeeCollectionKeeper{}
-
-// nolint
-func (fck DummyFeeCollectionKeeper) GetCollectedFees(_ sdk.Context) sdk.Coins {
-	return heldFees
-}
-func (fck DummyFeeCollectionKeeper) SetCollectedFees(in sdk.Coins) {
-	heldFees = in
-}
-func (fck DummyFeeCollectionKeeper) ClearCollectedFees(_ sdk.Context) {
-	heldFees = sdk.Coins{}
-}
@@ -0,0 +1,24 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewCommunityPoolSpendProposalHandler returns the governance handler paying
+// out passed CommunityPoolSpendProposals
+func NewCommunityPoolSpendProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.CommunityPoolSpendProposal:
+			return k.DistributeFromFeePool(ctx, c.Amount, c.Recipient)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized distr proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
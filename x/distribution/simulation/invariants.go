@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
 	distr "my-cosmos/cosmos-sdk/x/distribution"
 	"my-cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -125,3 +126,22 @@ func ReferenceCountInvariant(k distr.Keeper, sk types.StakingKeeper) sdk.Invaria
 		return nil
 	}
 }
+
+// ModuleAccountInvariant checks that the distribution module account holds
+// exactly the outstanding rewards and the community pool
+func ModuleAccountInvariant(k distr.Keeper, ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var balance sdk.Coins
+		if macc := ak.GetModuleAccount(ctx, distr.ModuleName); macc != nil {
+			balance = macc.GetCoins()
+		}
+
+		held := k.GetHeldCoins(ctx)
+		if diff, _ := held.SafeSub(sdk.NewDecCoins(balance)); !diff.Empty() {
+			return fmt.Errorf("distribution module account invariance:\n"+
+				"\tmodule account coins: %v\n"+
+				"\toutstanding rewards and community pool: %v", balance, held)
+		}
+		return nil
+	}
+}
@@ -13,6 +13,7 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeBadDistribution         CodeType          = 107
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -36,3 +37,9 @@ func ErrNoValidatorCommission(codespace sdk.CodespaceType) sdk.Error {
 func ErrSetWithdrawAddrDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSetWithdrawAddrDisabled, "set withdraw address disabled")
 }
+func ErrBadDistribution(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBadDistribution, "community pool does not have sufficient coins to distribute")
+}
+func ErrNoTokenizeShareRecords(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDistributionInfo, "no tokenize share records owned by that address")
+}
@@ -12,19 +12,27 @@ type StakingKeeper interface {
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
+	// used to compound the rewards of a delegation
+	BondDenom(ctx sdk.Context) string
+	DelegateTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondAmt sdk.Int) sdk.Error
+
+	// used to pay the rewards of tokenized delegations to the owner of their record
+	TokenizeShareRecordOwner(ctx sdk.Context, addr sdk.AccAddress) (owner sdk.AccAddress, found bool)
+	TokenizeShareRecordDelegations(ctx sdk.Context, owner sdk.AccAddress) []sdk.Delegation
+
 	// used for invariants
 	IterateValidators(ctx sdk.Context,
 		fn func(index int64, validator sdk.Validator) (stop bool))
 	GetAllSDKDelegations(ctx sdk.Context) []sdk.Delegation
 }
 
-// expected coin keeper
-type BankKeeper interface {
-	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+// expected supply keeper
+type SupplyKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
 }
 
 // expected fee collection keeper
 type FeeCollectionKeeper interface {
 	GetCollectedFees(ctx sdk.Context) sdk.Coins
-	ClearCollectedFees(ctx sdk.Context)
 }
@@ -59,7 +59,10 @@ type GenesisState struct {
 	BaseProposerReward              sdk.Dec                                `json:"base_proposer_reward"`
 	BonusProposerReward             sdk.Dec                                `json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled             bool                                   `json:"withdraw_addr_enabled"`
+	AutoCompoundPeriod              int64                                  `json:"auto_compound_period"`
+	MaxCompoundsPerBlock            int64                                  `json:"max_compounds_per_block"`
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos"`
+	AutoCompoundDelegators          []sdk.AccAddress                       `json:"auto_compound_delegators"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer"`
 	OutstandingRewards              []ValidatorOutstandingRewardsRecord    `json:"outstanding_rewards"`
 	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `json:"validator_accumulated_commissions"`
@@ -70,7 +73,9 @@ type GenesisState struct {
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
-	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
+	withdrawAddrEnabled bool, autoCompoundPeriod, maxCompoundsPerBlock int64, dwis []DelegatorWithdrawInfo,
+	autoCompounds []sdk.AccAddress,
+	pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
 	slashes []ValidatorSlashEventRecord) GenesisState {
@@ -81,7 +86,10 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		BaseProposerReward:              baseProposerReward,
 		BonusProposerReward:             bonusProposerReward,
 		WithdrawAddrEnabled:             withdrawAddrEnabled,
+		AutoCompoundPeriod:              autoCompoundPeriod,
+		MaxCompoundsPerBlock:            maxCompoundsPerBlock,
 		DelegatorWithdrawInfos:          dwis,
+		AutoCompoundDelegators:          autoCompounds,
 		PreviousProposer:                pp,
 		OutstandingRewards:              r,
 		ValidatorAccumulatedCommissions: acc,
@@ -100,7 +108,10 @@ func DefaultGenesisState() GenesisState {
 		BaseProposerReward:              sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward:             sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled:             true,
+		AutoCompoundPeriod:              1000,
+		MaxCompoundsPerBlock:            100,
 		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
+		AutoCompoundDelegators:          []sdk.AccAddress{},
 		PreviousProposer:                nil,
 		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
 		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
@@ -131,5 +142,13 @@ func ValidateGenesis(data GenesisState) error {
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	if data.AutoCompoundPeriod < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundPeriod should be non-negative, is %d",
+			data.AutoCompoundPeriod)
+	}
+	if data.MaxCompoundsPerBlock <= 0 {
+		return fmt.Errorf("distribution parameter MaxCompoundsPerBlock should be positive, is %d",
+			data.MaxCompoundsPerBlock)
+	}
 	return data.FeePool.ValidateGenesis()
 }
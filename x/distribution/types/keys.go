@@ -1,6 +1,10 @@
 package types
 
 const (
+	// ModuleName is the name of the module, which owns the account holding
+	// the rewards and the community pool
+	ModuleName = "distribution"
+
 	// StoreKey is the store key string for distribution
 	StoreKey = "distr"
 
@@ -9,7 +9,8 @@ import (
 const MsgRoute = "distr"
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{},
+	&MsgWithdrawTokenizeShareRecordReward{}, &MsgSetAutoCompound{}
 
 // msg struct for changing the withdraw address for a delegator (or validator self-delegation)
 type MsgSetWithdrawAddress struct {
@@ -119,3 +120,75 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// msg struct for withdrawing the rewards of the delegations tokenized by the
+// tokenize share records of an owner
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress sdk.AccAddress `json:"owner_address"`
+}
+
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress) MsgWithdrawTokenizeShareRecordReward {
+	return MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+	}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return MsgRoute }
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return "withdraw_tokenize_share_record_reward"
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() sdk.Error {
+	if msg.OwnerAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+// msg struct for enabling or disabling the re-delegation of the rewards of a
+// delegator in the bond denom when they are withdrawn
+type MsgSetAutoCompound struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	Enabled          bool           `json:"enabled"`
+}
+
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, enabled bool) MsgSetAutoCompound {
+	return MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return MsgRoute }
+func (msg MsgSetAutoCompound) Type() string  { return "set_auto_compound" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoCompound) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCommunityPoolSpend is the type of a CommunityPoolSpendProposal
+	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+)
+
+// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = CommunityPoolSpendProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolSpendProposal{}, "distr/CommunityPoolSpendProposal")
+}
+
+// CommunityPoolSpendProposal is a governance proposal content which, once the
+// proposal passes, pays the amount out of the community pool to the recipient
+type CommunityPoolSpendProposal struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"`
+}
+
+// NewCommunityPoolSpendProposal creates a CommunityPoolSpendProposal
+func NewCommunityPoolSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) CommunityPoolSpendProposal {
+	return CommunityPoolSpendProposal{title, description, recipient, amount}
+}
+
+// nolint
+func (csp CommunityPoolSpendProposal) GetTitle() string       { return csp.Title }
+func (csp CommunityPoolSpendProposal) GetDescription() string { return csp.Description }
+func (csp CommunityPoolSpendProposal) ProposalRoute() string  { return RouterKey }
+func (csp CommunityPoolSpendProposal) ProposalType() string   { return ProposalTypeCommunityPoolSpend }
+
+// ValidateBasic validates the title and description of the proposal along with
+// the recipient and the amount. Whether the community pool can pay the amount
+// is checked by the handler, at submission and again once the proposal passes.
+func (csp CommunityPoolSpendProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(DefaultCodespace, csp); err != nil {
+		return err
+	}
+	if csp.Recipient.Empty() {
+		return sdk.ErrInvalidAddress(csp.Recipient.String())
+	}
+	if !csp.Amount.IsValid() || csp.Amount.Empty() {
+		return sdk.ErrInvalidCoins(csp.Amount.String())
+	}
+
+	return nil
+}
+
+func (csp CommunityPoolSpendProposal) String() string {
+	return fmt.Sprintf(`Community Pool Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// test ValidateBasic for CommunityPoolSpendProposal
+func TestCommunityPoolSpendProposal(t *testing.T) {
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		title      string
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{"Test", delAddr1, coins, true},
+		{"", delAddr1, coins, false},
+		{"Test", emptyDelAddr, coins, false},
+		{"Test", delAddr1, sdk.Coins{}, false},
+		{"Test", delAddr1, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, false},
+	}
+
+	for i, tc := range tests {
+		proposal := NewCommunityPoolSpendProposal(tc.title, "description", tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, proposal.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, proposal.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
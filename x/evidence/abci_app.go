@@ -0,0 +1,28 @@
+package evidence
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker submits the evidence of misbehaviour Tendermint discovered.
+// Evidence that can't be handled is logged and ignored.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	logger := ctx.Logger().With("module", "x/evidence")
+
+	for _, tmEvidence := range req.ByzantineValidators {
+		switch tmEvidence.Type {
+		case tmtypes.ABCIEvidenceTypeDuplicateVote:
+			evidence := NewEquivocation(tmEvidence.Height, tmEvidence.Time, tmEvidence.Validator.Power, sdk.ConsAddress(tmEvidence.Validator.Address))
+			if err := k.SubmitEvidence(ctx, evidence); err != nil {
+				logger.Error(fmt.Sprintf("ignored equivocation evidence %s: %s", evidence.Hash(), err.Error()))
+			}
+		default:
+			logger.Error(fmt.Sprintf("ignored unknown evidence type: %s", tmEvidence.Type))
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/evidence"
+)
+
+// GetCmdQueryEvidence implements the query evidence command.
+func GetCmdQueryEvidence(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [hash]",
+		Short: "Query handled evidence by its hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid evidence hash %s: %s", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(evidence.NewQueryEvidenceParams(hash))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, evidence.QueryEvidence), bz)
+			if err != nil {
+				return err
+			}
+
+			var ev evidence.Evidence
+			cdc.MustUnmarshalJSON(res, &ev)
+			return cliCtx.PrintOutput(ev)
+		},
+	}
+}
+
+// GetCmdQueryAllEvidence implements the query all evidence command.
+func GetCmdQueryAllEvidence(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "all",
+		Short: "Query all the handled evidence",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, evidence.QueryAllEvidence), nil)
+			if err != nil {
+				return err
+			}
+
+			var ev evidence.EvidenceList
+			cdc.MustUnmarshalJSON(res, &ev)
+			return cliCtx.PrintOutput(ev)
+		},
+	}
+}
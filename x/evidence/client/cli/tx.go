@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/evidence"
+)
+
+// GetCmdSubmitEvidence implements the submit evidence command.
+func GetCmdSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [evidence-file]",
+		Short: "Submit evidence of misbehaviour",
+		Long: strings.TrimSpace(`
+Submit evidence of misbehaviour, read from a JSON file with its amino type:
+
+$ gaiacli tx evidence submit evidence.json --from mykey
+
+Where evidence.json contains, for instance:
+
+{
+  "type": "oracle/FaultyPrice",
+  "value": {
+    ...
+  }
+}
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var ev evidence.Evidence
+			if err := cdc.UnmarshalJSON(bz, &ev); err != nil {
+				return err
+			}
+
+			msg := evidence.NewMsgSubmitEvidence(ev, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return client.PostCommands(cmd)[0]
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/x/evidence"
+	"my-cosmos/cosmos-sdk/x/evidence/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	evidenceQueryCmd := &cobra.Command{
+		Use:   evidence.ModuleName,
+		Short: "Querying commands for the evidence module",
+	}
+
+	evidenceQueryCmd.AddCommand(
+		client.GetCommands(
+			cli.GetCmdQueryEvidence(mc.storeKey, mc.cdc),
+			cli.GetCmdQueryAllEvidence(mc.storeKey, mc.cdc),
+		)...,
+	)
+
+	return evidenceQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	evidenceTxCmd := &cobra.Command{
+		Use:   evidence.ModuleName,
+		Short: "Evidence transactions subcommands",
+	}
+
+	evidenceTxCmd.AddCommand(
+		cli.GetCmdSubmitEvidence(mc.cdc),
+	)
+
+	return evidenceTxCmd
+}
@@ -0,0 +1,30 @@
+package evidence
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+)
+
+// msgCdc is the codec used by x/evidence to encode messages. Evidence types
+// defined in other modules are registered on it through
+// RegisterEvidenceTypeCodec, so that MsgSubmitEvidence can be encoded.
+var msgCdc = codec.New()
+
+// RegisterCodec registers the Evidence interface, the evidence defined by
+// x/evidence and its messages on the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Evidence)(nil), nil)
+	cdc.RegisterConcrete(Equivocation{}, "cosmos-sdk/Equivocation", nil)
+
+	cdc.RegisterConcrete(MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence", nil)
+}
+
+// RegisterEvidenceTypeCodec registers an external evidence type defined in
+// another module on the evidence codec. This allows the MsgSubmitEvidence to
+// be correctly Amino encoded and decoded.
+func RegisterEvidenceTypeCodec(o interface{}, name string) {
+	msgCdc.RegisterConcrete(o, name, nil)
+}
+
+func init() {
+	RegisterCodec(msgCdc)
+}
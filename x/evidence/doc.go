@@ -0,0 +1,18 @@
+/*
+Package evidence handles the submission of evidence of misbehaviour.
+
+Modules define their own Evidence types and register a Handler for them on the
+evidence Router, which is sealed when the keeper is created:
+
+	evidenceRouter := evidence.NewRouter().
+		AddRoute(evidence.RouteEquivocation, slashing.NewEquivocationHandler(slashingKeeper))
+
+Anyone can submit evidence with a MsgSubmitEvidence. The keeper runs the
+handler routed to by the evidence and, when it succeeds, stores the evidence
+under its hash, so the same evidence can't be handled twice.
+
+The double-sign evidence Tendermint reports at the beginning of every block is
+submitted as Equivocation evidence by the BeginBlocker. Equivocation can only
+come from Tendermint, it is rejected in a MsgSubmitEvidence.
+*/
+package evidence
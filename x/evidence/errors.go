@@ -0,0 +1,45 @@
+package evidence
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Evidence errors reserve 100 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidEvidence         sdk.CodeType = 101
+	CodeNoEvidenceHandlerExists sdk.CodeType = 102
+	CodeEvidenceExists          sdk.CodeType = 103
+	CodeEvidenceNotFound        sdk.CodeType = 104
+	CodeEvidenceNotSubmittable  sdk.CodeType = 105
+)
+
+// ErrInvalidEvidence is an error
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, msg)
+}
+
+// ErrNoEvidenceHandlerExists is an error
+func ErrNoEvidenceHandlerExists(codespace sdk.CodespaceType, evidence Evidence) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandlerExists, fmt.Sprintf("'%T' does not have a corresponding handler", evidence))
+}
+
+// ErrEvidenceExists is an error
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence %s already exists", hash))
+}
+
+// ErrEvidenceNotFound is an error
+func ErrEvidenceNotFound(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceNotFound, fmt.Sprintf("evidence %s not found", hash))
+}
+
+// ErrEvidenceNotSubmittable is an error
+func ErrEvidenceNotSubmittable(codespace sdk.CodespaceType, evidence Evidence) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceNotSubmittable, fmt.Sprintf("'%T' can not be submitted in a message", evidence))
+}
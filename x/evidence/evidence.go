@@ -0,0 +1,110 @@
+package evidence
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Evidence defines the interface evidence of misbehaviour must implement. It
+// carries the routing information for the Handler that punishes the
+// misbehaviour. Evidence types must be registered on the codec.
+type Evidence interface {
+	Route() string
+	Type() string
+	String() string
+	Hash() cmn.HexBytes
+	ValidateBasic() sdk.Error
+
+	// GetHeight returns the height at which the misbehaviour happened
+	GetHeight() int64
+}
+
+// EvidenceList is a list of evidence
+type EvidenceList []Evidence
+
+func (el EvidenceList) String() string {
+	out := make([]string, len(el))
+	for i, e := range el {
+		out[i] = e.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Handler defines a function that handles evidence of misbehaviour, usually by
+// punishing the misbehaving party. All state changes are made on a cached
+// context, which is discarded when an error is returned.
+type Handler func(ctx sdk.Context, evidence Evidence) sdk.Error
+
+// Routing information of the equivocation evidence
+const (
+	RouteEquivocation = "equivocation"
+	TypeEquivocation  = "equivocation"
+)
+
+// Equivocation is the evidence of a validator signing two conflicting blocks
+// at the same height, as reported by Tendermint
+type Equivocation struct {
+	Height           int64           `json:"height"`
+	Time             time.Time       `json:"time"`
+	Power            int64           `json:"power"` // power of the validator at the height of the infraction
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address"`
+}
+
+var _ Evidence = Equivocation{}
+
+// NewEquivocation creates a new Equivocation
+func NewEquivocation(height int64, time time.Time, power int64, consAddr sdk.ConsAddress) Equivocation {
+	return Equivocation{
+		Height:           height,
+		Time:             time,
+		Power:            power,
+		ConsensusAddress: consAddr,
+	}
+}
+
+// Route implements Evidence
+func (e Equivocation) Route() string { return RouteEquivocation }
+
+// Type implements Evidence
+func (e Equivocation) Type() string { return TypeEquivocation }
+
+// Hash implements Evidence
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(msgCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic implements Evidence
+func (e Equivocation) ValidateBasic() sdk.Error {
+	if e.Height < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation height %d", e.Height))
+	}
+	if e.Time.IsZero() {
+		return ErrInvalidEvidence(DefaultCodespace, "missing equivocation time")
+	}
+	if e.Power < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation validator power %d", e.Power))
+	}
+	if e.ConsensusAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing equivocation validator address")
+	}
+	return nil
+}
+
+// GetHeight implements Evidence
+func (e Equivocation) GetHeight() int64 { return e.Height }
+
+func (e Equivocation) String() string {
+	return fmt.Sprintf(`Equivocation:
+  Height:            %d
+  Time:              %s
+  Power:             %d
+  Consensus Address: %s`,
+		e.Height, e.Time, e.Power, e.ConsensusAddress,
+	)
+}
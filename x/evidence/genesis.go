@@ -0,0 +1,56 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the handled evidence at genesis
+type GenesisState struct {
+	Evidence []Evidence `json:"evidence"`
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(evidence []Evidence) GenesisState {
+	return GenesisState{Evidence: evidence}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Evidence{})
+}
+
+// InitGenesis stores the evidence from genesis. It was already handled, so it
+// is not routed to its handler again.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, evidence := range data.Evidence {
+		keeper.SetEvidence(ctx, evidence)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	evidence := keeper.GetAllEvidence(ctx)
+	if evidence == nil {
+		evidence = []Evidence{}
+	}
+	return NewGenesisState(evidence)
+}
+
+// ValidateGenesis checks that all the evidence is well formed and unique
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool)
+	for _, evidence := range data.Evidence {
+		if err := evidence.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid evidence %s: %s", evidence.Hash(), err.Error())
+		}
+
+		hash := evidence.Hash().String()
+		if seen[hash] {
+			return fmt.Errorf("duplicate evidence %s", hash)
+		}
+		seen[hash] = true
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package evidence
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Tag keys
+const (
+	TagKeySubmitter    = "submitter"
+	TagKeyEvidenceHash = "evidence-hash"
+)
+
+// NewHandler returns a handler for "evidence" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, k, msg)
+		default:
+			errMsg := "Unrecognized evidence Msg type: " + msg.Type()
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle MsgSubmitEvidence.
+func handleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg MsgSubmitEvidence) sdk.Result {
+	if err := k.SubmitEvidence(ctx, msg.Evidence); err != nil {
+		return err.Result()
+	}
+
+	hash := msg.Evidence.Hash()
+	return sdk.Result{
+		Data: hash,
+		Tags: sdk.NewTags(
+			TagKeySubmitter, msg.Submitter.String(),
+			TagKeyEvidenceHash, hash.String(),
+		),
+	}
+}
@@ -0,0 +1,116 @@
+package evidence
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "evidence"
+
+	// StoreKey is the store key string for evidence
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for evidence
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for evidence
+	QuerierRoute = ModuleName
+)
+
+// EvidenceKeyPrefix is the prefix for the handled evidence, stored by hash
+var EvidenceKeyPrefix = []byte{0x00}
+
+// EvidenceKey returns the key under which the evidence with the given hash is
+// stored
+func EvidenceKey(hash cmn.HexBytes) []byte {
+	return append(EvidenceKeyPrefix, hash...)
+}
+
+// Keeper of the evidence store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	router   Router // evidence router, routing evidence to their handler
+}
+
+// NewKeeper returns an evidence keeper.
+//
+// The router is sealed, no evidence handler can be added to it afterwards.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, router Router) Keeper {
+	router.Seal()
+
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// SubmitEvidence handles evidence of misbehaviour and stores it. Evidence that
+// was already handled, or that no handler is registered for, is rejected. The
+// evidence is only stored when its handler succeeds.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence Evidence) sdk.Error {
+	if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+		return ErrEvidenceExists(DefaultCodespace, evidence.Hash())
+	}
+	if !k.router.HasRoute(evidence.Route()) {
+		return ErrNoEvidenceHandlerExists(DefaultCodespace, evidence)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	handler := k.router.GetRoute(evidence.Route())
+	if err := handler(cacheCtx, evidence); err != nil {
+		return err
+	}
+	writeCache()
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// SetEvidence stores evidence under its hash, without handling it
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(EvidenceKey(evidence.Hash()), k.cdc.MustMarshalBinaryLengthPrefixed(evidence))
+}
+
+// GetEvidence returns the handled evidence with the given hash
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(EvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &evidence)
+	return evidence, true
+}
+
+// GetAllEvidence returns all the handled evidence
+func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []Evidence) {
+	k.IterateEvidence(ctx, func(e Evidence) bool {
+		evidence = append(evidence, e)
+		return false
+	})
+	return evidence
+}
+
+// IterateEvidence iterates over all the handled evidence until the callback
+// returns true
+func (k Keeper) IterateEvidence(ctx sdk.Context, cb func(evidence Evidence) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, EvidenceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &evidence)
+		if cb(evidence) {
+			break
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package evidence
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/store"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+var (
+	submitter = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	consAddr  = sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+// testEvidence is the evidence of a fault of an account, punished by storing
+// the account under testPunishedKey
+type testEvidence struct {
+	Height  int64          `json:"height"`
+	Address sdk.AccAddress `json:"address"`
+	Invalid bool           `json:"invalid"` // rejected by the handler
+}
+
+var _ Evidence = testEvidence{}
+
+func (e testEvidence) Route() string      { return "test" }
+func (e testEvidence) Type() string       { return "test" }
+func (e testEvidence) String() string     { return fmt.Sprintf("test evidence: %s", e.Address) }
+func (e testEvidence) Hash() cmn.HexBytes { return tmhash.Sum(msgCdc.MustMarshalBinaryBare(e)) }
+func (e testEvidence) GetHeight() int64   { return e.Height }
+
+func (e testEvidence) ValidateBasic() sdk.Error {
+	if e.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	return nil
+}
+
+var testPunishedKey = []byte("punished")
+
+func newTestEvidenceHandler(key sdk.StoreKey) Handler {
+	return func(ctx sdk.Context, evidence Evidence) sdk.Error {
+		e := evidence.(testEvidence)
+		ctx.KVStore(key).Set(testPunishedKey, e.Address)
+		if e.Invalid {
+			return ErrInvalidEvidence(DefaultCodespace, "invalid test evidence")
+		}
+		return nil
+	}
+}
+
+func init() {
+	RegisterEvidenceTypeCodec(testEvidence{}, "test/testEvidence")
+}
+
+func createTestInput(t *testing.T, router Router) (sdk.Context, sdk.StoreKey, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+	keyTest := sdk.NewKVStoreKey("test")
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTest, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	cdc.RegisterConcrete(testEvidence{}, "test/testEvidence", nil)
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10, Time: time.Unix(1000, 0)}, false, log.NewNopLogger())
+	router.AddRoute("test", newTestEvidenceHandler(keyTest))
+	keeper := NewKeeper(cdc, key, router)
+
+	return ctx, keyTest, keeper
+}
+
+func TestSubmitEvidence(t *testing.T) {
+	ctx, keyTest, keeper := createTestInput(t, NewRouter())
+	evidence := testEvidence{Height: 5, Address: submitter}
+
+	require.NoError(t, keeper.SubmitEvidence(ctx, evidence))
+	require.Equal(t, []byte(submitter), ctx.KVStore(keyTest).Get(testPunishedKey))
+
+	stored, found := keeper.GetEvidence(ctx, evidence.Hash())
+	require.True(t, found)
+	require.Equal(t, evidence, stored)
+	require.Equal(t, []Evidence{evidence}, keeper.GetAllEvidence(ctx))
+
+	// the same evidence is only handled once
+	ctx.KVStore(keyTest).Delete(testPunishedKey)
+	err := keeper.SubmitEvidence(ctx, evidence)
+	require.Error(t, err)
+	require.Equal(t, CodeEvidenceExists, err.Code())
+	require.Nil(t, ctx.KVStore(keyTest).Get(testPunishedKey))
+}
+
+func TestSubmitEvidenceRejected(t *testing.T) {
+	ctx, keyTest, keeper := createTestInput(t, NewRouter())
+
+	// the state changes of a failing handler are discarded
+	invalid := testEvidence{Height: 5, Address: submitter, Invalid: true}
+	require.Error(t, keeper.SubmitEvidence(ctx, invalid))
+	require.Nil(t, ctx.KVStore(keyTest).Get(testPunishedKey))
+	_, found := keeper.GetEvidence(ctx, invalid.Hash())
+	require.False(t, found)
+
+	// evidence without a handler
+	equivocation := NewEquivocation(5, time.Unix(900, 0), 10, consAddr)
+	err := keeper.SubmitEvidence(ctx, equivocation)
+	require.Error(t, err)
+	require.Equal(t, CodeNoEvidenceHandlerExists, err.Code())
+}
+
+func TestBeginBlocker(t *testing.T) {
+	var handled []Evidence
+	router := NewRouter().AddRoute(RouteEquivocation, func(ctx sdk.Context, evidence Evidence) sdk.Error {
+		handled = append(handled, evidence)
+		return nil
+	})
+	ctx, _, keeper := createTestInput(t, router)
+
+	tmEvidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: consAddr, Power: 10},
+		Height:    5,
+		Time:      time.Unix(900, 0),
+	}
+	req := abci.RequestBeginBlock{ByzantineValidators: []abci.Evidence{tmEvidence, tmEvidence}}
+	BeginBlocker(ctx, req, keeper)
+
+	equivocation := NewEquivocation(5, time.Unix(900, 0), 10, consAddr)
+	require.Equal(t, []Evidence{equivocation}, handled)
+	_, found := keeper.GetEvidence(ctx, equivocation.Hash())
+	require.True(t, found)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, keyTest, keeper := createTestInput(t, NewRouter())
+	evidence := testEvidence{Height: 5, Address: submitter}
+
+	genesis := NewGenesisState([]Evidence{evidence})
+	require.NoError(t, ValidateGenesis(genesis))
+	InitGenesis(ctx, keeper, genesis)
+
+	// genesis evidence was already handled
+	require.Nil(t, ctx.KVStore(keyTest).Get(testPunishedKey))
+	require.Equal(t, genesis, ExportGenesis(ctx, keeper))
+
+	require.Error(t, ValidateGenesis(NewGenesisState([]Evidence{evidence, evidence})))
+	require.Error(t, ValidateGenesis(NewGenesisState([]Evidence{testEvidence{Height: 5}})))
+}
@@ -0,0 +1,50 @@
+package evidence
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// MsgSubmitEvidence - submits evidence of misbehaviour to be handled by its
+// registered handler. Anyone can submit evidence.
+type MsgSubmitEvidence struct {
+	Evidence  Evidence       `json:"evidence"`
+	Submitter sdk.AccAddress `json:"submitter"`
+}
+
+var _ sdk.Msg = MsgSubmitEvidence{}
+
+// NewMsgSubmitEvidence creates a new MsgSubmitEvidence
+func NewMsgSubmitEvidence(evidence Evidence, submitter sdk.AccAddress) MsgSubmitEvidence {
+	return MsgSubmitEvidence{Evidence: evidence, Submitter: submitter}
+}
+
+// Route Implements Msg.
+func (msg MsgSubmitEvidence) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSubmitEvidence) Type() string { return "submit_evidence" }
+
+// ValidateBasic Implements Msg. Equivocation can only be reported by
+// Tendermint, which has verified the conflicting votes.
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.Submitter.Empty() {
+		return sdk.ErrInvalidAddress("missing submitter address")
+	}
+	if msg.Evidence == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "missing evidence")
+	}
+	if _, ok := msg.Evidence.(Equivocation); ok {
+		return ErrEvidenceNotSubmittable(DefaultCodespace, msg.Evidence)
+	}
+	return msg.Evidence.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
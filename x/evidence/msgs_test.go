@@ -0,0 +1,34 @@
+package evidence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitEvidenceValidateBasic(t *testing.T) {
+	evidence := testEvidence{Height: 5, Address: submitter}
+
+	msg := NewMsgSubmitEvidence(evidence, submitter)
+	require.Nil(t, msg.ValidateBasic())
+	require.Contains(t, string(msg.GetSignBytes()), "test/testEvidence")
+
+	require.NotNil(t, NewMsgSubmitEvidence(evidence, nil).ValidateBasic())
+	require.NotNil(t, NewMsgSubmitEvidence(nil, submitter).ValidateBasic())
+	require.NotNil(t, NewMsgSubmitEvidence(testEvidence{Height: 5}, submitter).ValidateBasic())
+
+	// equivocation is only reported by Tendermint
+	equivocation := NewEquivocation(5, time.Unix(900, 0), 10, consAddr)
+	require.Nil(t, equivocation.ValidateBasic())
+	err := NewMsgSubmitEvidence(equivocation, submitter).ValidateBasic()
+	require.NotNil(t, err)
+	require.Equal(t, CodeEvidenceNotSubmittable, err.Code())
+}
+
+func TestEquivocationValidateBasic(t *testing.T) {
+	require.NotNil(t, NewEquivocation(0, time.Unix(900, 0), 10, consAddr).ValidateBasic())
+	require.NotNil(t, NewEquivocation(5, time.Time{}, 10, consAddr).ValidateBasic())
+	require.NotNil(t, NewEquivocation(5, time.Unix(900, 0), 0, consAddr).ValidateBasic())
+	require.NotNil(t, NewEquivocation(5, time.Unix(900, 0), 10, nil).ValidateBasic())
+}
@@ -0,0 +1,71 @@
+package evidence
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the evidence Querier
+const (
+	QueryEvidence    = "evidence"
+	QueryAllEvidence = "all_evidence"
+)
+
+// QueryEvidenceParams are the params for the evidence by hash query
+type QueryEvidenceParams struct {
+	Hash cmn.HexBytes `json:"hash"`
+}
+
+// NewQueryEvidenceParams creates a new instance of QueryEvidenceParams
+func NewQueryEvidenceParams(hash cmn.HexBytes) QueryEvidenceParams {
+	return QueryEvidenceParams{Hash: hash}
+}
+
+// NewQuerier creates a querier for the evidence module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryEvidence:
+			return queryEvidence(ctx, req, k)
+		case QueryAllEvidence:
+			return queryAllEvidence(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown evidence query endpoint")
+		}
+	}
+}
+
+func queryEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryEvidenceParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	evidence, found := k.GetEvidence(ctx, params.Hash)
+	if !found {
+		return nil, ErrEvidenceNotFound(DefaultCodespace, params.Hash)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryAllEvidence(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	evidence := k.GetAllEvidence(ctx)
+	if evidence == nil {
+		evidence = []Evidence{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
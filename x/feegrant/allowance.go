@@ -0,0 +1,229 @@
+package feegrant
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance is a permission a granter gives a grantee to spend fees on its
+// behalf
+type FeeAllowance interface {
+	// Accept charges the fee to the allowance at the given block time. It
+	// returns the allowance left and whether it is used up and can be removed,
+	// or an error if the fee is not allowed.
+	Accept(fee sdk.Coins, blockTime time.Time) (left FeeAllowance, remove bool, err sdk.Error)
+
+	// ValidateBasic checks the allowance is well formed
+	ValidateBasic() sdk.Error
+}
+
+var (
+	_ FeeAllowance = BasicFeeAllowance{}
+	_ FeeAllowance = PeriodicFeeAllowance{}
+)
+
+//-----------------------------------------------------------------------------
+// Basic Fee Allowance
+
+// BasicFeeAllowance allows a grantee to spend fees up to SpendLimit until
+// Expiration. An empty SpendLimit allows any fees and a zero Expiration never
+// expires.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit"`
+	Expiration int64     `json:"expiration"` // unix time
+}
+
+// NewBasicFeeAllowance returns a new basic fee allowance
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration int64) BasicFeeAllowance {
+	return BasicFeeAllowance{SpendLimit: spendLimit, Expiration: expiration}
+}
+
+// Accept implements FeeAllowance. The allowance is used up once its spend
+// limit is.
+func (a BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (FeeAllowance, bool, sdk.Error) {
+	if a.isExpired(blockTime) {
+		return nil, false, ErrFeeLimitExpired(DefaultCodespace, a.Expiration)
+	}
+	if a.SpendLimit.Empty() {
+		return a, false, nil
+	}
+
+	left, hasNeg := a.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.SpendLimit)
+	}
+	a.SpendLimit = left
+	return a, left.IsZero(), nil
+}
+
+func (a BasicFeeAllowance) isExpired(blockTime time.Time) bool {
+	return a.Expiration != 0 && blockTime.Unix() >= a.Expiration
+}
+
+// ValidateBasic implements FeeAllowance
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid spend limit: %s", a.SpendLimit))
+	}
+	if a.Expiration < 0 {
+		return ErrInvalidPeriod(DefaultCodespace, "expiration cannot be negative")
+	}
+	return nil
+}
+
+func (a BasicFeeAllowance) String() string {
+	return fmt.Sprintf(`Basic Fee Allowance:
+  Spend Limit: %s
+  Expiration:  %d`, a.SpendLimit, a.Expiration)
+}
+
+//-----------------------------------------------------------------------------
+// Periodic Fee Allowance
+
+// PeriodicFeeAllowance extends a basic allowance with a cap on the fees spent
+// in each period of Period seconds. PeriodCanSpend is what is left to spend
+// until PeriodReset, when it is reset to PeriodSpendLimit. A zero PeriodReset
+// starts the first period on first use.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic"`
+	Period           int64             `json:"period"` // seconds
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit"`
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend"`
+	PeriodReset      int64             `json:"period_reset"` // unix time
+}
+
+// NewPeriodicFeeAllowance returns a new periodic fee allowance whose first
+// period starts on first use
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period int64, periodSpendLimit sdk.Coins) PeriodicFeeAllowance {
+	return PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept implements FeeAllowance. The allowance is used up once the spend
+// limit of its basic allowance is.
+func (a PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (FeeAllowance, bool, sdk.Error) {
+	if a.Basic.isExpired(blockTime) {
+		return nil, false, ErrFeeLimitExpired(DefaultCodespace, a.Basic.Expiration)
+	}
+
+	a.tryResetPeriod(blockTime)
+
+	left, hasNeg := a.PeriodCanSpend.SafeSub(fee)
+	if hasNeg {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.PeriodCanSpend)
+	}
+	a.PeriodCanSpend = left
+
+	if a.Basic.SpendLimit.Empty() {
+		return a, false, nil
+	}
+	left, hasNeg = a.Basic.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.Basic.SpendLimit)
+	}
+	a.Basic.SpendLimit = left
+	return a, left.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period if the current one is over. Periods
+// missed entirely are skipped.
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time) {
+	now := blockTime.Unix()
+	if now < a.PeriodReset {
+		return
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	a.PeriodReset += a.Period
+	if a.PeriodReset <= now {
+		a.PeriodReset = now + a.Period
+	}
+}
+
+// ValidateBasic implements FeeAllowance
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+	if a.Period <= 0 {
+		return ErrInvalidPeriod(DefaultCodespace, "period must be positive")
+	}
+	if a.PeriodSpendLimit.Empty() || !a.PeriodSpendLimit.IsValid() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid period spend limit: %s", a.PeriodSpendLimit))
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid period can spend: %s", a.PeriodCanSpend))
+	}
+	if a.PeriodReset < 0 {
+		return ErrInvalidPeriod(DefaultCodespace, "period reset cannot be negative")
+	}
+	return nil
+}
+
+func (a PeriodicFeeAllowance) String() string {
+	return fmt.Sprintf(`Periodic Fee Allowance:
+  Spend Limit:        %s
+  Expiration:         %d
+  Period:             %d
+  Period Spend Limit: %s
+  Period Can Spend:   %s
+  Period Reset:       %d`,
+		a.Basic.SpendLimit, a.Basic.Expiration, a.Period,
+		a.PeriodSpendLimit, a.PeriodCanSpend, a.PeriodReset)
+}
+
+//-----------------------------------------------------------------------------
+// Fee Allowance Grant
+
+// FeeAllowanceGrant is a fee allowance stored with its granter and grantee
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+// NewFeeAllowanceGrant returns a new fee allowance grant
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{Granter: granter, Grantee: grantee, Allowance: allowance}
+}
+
+// ValidateBasic checks the grant is well formed
+func (g FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdk.ErrInvalidAddress("cannot grant a fee allowance to oneself")
+	}
+	if g.Allowance == nil {
+		return sdk.ErrUnknownRequest("missing fee allowance")
+	}
+	return g.Allowance.ValidateBasic()
+}
+
+func (g FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Fee Allowance Grant:
+  Granter:   %s
+  Grantee:   %s
+  Allowance: %v`, g.Granter, g.Grantee, g.Allowance)
+}
+
+// FeeAllowanceGrants is a list of fee allowance grants
+type FeeAllowanceGrants []FeeAllowanceGrant
+
+func (gs FeeAllowanceGrants) String() string {
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,94 @@
+package feegrant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+func TestBasicFeeAllowance(t *testing.T) {
+	limit := sdk.Coins{sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100)}
+	now := time.Unix(1000, 0)
+
+	cases := map[string]struct {
+		allowance BasicFeeAllowance
+		fee       sdk.Coins
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		left      sdk.Coins
+	}{
+		"no limit":        {NewBasicFeeAllowance(nil, 0), sdk.Coins{sdk.NewInt64Coin("stake", 1000)}, now, true, false, nil},
+		"under limit":     {NewBasicFeeAllowance(limit, 0), sdk.Coins{sdk.NewInt64Coin("stake", 40)}, now, true, false, sdk.Coins{sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 60)}},
+		"limit used up":   {NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 40)}, 0), sdk.Coins{sdk.NewInt64Coin("stake", 40)}, now, true, true, sdk.Coins{}},
+		"over limit":      {NewBasicFeeAllowance(limit, 0), sdk.Coins{sdk.NewInt64Coin("stake", 101)}, now, false, false, nil},
+		"unknown denom":   {NewBasicFeeAllowance(limit, 0), sdk.Coins{sdk.NewInt64Coin("photon", 1)}, now, false, false, nil},
+		"before expiry":   {NewBasicFeeAllowance(limit, 1001), sdk.Coins{sdk.NewInt64Coin("atom", 10)}, now, true, false, sdk.Coins{sdk.NewInt64Coin("stake", 100)}},
+		"at expiry":       {NewBasicFeeAllowance(limit, 1000), sdk.Coins{sdk.NewInt64Coin("atom", 1)}, now, false, false, nil},
+		"expired, no cap": {NewBasicFeeAllowance(nil, 500), sdk.Coins{sdk.NewInt64Coin("atom", 1)}, now, false, false, nil},
+	}
+
+	for name, tc := range cases {
+		require.NoError(t, tc.allowance.ValidateBasic(), name)
+
+		left, remove, err := tc.allowance.Accept(tc.fee, tc.blockTime)
+		if !tc.accept {
+			require.Error(t, err, name)
+			continue
+		}
+		require.NoError(t, err, name)
+		require.Equal(t, tc.remove, remove, name)
+		require.True(t, left.(BasicFeeAllowance).SpendLimit.IsEqual(tc.left), name)
+	}
+}
+
+func TestPeriodicFeeAllowance(t *testing.T) {
+	basic := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 25)}, 0)
+	var allowance FeeAllowance = NewPeriodicFeeAllowance(basic, 10, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.NoError(t, allowance.ValidateBasic())
+	fee := sdk.Coins{sdk.NewInt64Coin("stake", 6)}
+
+	// the first period starts on first use
+	allowance, remove, err := allowance.Accept(fee, time.Unix(1000, 0))
+	require.NoError(t, err)
+	require.False(t, remove)
+	periodic := allowance.(PeriodicFeeAllowance)
+	require.Equal(t, int64(1010), periodic.PeriodReset)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 4)}, periodic.PeriodCanSpend)
+
+	// the period cap is spent
+	_, _, err = allowance.Accept(fee, time.Unix(1009, 0))
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+
+	// the next period resets the cap
+	allowance, _, err = allowance.Accept(fee, time.Unix(1010, 0))
+	require.NoError(t, err)
+	require.Equal(t, int64(1020), allowance.(PeriodicFeeAllowance).PeriodReset)
+
+	// missed periods are skipped, and the basic limit still applies
+	allowance, remove, err = allowance.Accept(fee, time.Unix(1055, 0))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, int64(1065), allowance.(PeriodicFeeAllowance).PeriodReset)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 7)}, allowance.(PeriodicFeeAllowance).Basic.SpendLimit)
+
+	_, _, err = allowance.Accept(sdk.Coins{sdk.NewInt64Coin("stake", 8)}, time.Unix(1070, 0))
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+
+	_, remove, err = allowance.Accept(sdk.Coins{sdk.NewInt64Coin("stake", 7)}, time.Unix(1070, 0))
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestPeriodicFeeAllowanceValidateBasic(t *testing.T) {
+	basic := NewBasicFeeAllowance(nil, 0)
+	limit := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	require.NoError(t, NewPeriodicFeeAllowance(basic, 10, limit).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, 0, limit).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, 10, nil).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(NewBasicFeeAllowance(nil, -1), 10, limit).ValidateBasic())
+}
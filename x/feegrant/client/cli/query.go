@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/feegrant"
+)
+
+// GetCmdQueryFeeAllowance implements the query fee allowance command.
+func GetCmdQueryFeeAllowance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [granter] [grantee]",
+		Short: "Query the fee allowance a granter gave a grantee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(feegrant.NewQueryFeeAllowanceParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, feegrant.QueryFeeAllowance), bz)
+			if err != nil {
+				return err
+			}
+
+			var grant feegrant.FeeAllowanceGrant
+			cdc.MustUnmarshalJSON(res, &grant)
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryGrants implements the query grants command.
+func GetCmdQueryGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [granter]",
+		Short: "Query the fee allowances a granter gave",
+		Long: strings.TrimSpace(`
+Query all the fee allowances a granter gave:
+
+$ gaiacli query feegrant grants cosmos1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(feegrant.NewQueryGrantsParams(granter))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, feegrant.QueryGrants), bz)
+			if err != nil {
+				return err
+			}
+
+			var grants feegrant.FeeAllowanceGrants
+			cdc.MustUnmarshalJSON(res, &grants)
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
+
+// DONTCOVER
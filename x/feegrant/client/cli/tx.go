@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/feegrant"
+)
+
+const (
+	flagSpendLimit       = "spend-limit"
+	flagExpiration       = "expiration"
+	flagPeriod           = "period"
+	flagPeriodSpendLimit = "period-spend-limit"
+)
+
+// GetCmdGrantFeeAllowance implements the grant fee allowance command.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant an account an allowance to spend fees on your behalf",
+		Long: strings.TrimSpace(`
+Grant the grantee an allowance to pay the fees of its transactions with your
+coins, replacing any allowance you gave it before. The grantee names you with
+--fee-granter in its transactions. The allowance can be capped in total, expire
+at a unix time, and be capped in each period of a number of seconds:
+
+$ gaiacli tx feegrant grant cosmos1... --spend-limit=100stake --expiration=1600000000 --from mykey
+$ gaiacli tx feegrant grant cosmos1... --period=86400 --period-spend-limit=10stake --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			var allowance feegrant.FeeAllowance
+			basic := feegrant.NewBasicFeeAllowance(spendLimit, viper.GetInt64(flagExpiration))
+			allowance = basic
+
+			if period := viper.GetInt64(flagPeriod); period != 0 {
+				periodSpendLimit, err := sdk.ParseCoins(viper.GetString(flagPeriodSpendLimit))
+				if err != nil {
+					return err
+				}
+				allowance = feegrant.NewPeriodicFeeAllowance(basic, period, periodSpendLimit)
+			} else if viper.GetString(flagPeriodSpendLimit) != "" {
+				return fmt.Errorf("--%s requires --%s", flagPeriodSpendLimit, flagPeriod)
+			}
+
+			msg := feegrant.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "total fees the grantee may spend, unlimited if empty")
+	cmd.Flags().Int64(flagExpiration, 0, "unix time at which the allowance expires, never if zero")
+	cmd.Flags().Int64(flagPeriod, 0, "length in seconds of the periods capped by --period-spend-limit")
+	cmd.Flags().String(flagPeriodSpendLimit, "", "fees the grantee may spend in each period")
+	return client.PostCommands(cmd)[0]
+}
+
+// GetCmdRevokeFeeAllowance implements the revoke fee allowance command.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance you gave an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return client.PostCommands(cmd)[0]
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/x/feegrant"
+	"my-cosmos/cosmos-sdk/x/feegrant/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:   feegrant.ModuleName,
+		Short: "Querying commands for the fee grant module",
+	}
+
+	feegrantQueryCmd.AddCommand(
+		client.GetCommands(
+			cli.GetCmdQueryFeeAllowance(mc.storeKey, mc.cdc),
+			cli.GetCmdQueryGrants(mc.storeKey, mc.cdc),
+		)...,
+	)
+
+	return feegrantQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:   feegrant.ModuleName,
+		Short: "Fee grant transactions subcommands",
+	}
+
+	feegrantTxCmd.AddCommand(
+		cli.GetCmdGrantFeeAllowance(mc.cdc),
+		cli.GetCmdRevokeFeeAllowance(mc.cdc),
+	)
+
+	return feegrantTxCmd
+}
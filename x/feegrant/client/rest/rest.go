@@ -0,0 +1,172 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	clientrest "my-cosmos/cosmos-sdk/client/rest"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/types/rest"
+	"my-cosmos/cosmos-sdk/x/feegrant"
+)
+
+// RegisterRoutes registers fee grant related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/feegrant/grants/{granter}",
+		grantsHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/feegrant/grants/{granter}/{grantee}",
+		feeAllowanceHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/feegrant/grantees/{grantee}/grant",
+		grantFeeAllowanceHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/feegrant/grantees/{grantee}/revoke",
+		revokeFeeAllowanceHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+}
+
+// GrantFeeAllowanceReq defines the properties of a grant fee allowance
+// request's body. The allowance is encoded with its amino type.
+type GrantFeeAllowanceReq struct {
+	BaseReq   rest.BaseReq          `json:"base_req"`
+	Allowance feegrant.FeeAllowance `json:"allowance"`
+}
+
+// RevokeFeeAllowanceReq defines the properties of a revoke fee allowance
+// request's body.
+type RevokeFeeAllowanceReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func grantsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		granter, err := sdk.AccAddressFromBech32(mux.Vars(r)["granter"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(feegrant.NewQueryGrantsParams(granter))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", feegrant.QuerierRoute, feegrant.QueryGrants)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func feeAllowanceHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		granter, err := sdk.AccAddressFromBech32(vars["granter"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(feegrant.NewQueryFeeAllowanceParams(granter, grantee))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", feegrant.QuerierRoute, feegrant.QueryFeeAllowance)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func grantFeeAllowanceHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GrantFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := feegrant.NewMsgGrantFeeAllowance(granter, grantee, req.Allowance)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeFeeAllowanceHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req RevokeFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := feegrant.NewMsgRevokeFeeAllowance(granter, grantee)
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
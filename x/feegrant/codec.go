@@ -0,0 +1,22 @@
+package feegrant
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the fee allowances and messages of the fee grant
+// module on the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*FeeAllowance)(nil), nil)
+	cdc.RegisterConcrete(BasicFeeAllowance{}, "cosmos-sdk/BasicFeeAllowance", nil)
+	cdc.RegisterConcrete(PeriodicFeeAllowance{}, "cosmos-sdk/PeriodicFeeAllowance", nil)
+
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "cosmos-sdk/MsgGrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "cosmos-sdk/MsgRevokeFeeAllowance", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
@@ -0,0 +1,16 @@
+/*
+Package feegrant lets an account pay the transaction fees of another.
+
+A granter gives a grantee a fee allowance with MsgGrantFeeAllowance. The
+grantee then names the granter in the fee of its transactions, and the ante
+handler deducts the fees from the granter's account after charging them to the
+allowance:
+
+	tx.Fee.Granter = granter // fees come out of granter's allowance to the signer
+
+Allowances may cap the total fees, expire at a given time, or cap the fees
+spent in each period. A granter withdraws an allowance with
+MsgRevokeFeeAllowance. Granting an allowance to an address without an account
+creates the account, so that users holding no tokens can sign transactions.
+*/
+package feegrant
@@ -0,0 +1,37 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Fee grant errors reserve 100 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded sdk.CodeType = 101
+	CodeFeeLimitExpired  sdk.CodeType = 102
+	CodeInvalidPeriod    sdk.CodeType = 103
+	CodeNoAllowance      sdk.CodeType = 104
+)
+
+// ErrFeeLimitExceeded is an error
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType, fee, limit sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, fmt.Sprintf("fee %s exceeds the allowance limit %s", fee, limit))
+}
+
+// ErrFeeLimitExpired is an error
+func ErrFeeLimitExpired(codespace sdk.CodespaceType, expiration int64) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExpired, fmt.Sprintf("fee allowance expired at %d", expiration))
+}
+
+// ErrInvalidPeriod is an error
+func ErrInvalidPeriod(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPeriod, msg)
+}
+
+// ErrNoAllowance is an error
+func ErrNoAllowance(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, fmt.Sprintf("%s has no fee allowance from %s", grantee, granter))
+}
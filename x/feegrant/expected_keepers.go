@@ -0,0 +1,13 @@
+package feegrant
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.Account
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) auth.Account
+	SetAccount(ctx sdk.Context, acc auth.Account)
+}
@@ -0,0 +1,49 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the fee allowance grants at genesis
+type GenesisState struct {
+	Grants []FeeAllowanceGrant `json:"grants"`
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(grants []FeeAllowanceGrant) GenesisState {
+	return GenesisState{Grants: grants}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]FeeAllowanceGrant{})
+}
+
+// InitGenesis sets the fee allowance grants from genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, grant := range data.Grants {
+		keeper.GrantFeeAllowance(ctx, grant)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	grants := []FeeAllowanceGrant{}
+	keeper.IterateFeeAllowanceGrants(ctx, func(grant FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return NewGenesisState(grants)
+}
+
+// ValidateGenesis checks that every grant is well formed
+func ValidateGenesis(data GenesisState) error {
+	for _, grant := range data.Grants {
+		if err := grant.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid fee allowance grant from %s to %s: %s", grant.Granter, grant.Grantee, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package feegrant
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Tag keys
+const (
+	TagKeyGranter = "granter"
+	TagKeyGrantee = "grantee"
+)
+
+// NewHandler returns a handler for "feegrant" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, k, msg)
+		case MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, k, msg)
+		default:
+			errMsg := "Unrecognized feegrant Msg type: " + msg.Type()
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle MsgGrantFeeAllowance.
+func handleMsgGrantFeeAllowance(ctx sdk.Context, k Keeper, msg MsgGrantFeeAllowance) sdk.Result {
+	k.GrantFeeAllowance(ctx, msg.Grant())
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeyGranter, msg.Granter.String(),
+			TagKeyGrantee, msg.Grantee.String(),
+		),
+	}
+}
+
+// Handle MsgRevokeFeeAllowance.
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, k Keeper, msg MsgRevokeFeeAllowance) sdk.Result {
+	if err := k.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeyGranter, msg.Granter.String(),
+			TagKeyGrantee, msg.Grantee.String(),
+		),
+	}
+}
@@ -0,0 +1,154 @@
+package feegrant
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for fee grants
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for fee grants
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for fee grants
+	QuerierRoute = ModuleName
+)
+
+// FeeAllowanceKeyPrefix is the prefix for the fee allowances, stored by
+// granter and then grantee
+var FeeAllowanceKeyPrefix = []byte{0x00}
+
+// FeeAllowanceKey returns the key under which the fee allowance of a granter
+// to a grantee is stored
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancesByGranterKey(granter), grantee.Bytes()...)
+}
+
+// FeeAllowancesByGranterKey returns the prefix of the fee allowances of a
+// granter
+func FeeAllowancesByGranterKey(granter sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, granter.Bytes()...)
+}
+
+var _ auth.FeeGrantKeeper = Keeper{}
+
+// Keeper of the fee grant store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	ak       AccountKeeper
+}
+
+// NewKeeper returns a fee grant keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ak AccountKeeper) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+		ak:       ak,
+	}
+}
+
+// GrantFeeAllowance sets the fee allowance of a grant, replacing any previous
+// one between the same accounts. It creates the account of the grantee if it
+// does not exist, so that it can sign the transactions the granter pays for.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant FeeAllowanceGrant) {
+	if k.ak.GetAccount(ctx, grant.Grantee) == nil {
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, grant.Grantee))
+	}
+	k.setFeeAllowanceGrant(ctx, grant)
+}
+
+func (k Keeper) setFeeAllowanceGrant(ctx sdk.Context, grant FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(FeeAllowanceKey(grant.Granter, grant.Grantee), k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+// RevokeFeeAllowance removes the fee allowance of a granter to a grantee
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := FeeAllowanceKey(granter, grantee)
+	if !store.Has(key) {
+		return ErrNoAllowance(DefaultCodespace, granter, grantee)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetFeeAllowance returns the fee allowance of a granter to a grantee, or nil
+// if there is none
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) FeeAllowance {
+	grant, found := k.GetFeeAllowanceGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+	return grant.Allowance
+}
+
+// GetFeeAllowanceGrant returns the grant of a granter to a grantee
+func (k Keeper) GetFeeAllowanceGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(FeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// GetFeeAllowanceGrants returns all the grants of a granter
+func (k Keeper) GetFeeAllowanceGrants(ctx sdk.Context, granter sdk.AccAddress) (grants []FeeAllowanceGrant) {
+	k.iterateFeeAllowanceGrants(ctx, FeeAllowancesByGranterKey(granter), func(grant FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return grants
+}
+
+// IterateFeeAllowanceGrants iterates over all the grants until the callback
+// returns true
+func (k Keeper) IterateFeeAllowanceGrants(ctx sdk.Context, cb func(grant FeeAllowanceGrant) (stop bool)) {
+	k.iterateFeeAllowanceGrants(ctx, FeeAllowanceKeyPrefix, cb)
+}
+
+func (k Keeper) iterateFeeAllowanceGrants(ctx sdk.Context, prefix []byte, cb func(grant FeeAllowanceGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees charges a fee to the allowance of a granter to a grantee,
+// removing the allowance once it is used up. It implements
+// auth.FeeGrantKeeper.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	grant, found := k.GetFeeAllowanceGrant(ctx, granter, grantee)
+	if !found {
+		return ErrNoAllowance(DefaultCodespace, granter, grantee)
+	}
+
+	left, remove, err := grant.Allowance.Accept(fee, ctx.BlockHeader().Time)
+	if err != nil {
+		return err
+	}
+	if remove {
+		return k.RevokeFeeAllowance(ctx, granter, grantee)
+	}
+
+	grant.Allowance = left
+	k.setFeeAllowanceGrant(ctx, grant)
+	return nil
+}
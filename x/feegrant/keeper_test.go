@@ -0,0 +1,120 @@
+package feegrant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/store"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	granter  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func createTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	RegisterCodec(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(1000, 0)}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+
+	return ctx, ak, NewKeeper(cdc, key, ak)
+}
+
+func TestGrantRevokeFeeAllowance(t *testing.T) {
+	ctx, ak, keeper := createTestInput(t)
+	allowance := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+
+	// granting creates the account of the grantee
+	require.Nil(t, ak.GetAccount(ctx, grantee))
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee, allowance))
+	require.NotNil(t, ak.GetAccount(ctx, grantee))
+	require.Equal(t, allowance, keeper.GetFeeAllowance(ctx, granter, grantee))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, grantee, granter))
+
+	// a new grant replaces the previous one
+	periodic := NewPeriodicFeeAllowance(allowance, 10, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee, periodic))
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee2, allowance))
+	require.Equal(t, periodic, keeper.GetFeeAllowance(ctx, granter, grantee))
+	require.Len(t, keeper.GetFeeAllowanceGrants(ctx, granter), 2)
+	require.Empty(t, keeper.GetFeeAllowanceGrants(ctx, grantee))
+
+	require.NoError(t, keeper.RevokeFeeAllowance(ctx, granter, grantee))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, granter, grantee))
+	require.Error(t, keeper.RevokeFeeAllowance(ctx, granter, grantee))
+	require.Len(t, keeper.GetFeeAllowanceGrants(ctx, granter), 1)
+}
+
+func TestUseGrantedFees(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	fee := sdk.Coins{sdk.NewInt64Coin("stake", 40)}
+
+	err := keeper.UseGrantedFees(ctx, granter, grantee, fee)
+	require.Equal(t, CodeNoAllowance, err.Code())
+
+	allowance := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee, allowance))
+
+	require.NoError(t, keeper.UseGrantedFees(ctx, granter, grantee, fee))
+	require.NoError(t, keeper.UseGrantedFees(ctx, granter, grantee, fee))
+	require.Equal(t, NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 20)}, 0),
+		keeper.GetFeeAllowance(ctx, granter, grantee))
+
+	// a failed charge leaves the allowance untouched
+	err = keeper.UseGrantedFees(ctx, granter, grantee, fee)
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+	require.Equal(t, NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 20)}, 0),
+		keeper.GetFeeAllowance(ctx, granter, grantee))
+
+	// the allowance is removed once used up
+	require.NoError(t, keeper.UseGrantedFees(ctx, granter, grantee, sdk.Coins{sdk.NewInt64Coin("stake", 20)}))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, granter, grantee))
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	basic := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 2000)
+	periodic := NewPeriodicFeeAllowance(basic, 10, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee, basic))
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee2, periodic))
+
+	genesis := ExportGenesis(ctx, keeper)
+	require.Len(t, genesis.Grants, 2)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	ctx2, _, keeper2 := createTestInput(t)
+	InitGenesis(ctx2, keeper2, genesis)
+	require.Equal(t, genesis, ExportGenesis(ctx2, keeper2))
+
+	genesis.Grants[0].Grantee = genesis.Grants[0].Granter
+	require.Error(t, ValidateGenesis(genesis))
+}
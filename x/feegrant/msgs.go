@@ -0,0 +1,86 @@
+package feegrant
+
+import (
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// MsgGrantFeeAllowance - gives a grantee an allowance to spend fees on behalf
+// of the granter, replacing any previous one
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+var _ sdk.Msg = MsgGrantFeeAllowance{}
+
+// NewMsgGrantFeeAllowance creates a new MsgGrantFeeAllowance
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{Granter: granter, Grantee: grantee, Allowance: allowance}
+}
+
+// Route Implements Msg.
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgGrantFeeAllowance) Type() string { return "grant_fee_allowance" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	return msg.Grant().ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// Grant returns the fee allowance grant of the message
+func (msg MsgGrantFeeAllowance) Grant() FeeAllowanceGrant {
+	return NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance)
+}
+
+// MsgRevokeFeeAllowance - removes the fee allowance of a granter to a grantee
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+var _ sdk.Msg = MsgRevokeFeeAllowance{}
+
+// NewMsgRevokeFeeAllowance creates a new MsgRevokeFeeAllowance
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevokeFeeAllowance) Type() string { return "revoke_fee_allowance" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
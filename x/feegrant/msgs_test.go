@@ -0,0 +1,45 @@
+package feegrant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+func TestMsgGrantFeeAllowanceValidateBasic(t *testing.T) {
+	allowance := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, 0)
+
+	cases := []struct {
+		msg   MsgGrantFeeAllowance
+		valid bool
+	}{
+		{NewMsgGrantFeeAllowance(granter, grantee, allowance), true},
+		{NewMsgGrantFeeAllowance(nil, grantee, allowance), false},
+		{NewMsgGrantFeeAllowance(granter, nil, allowance), false},
+		{NewMsgGrantFeeAllowance(granter, granter, allowance), false},
+		{NewMsgGrantFeeAllowance(granter, grantee, nil), false},
+		{NewMsgGrantFeeAllowance(granter, grantee, NewBasicFeeAllowance(nil, -1)), false},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgGrantFeeAllowance(granter, grantee, allowance)
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+func TestMsgRevokeFeeAllowanceValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgRevokeFeeAllowance(granter, grantee).ValidateBasic())
+	require.NotNil(t, NewMsgRevokeFeeAllowance(nil, grantee).ValidateBasic())
+	require.NotNil(t, NewMsgRevokeFeeAllowance(granter, nil).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{granter}, NewMsgRevokeFeeAllowance(granter, grantee).GetSigners())
+}
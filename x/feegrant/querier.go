@@ -0,0 +1,88 @@
+package feegrant
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the fee grant Querier
+const (
+	QueryFeeAllowance = "allowance"
+	QueryGrants       = "grants"
+)
+
+// QueryFeeAllowanceParams are the params for the fee allowance of a granter to
+// a grantee query
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams creates a new instance of QueryFeeAllowanceParams
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{Granter: granter, Grantee: grantee}
+}
+
+// QueryGrantsParams are the params for the grants of a granter query
+type QueryGrantsParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+}
+
+// NewQueryGrantsParams creates a new instance of QueryGrantsParams
+func NewQueryGrantsParams(granter sdk.AccAddress) QueryGrantsParams {
+	return QueryGrantsParams{Granter: granter}
+}
+
+// NewQuerier creates a querier for the fee grant module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryFeeAllowance:
+			return queryFeeAllowance(ctx, req, k)
+		case QueryGrants:
+			return queryGrants(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown feegrant query endpoint")
+		}
+	}
+}
+
+func queryFeeAllowance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryFeeAllowanceParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grant, found := k.GetFeeAllowanceGrant(ctx, params.Granter, params.Grantee)
+	if !found {
+		return nil, ErrNoAllowance(DefaultCodespace, params.Granter, params.Grantee)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grant)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryGrants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryGrantsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grants := k.GetFeeAllowanceGrants(ctx, params.Granter)
+	if grants == nil {
+		grants = []FeeAllowanceGrant{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
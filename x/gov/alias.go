@@ -0,0 +1,29 @@
+// nolint
+package gov
+
+import (
+	"my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+type (
+	Content      = types.Content
+	Handler      = types.Handler
+	Router       = types.Router
+	TextProposal = types.TextProposal
+)
+
+const (
+	MaxDescriptionLength = types.MaxDescriptionLength
+	MaxTitleLength       = types.MaxTitleLength
+	ProposalTypeText     = types.ProposalTypeText
+)
+
+var (
+	NewRouter                 = types.NewRouter
+	NewTextProposal           = types.NewTextProposal
+	ValidateAbstract          = types.ValidateAbstract
+	RegisterProposalType      = types.RegisterProposalType
+	RegisterProposalTypeCodec = types.RegisterProposalTypeCodec
+	IsValidProposalType       = types.IsValidProposalType
+	ContentFromProposalType   = types.ContentFromProposalType
+)
@@ -67,6 +67,9 @@ where proposal.json contains:
 is equivalent to
 
 $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Proposals of other modules, e.g. parameter changes, are submitted through the
+subcommands of submit-proposal.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			proposal, err := parseSubmitProposalFlags()
@@ -99,12 +102,12 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 				return fmt.Errorf("address %s doesn't have enough coins to pay for this transaction", from)
 			}
 
-			proposalType, err := gov.ProposalTypeFromString(proposal.Type)
-			if err != nil {
-				return err
+			content := gov.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			if content == nil {
+				return gov.ErrInvalidProposalType(gov.DefaultCodespace, proposal.Type)
 			}
 
-			msg := gov.NewMsgSubmitProposal(proposal.Title, proposal.Description, proposalType, from, amount)
+			msg := gov.NewMsgSubmitProposal(content, from, amount)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -116,7 +119,7 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 
 	cmd.Flags().String(flagTitle, "", "title of proposal")
 	cmd.Flags().String(flagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(flagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
@@ -13,10 +13,13 @@ import (
 type ModuleClient struct {
 	storeKey string
 	cdc      *amino.Codec
+	pcmds    []*cobra.Command
 }
 
-func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
-	return ModuleClient{storeKey, cdc}
+// NewModuleClient returns the gov module client. The given commands are added
+// to submit-proposal to submit the proposal contents of other modules.
+func NewModuleClient(storeKey string, cdc *amino.Codec, pcmds ...*cobra.Command) ModuleClient {
+	return ModuleClient{storeKey, cdc, pcmds}
 }
 
 // GetQueryCmd returns the cli query commands for this module
@@ -49,10 +52,13 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 		Short: "Governance transactions subcommands",
 	}
 
+	submitProposalCmd := govCli.GetCmdSubmitProposal(mc.cdc)
+	submitProposalCmd.AddCommand(client.PostCommands(mc.pcmds...)...)
+
 	govTxCmd.AddCommand(client.PostCommands(
 		govCli.GetCmdDeposit(mc.storeKey, mc.cdc),
 		govCli.GetCmdVote(mc.storeKey, mc.cdc),
-		govCli.GetCmdSubmitProposal(mc.cdc),
+		submitProposalCmd,
 	)...)
 
 	return govTxCmd
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+// RESTHandlerFn creates the REST handler submitting the proposals of a module
+type RESTHandlerFn func(context.CLIContext, *codec.Codec) rest.ProposalRESTHandler
+
+// CLIHandlerFn creates the submit-proposal subcommand of a module
+type CLIHandlerFn func(*codec.Codec) *cobra.Command
+
+// ProposalHandler groups the CLI and REST handlers submitting the proposal
+// contents of a module
+type ProposalHandler struct {
+	CLIHandler  CLIHandlerFn
+	RESTHandler RESTHandlerFn
+}
+
+// NewProposalHandler creates a new ProposalHandler
+func NewProposalHandler(cliHandler CLIHandlerFn, restHandler RESTHandlerFn) ProposalHandler {
+	return ProposalHandler{
+		CLIHandler:  cliHandler,
+		RESTHandler: restHandler,
+	}
+}
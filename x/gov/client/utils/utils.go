@@ -20,10 +20,6 @@ func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
 	case "Text", "text":
 		return "Text"
-	case "ParameterChange", "parameter_change":
-		return "ParameterChange"
-	case "SoftwareUpgrade", "software_upgrade":
-		return "SoftwareUpgrade"
 	}
 	return ""
 }
@@ -39,6 +35,8 @@ func NormalizeProposalStatus(status string) string {
 		return "Passed"
 	case "Rejected", "rejected":
 		return "Rejected"
+	case "Failed", "failed":
+		return "Failed"
 	}
 	return ""
 }
@@ -2,9 +2,12 @@ package gov
 
 import (
 	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/gov/types"
 )
 
-var msgCdc = codec.New()
+// msgCdc is shared with the types package, on which other modules register
+// their proposal contents, so that MsgSubmitProposal can be encoded
+var msgCdc = types.ModuleCdc
 
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
@@ -12,8 +15,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
 
-	cdc.RegisterInterface((*Proposal)(nil), nil)
-	cdc.RegisterConcrete(&TextProposal{}, "gov/TextProposal", nil)
+	types.RegisterCodec(cdc)
 }
 
 func init() {
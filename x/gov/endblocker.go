@@ -27,7 +27,10 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 		var proposalID uint64
 
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(inactiveIterator.Value(), &proposalID)
-		inactiveProposal := keeper.GetProposal(ctx, proposalID)
+		inactiveProposal, ok := keeper.GetProposal(ctx, proposalID)
+		if !ok {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
 
 		// 清除吊已过期的非激活提案
 		keeper.DeleteProposal(ctx, proposalID)
@@ -40,10 +43,10 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
-				inactiveProposal.GetProposalID(),
+				inactiveProposal.ProposalID,
 				inactiveProposal.GetTitle(),
 				keeper.GetDepositParams(ctx).MinDeposit,
-				inactiveProposal.GetTotalDeposit(),
+				inactiveProposal.TotalDeposit,
 			),
 		)
 	}
@@ -56,8 +59,11 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 		var proposalID uint64
 
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(activeIterator.Value(), &proposalID)
-		// 跟填好获取出 激活的提案信息 (这里是Proposal接口的实现，目前是唯一的实现 TextProposal)
-		activeProposal := keeper.GetProposal(ctx, proposalID)
+		// 根据提案ID获取出 激活的提案信息
+		activeProposal, ok := keeper.GetProposal(ctx, proposalID)
+		if !ok {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
 
 
 		// TODO 核心方法， 计算治理提案的投票
@@ -67,32 +73,44 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 		var tagValue string
 
-		// 如果 通过了 提议，则 退还并删除特定提案上的所有存款
+		// 如果 通过了 提议，则 退还并删除特定提案上的所有存款，并执行提案
 		if passes {
-			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
-			activeProposal.SetStatus(StatusPassed)
-			tagValue = tags.ActionProposalPassed
+			keeper.RefundDeposits(ctx, activeProposal.ProposalID)
+
+			// 执行失败时不会修改任何状态，提案被标记为失败
+			if err := keeper.executeProposal(ctx, activeProposal); err != nil {
+				activeProposal.Status = StatusFailed
+				tagValue = tags.ActionProposalFailed
+				logger.Info(
+					fmt.Sprintf("proposal %d (%s) passed but failed on execution: %s",
+						activeProposal.ProposalID, activeProposal.GetTitle(), err.Error(),
+					),
+				)
+			} else {
+				activeProposal.Status = StatusPassed
+				tagValue = tags.ActionProposalPassed
+			}
 		} else {
 
 			// 否则， 删除特定提案上的所有存款而不退款
 			// TODO NOTE: 之所以这么做是为了人人们不要 随意的发起 提议
-			keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
-			activeProposal.SetStatus(StatusRejected)
+			keeper.DeleteDeposits(ctx, activeProposal.ProposalID)
+			activeProposal.Status = StatusRejected
 			tagValue = tags.ActionProposalRejected
 		}
 
 		// 设置最终的结果
-		activeProposal.SetFinalTallyResult(tallyResults)
+		activeProposal.FinalTallyResult = tallyResults
 		// 设置通过的提案信息
 		keeper.SetProposal(ctx, activeProposal)
 
 		// 从 激活队列中 移除
-		keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.GetVotingEndTime(), activeProposal.GetProposalID())
+		keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.VotingEndTime, activeProposal.ProposalID)
 
 		logger.Info(
 			fmt.Sprintf(
 				"proposal %d (%s) tallied; passed: %v",
-				activeProposal.GetProposalID(), activeProposal.GetTitle(), passes,
+				activeProposal.ProposalID, activeProposal.GetTitle(), passes,
 			),
 		)
 
@@ -9,6 +9,11 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+	distr "my-cosmos/cosmos-sdk/x/distribution"
+	"my-cosmos/cosmos-sdk/x/params"
+	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/upgrade"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
@@ -22,7 +27,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg := NewMsgSubmitProposal(TextProposal{"Test", "test"}, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -65,7 +70,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg := NewMsgSubmitProposal(TextProposal{"Test", "test"}, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -82,7 +87,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
-	newProposalMsg2 := NewMsgSubmitProposal("Test2", "test2", ProposalTypeText, addrs[1], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg2 := NewMsgSubmitProposal(TextProposal{"Test2", "test2"}, addrs[1], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 	res = govHandler(ctx, newProposalMsg2)
 	require.True(t, res.IsOK())
 
@@ -125,7 +130,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	require.False(t, activeQueue.Valid())
 	activeQueue.Close()
 
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg := NewMsgSubmitProposal(TextProposal{"Test", "test"}, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -169,7 +174,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(5))}
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], proposalCoins)
+	newProposalMsg := NewMsgSubmitProposal(TextProposal{"Test", "test"}, addrs[0], proposalCoins)
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -196,7 +201,9 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.True(t, activeQueue.Valid())
 	var activeProposalID uint64
 	keeper.cdc.UnmarshalBinaryLengthPrefixed(activeQueue.Value(), &activeProposalID)
-	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, activeProposalID).GetStatus())
+	proposal, ok := keeper.GetProposal(ctx, activeProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 	require.True(t, depositsIterator.Valid())
 	depositsIterator.Close()
@@ -208,3 +215,227 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.False(t, activeQueue.Valid())
 	activeQueue.Close()
 }
+
+func TestTickPassedParameterChangeProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs[:2]))
+	for i, addr := range addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	// changes to unknown subspaces are rejected on submission
+	_, err := keeper.SubmitProposal(ctx, params.NewParameterChangeProposal("Test", "description",
+		params.ParamChanges{params.NewParamChange("unknown", "votingparams", `{"voting_period":"1"}`)}))
+	require.Error(t, err)
+
+	changes := params.ParamChanges{
+		params.NewParamChange("testgov", string(ParamStoreKeyVotingParams), `{"voting_period":"3600000000000"}`),
+	}
+	proposal, err := keeper.SubmitProposal(ctx, params.NewParameterChangeProposal("Test", "description", changes))
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	keeper.activateVotingPeriod(ctx, proposal)
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, keeper)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Equal(t, time.Hour, keeper.GetVotingParams(ctx).VotingPeriod)
+}
+
+func TestTickFailedParameterChangeProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs[:2]))
+	for i, addr := range addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	proposal, err := keeper.SubmitProposal(ctx, params.NewParameterChangeProposal("Test", "description", params.ParamChanges{
+		params.NewParamChange("testgov", string(ParamStoreKeyVotingParams), `{"voting_period":"3600000000000"}`),
+	}))
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	// the second change can no longer be applied, so neither change should be
+	pcp := proposal.Content.(params.ParameterChangeProposal)
+	pcp.Changes = append(pcp.Changes, params.NewParamChange("testgov", string(ParamStoreKeyTallyParams), `"invalid"`))
+	proposal.Content = pcp
+	keeper.SetProposal(ctx, proposal)
+	keeper.activateVotingPeriod(ctx, proposal)
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+
+	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, keeper)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, proposal.Status)
+	require.Equal(t, votingPeriod, keeper.GetVotingParams(ctx).VotingPeriod)
+}
+
+func TestTickPassedSoftwareUpgradeProposal(t *testing.T) {
+	mapp, keeper, sk, uk, _, addrs, _, _ := getMockAppWithKeepers(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs[:2]))
+	for i, addr := range addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	// plans which cannot be scheduled are rejected on submission
+	_, err := keeper.SubmitProposal(ctx, upgrade.NewSoftwareUpgradeProposal("Test", "description", upgrade.Plan{Name: "v2", Height: 1}))
+	require.Error(t, err)
+
+	plan := upgrade.Plan{Name: "v2", Height: 100}
+	proposal, err := keeper.SubmitProposal(ctx, upgrade.NewSoftwareUpgradeProposal("Test", "description", plan))
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	keeper.activateVotingPeriod(ctx, proposal)
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, keeper)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	scheduled, found := uk.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, scheduled)
+}
+
+func TestTickFailedSoftwareUpgradeProposal(t *testing.T) {
+	mapp, keeper, sk, uk, _, addrs, _, _ := getMockAppWithKeepers(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs[:2]))
+	for i, addr := range addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	proposal, err := keeper.SubmitProposal(ctx, upgrade.NewSoftwareUpgradeProposal("Test", "description", upgrade.Plan{Name: "v2", Height: 10}))
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	keeper.activateVotingPeriod(ctx, proposal)
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+
+	// the upgrade height is reached before the voting period ends, so the
+	// plan can no longer be scheduled
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithBlockHeight(20)
+
+	EndBlocker(ctx, keeper)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, proposal.Status)
+	_, found := uk.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestTickCommunityPoolSpendProposal(t *testing.T) {
+	mapp, keeper, sk, _, dk, addrs, _, _ := getMockAppWithKeepers(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs[:2]))
+	for i, addr := range addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	feePool := dk.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)})
+	dk.SetFeePool(ctx, feePool)
+
+	// the distribution module account holds the coins of the community pool
+	distrAcc := auth.NewEmptyModuleAccount(distr.ModuleName)
+	require.NoError(t, distrAcc.SetCoins(sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)}))
+	mapp.AccountKeeper.SetAccount(ctx, mapp.AccountKeeper.NewAccount(ctx, distrAcc))
+
+	recipient := addrs[9]
+	initCoins := keeper.ck.GetCoins(ctx, recipient)
+	amount := sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)}
+
+	// spending more than the pool holds is rejected on submission
+	_, err := keeper.SubmitProposal(ctx, distr.NewCommunityPoolSpendProposal("Test", "description", recipient,
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 101)}))
+	require.Error(t, err)
+
+	// both proposals pass, but the pool can only fund the first one
+	var proposalIDs []uint64
+	for i := 0; i < 2; i++ {
+		proposal, err := keeper.SubmitProposal(ctx, distr.NewCommunityPoolSpendProposal("Test", "description", recipient, amount))
+		require.NoError(t, err)
+		proposalIDs = append(proposalIDs, proposal.ProposalID)
+		keeper.activateVotingPeriod(ctx, proposal)
+
+		require.Nil(t, keeper.AddVote(ctx, proposal.ProposalID, addrs[0], OptionYes))
+		require.Nil(t, keeper.AddVote(ctx, proposal.ProposalID, addrs[1], OptionYes))
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, keeper)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalIDs[0])
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	proposal, ok = keeper.GetProposal(ctx, proposalIDs[1])
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, proposal.Status)
+	require.Equal(t, initCoins.Add(amount), keeper.ck.GetCoins(ctx, recipient))
+	require.Equal(t, sdk.NewDecCoins(sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)}),
+		dk.GetFeePool(ctx).CommunityPool)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
@@ -15,12 +16,14 @@ const (
 	CodeAlreadyActiveProposal   sdk.CodeType = 3
 	CodeAlreadyFinishedProposal sdk.CodeType = 4
 	CodeAddressNotStaked        sdk.CodeType = 5
-	CodeInvalidTitle            sdk.CodeType = 6
-	CodeInvalidDescription      sdk.CodeType = 7
-	CodeInvalidProposalType     sdk.CodeType = 8
+	CodeInvalidTitle            sdk.CodeType = types.CodeInvalidTitle
+	CodeInvalidDescription      sdk.CodeType = types.CodeInvalidDescription
+	CodeInvalidProposalType     sdk.CodeType = types.CodeInvalidProposalType
 	CodeInvalidVote             sdk.CodeType = 9
 	CodeInvalidGenesis          sdk.CodeType = 10
 	CodeInvalidProposalStatus   sdk.CodeType = 11
+	CodeInvalidProposalContent  sdk.CodeType = types.CodeInvalidProposalContent
+	CodeNoProposalHandlerExists sdk.CodeType = 13
 )
 
 // Error constructors
@@ -45,16 +48,15 @@ func ErrAddressNotStaked(codespace sdk.CodespaceType, address sdk.AccAddress) sd
 	return sdk.NewError(codespace, CodeAddressNotStaked, fmt.Sprintf("Address %s is not staked and is thus ineligible to vote", address))
 }
 
-func ErrInvalidTitle(codespace sdk.CodespaceType, errorMsg string) sdk.Error {
-	return sdk.NewError(codespace, CodeInvalidTitle, errorMsg)
-}
-
-func ErrInvalidDescription(codespace sdk.CodespaceType, errorMsg string) sdk.Error {
-	return sdk.NewError(codespace, CodeInvalidDescription, errorMsg)
-}
+var (
+	ErrInvalidTitle           = types.ErrInvalidTitle
+	ErrInvalidDescription     = types.ErrInvalidDescription
+	ErrInvalidProposalType    = types.ErrInvalidProposalType
+	ErrInvalidProposalContent = types.ErrInvalidProposalContent
+)
 
-func ErrInvalidProposalType(codespace sdk.CodespaceType, proposalType ProposalKind) sdk.Error {
-	return sdk.NewError(codespace, CodeInvalidProposalType, fmt.Sprintf("Proposal Type '%s' is not valid", proposalType))
+func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content Content) sdk.Error {
+	return sdk.NewError(codespace, CodeNoProposalHandlerExists, fmt.Sprintf("no handler exists for proposal type %s", content.ProposalType()))
 }
 
 func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Error {
@@ -31,8 +31,11 @@ func TestEqualProposals(t *testing.T) {
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
 	// Create two proposals
-	proposal1 := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	proposal2 := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	tp := NewTextProposal("Test", "description")
+	proposal1, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposal2, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
 	require.NotEqual(t, proposal1, proposal2)
@@ -45,12 +48,14 @@ func TestEqualProposals(t *testing.T) {
 	require.False(t, state1.Equal(state2))
 
 	// Now make proposals identical by setting both IDs to 55
-	proposal1.SetProposalID(55)
-	proposal2.SetProposalID(55)
+	proposal1.ProposalID = 55
+	proposal2.ProposalID = 55
 	require.Equal(t, proposal1, proposal1)
 	require.True(t, ProposalEqual(proposal1, proposal2))
 
 	// State should be identical now..
+	state1 = GenesisState{Proposals: []Proposal{proposal1}}
+	state2 = GenesisState{Proposals: []Proposal{proposal2}}
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
@@ -64,17 +69,24 @@ func TestImportExportQueues(t *testing.T) {
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
 	// Create two proposals, put the second into the voting period
-	proposal1 := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	proposalID1 := proposal1.GetProposalID()
+	tp := NewTextProposal("Test", "description")
+	proposal1, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID1 := proposal1.ProposalID
 
-	proposal2 := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	proposalID2 := proposal2.GetProposalID()
+	proposal2, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID2 := proposal2.ProposalID
 
 	_, votingStarted := keeper.AddDeposit(ctx, proposalID2, addrs[0], keeper.GetDepositParams(ctx).MinDeposit)
 	require.True(t, votingStarted)
 
-	require.True(t, keeper.GetProposal(ctx, proposalID1).GetStatus() == StatusDepositPeriod)
-	require.True(t, keeper.GetProposal(ctx, proposalID2).GetStatus() == StatusVotingPeriod)
+	proposal1, ok := keeper.GetProposal(ctx, proposalID1)
+	require.True(t, ok)
+	proposal2, ok = keeper.GetProposal(ctx, proposalID2)
+	require.True(t, ok)
+	require.True(t, proposal1.Status == StatusDepositPeriod)
+	require.True(t, proposal2.Status == StatusVotingPeriod)
 
 	genAccs := mapp.AccountKeeper.GetAllAccounts(ctx)
 
@@ -89,12 +101,19 @@ func TestImportExportQueues(t *testing.T) {
 	ctx2 = ctx2.WithBlockTime(ctx2.BlockHeader().Time.Add(keeper2.GetDepositParams(ctx2).MaxDepositPeriod).Add(keeper2.GetVotingParams(ctx2).VotingPeriod))
 
 	// Make sure that they are still in the DepositPeriod and VotingPeriod respectively
-	require.True(t, keeper2.GetProposal(ctx2, proposalID1).GetStatus() == StatusDepositPeriod)
-	require.True(t, keeper2.GetProposal(ctx2, proposalID2).GetStatus() == StatusVotingPeriod)
+	proposal1, ok = keeper2.GetProposal(ctx2, proposalID1)
+	require.True(t, ok)
+	proposal2, ok = keeper2.GetProposal(ctx2, proposalID2)
+	require.True(t, ok)
+	require.True(t, proposal1.Status == StatusDepositPeriod)
+	require.True(t, proposal2.Status == StatusVotingPeriod)
 
 	// Run the endblocker.  Check to make sure that proposal1 is removed from state, and proposal2 is finished VotingPeriod.
 	EndBlocker(ctx2, keeper2)
 
-	require.Nil(t, keeper2.GetProposal(ctx2, proposalID1))
-	require.True(t, keeper2.GetProposal(ctx2, proposalID2).GetStatus() == StatusRejected)
+	proposal1, ok = keeper2.GetProposal(ctx2, proposalID1)
+	require.False(t, ok)
+	proposal2, ok = keeper2.GetProposal(ctx2, proposalID2)
+	require.True(t, ok)
+	require.True(t, proposal2.Status == StatusRejected)
 }
@@ -40,11 +40,14 @@ TODO 重要
 提交一个提案
  */
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
-	// 根据入参，创建一个文本提案
-	proposal := keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
+	// 根据提案内容创建一个提案 (提案内容会先在缓存的上下文中执行一遍以校验其合法性)
+	proposal, err := keeper.SubmitProposal(ctx, msg.Content)
+	if err != nil {
+		return err.Result()
+	}
 
 	// 获取一个自增的 提案ID
-	proposalID := proposal.GetProposalID()
+	proposalID := proposal.ProposalID
 	proposalIDStr := fmt.Sprintf("%d", proposalID)
 
 	// 添加提案质押
@@ -113,3 +116,17 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 		),
 	}
 }
+
+// ProposalHandler implements the Handler for the proposal contents defined by
+// x/gov. Text proposals have no effect on the state once they pass.
+func ProposalHandler(_ sdk.Context, c Content) sdk.Error {
+	switch c.ProposalType() {
+	case ProposalTypeText:
+		// text proposals do not change state so this performs a no-op
+		return nil
+
+	default:
+		errMsg := fmt.Sprintf("unrecognized gov proposal type: %s", c.ProposalType())
+		return sdk.ErrUnknownRequest(errMsg)
+	}
+}
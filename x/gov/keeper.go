@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"fmt"
 	"time"
 
 	codec "my-cosmos/cosmos-sdk/codec"
@@ -76,6 +77,9 @@ type Keeper struct {
 
 	// Reserved codespace
 	codespace sdk.CodespaceType
+
+	// Proposal router, routing passed proposal contents to their handler
+	router Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -83,8 +87,15 @@ type Keeper struct {
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
+//
+// The router is sealed, no proposal handler can be added to it afterwards.
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
-	paramSpace params.Subspace, ck BankKeeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
+	paramSpace params.Subspace, ck BankKeeper, ds sdk.DelegationSet, codespace sdk.CodespaceType, rtr Router) Keeper {
+
+	// It is vital to seal the governance proposal router here as to not allow
+	// further handlers to be registered after the keeper is created since this
+	// could create invalid or non-deterministic behavior.
+	rtr.Seal()
 
 	return Keeper{
 		storeKey:     key,
@@ -95,73 +106,95 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
 		vs:           ds.GetValidatorSet(),
 		cdc:          cdc,
 		codespace:    codespace,
+		router:       rtr,
 	}
 }
 
 // Proposals
 
-// Creates a NewProposal
+// SubmitProposal creates a new proposal for the given content and starts its
+// deposit period.
 /**
-创建 一个治理相关的 提案 (文本提案？？)
+创建 一个治理相关的 提案
+提案内容需要先在缓存的上下文中执行一遍，以校验其是否可以被执行
  */
-func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind) Proposal {
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal, sdk.Error) {
+	if !keeper.router.HasRoute(content.ProposalRoute()) {
+		return Proposal{}, ErrNoProposalHandlerExists(keeper.codespace, content)
+	}
+
+	// Execute the proposal content in a cache-wrapped context to validate the
+	// actual changes before the proposal proceeds through the governance
+	// process. State is not persisted.
+	cacheCtx, _ := ctx.CacheContext()
+	handler := keeper.router.GetRoute(content.ProposalRoute())
+	if err := handler(cacheCtx, content); err != nil {
+		return Proposal{}, err
+	}
+
 	// 生成一个新的提案ID
 	proposalID, err := keeper.getNewProposalID(ctx)
 	if err != nil {
-		return nil
-	}
-
-	// 创建一个文本提案
-	var proposal Proposal = &TextProposal{
-		ProposalID:       proposalID,
-		Title:            title,
-		Description:      description,
-		ProposalType:     proposalType,
-		Status:           StatusDepositPeriod,
-		FinalTallyResult: EmptyTallyResult(),
-		TotalDeposit:     sdk.Coins{},
-		SubmitTime:       ctx.BlockHeader().Time,
+		return Proposal{}, err
 	}
 
-	// 获取治理质押的起始周期
+	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
-	proposal.SetDepositEndTime(proposal.GetSubmitTime().Add(depositPeriod))
 
-	// 保存新提案信息
+	proposal := NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+
+	// 保存新提案信息并追加到非激活提案队列中
 	keeper.SetProposal(ctx, proposal)
-	// 将提案ID往 非激活提案队列中追加
-	keeper.InsertInactiveProposalQueue(ctx, proposal.GetDepositEndTime(), proposalID)
-	return proposal
+	keeper.InsertInactiveProposalQueue(ctx, proposal.DepositEndTime, proposalID)
+	return proposal, nil
+}
+
+// executeProposal runs the handler routed to by the content of a passed
+// proposal. All state changes are made on a cached context which is only
+// written when the handler succeeds, so a failing proposal leaves the state
+// untouched.
+func (keeper Keeper) executeProposal(ctx sdk.Context, proposal Proposal) sdk.Error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	handler := keeper.router.GetRoute(proposal.ProposalRoute())
+	if err := handler(cacheCtx, proposal.Content); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
 }
 
 // Get Proposal from store by ProposalID
-func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) Proposal {
+func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := store.Get(KeyProposal(proposalID))
 	if bz == nil {
-		return nil
+		return
 	}
-	var proposal Proposal
 	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
-	return proposal
+	return proposal, true
 }
 
 // Implements sdk.AccountKeeper.
 func (keeper Keeper) SetProposal(ctx sdk.Context, proposal Proposal) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(proposal)
-	store.Set(KeyProposal(proposal.GetProposalID()), bz)
+	store.Set(KeyProposal(proposal.ProposalID), bz)
 }
 
 // Implements sdk.AccountKeeper.
 // 清除某提案相关信息
 func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
-	proposal := keeper.GetProposal(ctx, proposalID)
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		panic(fmt.Sprintf("couldn't find proposal with id#%d", proposalID))
+	}
 	// 非激活队列中清除 提案ID
-	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.GetDepositEndTime(), proposalID)
+	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.DepositEndTime, proposalID)
 	// 激活队列中删除提案ID (因为做成通用的，所有 非激活和激活都需要删一遍)
-	keeper.RemoveFromActiveProposalQueue(ctx, proposal.GetVotingEndTime(), proposalID)
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.VotingEndTime, proposalID)
 	// 删掉提案信息
 	store.Delete(KeyProposal(proposalID))
 }
@@ -199,13 +232,13 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddr
 			}
 		}
 
-		proposal := keeper.GetProposal(ctx, proposalID)
-		if proposal == nil {
+		proposal, ok := keeper.GetProposal(ctx, proposalID)
+		if !ok {
 			continue
 		}
 
 		if validProposalStatus(status) {
-			if proposal.GetStatus() != status {
+			if proposal.Status != status {
 				continue
 			}
 		}
@@ -269,20 +302,20 @@ func (keeper Keeper) peekCurrentProposalID(ctx sdk.Context) (proposalID uint64,
 // 开始激活提案投票周期
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	// 获取当前block的时间戳
-	proposal.SetVotingStartTime(ctx.BlockHeader().Time)
+	proposal.VotingStartTime = ctx.BlockHeader().Time
 	// 获取上下文中的 最长投票周期限制
 	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
 	// 根据当前block的时间戳和 最长投票期限先算出该提案投票终止时间
-	proposal.SetVotingEndTime(proposal.GetVotingStartTime().Add(votingPeriod))
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	// 更改提案状态为 投票周期
-	proposal.SetStatus(StatusVotingPeriod)
+	proposal.Status = StatusVotingPeriod
 	// 保存提案变更信息
 	keeper.SetProposal(ctx, proposal)
 
 	// 从非活动(非激活)提案队列中删除提案ID
-	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.GetDepositEndTime(), proposal.GetProposalID())
+	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.DepositEndTime, proposal.ProposalID)
 	// 追加到 激活提案队列
-	keeper.InsertActiveProposalQueue(ctx, proposal.GetVotingEndTime(), proposal.GetProposalID())
+	keeper.InsertActiveProposalQueue(ctx, proposal.VotingEndTime, proposal.ProposalID)
 }
 
 // Params
@@ -326,12 +359,12 @@ func (keeper Keeper) setTallyParams(ctx sdk.Context, tallyParams TallyParams) {
 // 添加对特定提案的投票
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
 	// 先获取提案，非空判断一波
-	proposal := keeper.GetProposal(ctx, proposalID)
-	if proposal == nil {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
 	}
 	// 是否为投票轮状态
-	if proposal.GetStatus() != StatusVotingPeriod {
+	if proposal.Status != StatusVotingPeriod {
 		return ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
@@ -412,15 +445,15 @@ func (keeper Keeper) setDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (sdk.Error, bool) {
 	// Checks to see if proposal exists
 	// 根据提案ID检查提案是否存在
-	proposal := keeper.GetProposal(ctx, proposalID)
-	if proposal == nil {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
 		return ErrUnknownProposal(keeper.codespace, proposalID), false
 	}
 
 	// Check if proposal is still depositable
 	// 检查提案是否仍可存款(质押)
 	// 如果当前提案的状态不属于 质押轮 也不属于 投票轮
-	if (proposal.GetStatus() != StatusDepositPeriod) && (proposal.GetStatus() != StatusVotingPeriod) {
+	if (proposal.Status != StatusDepositPeriod) && (proposal.Status != StatusVotingPeriod) {
 		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID), false
 	}
 
@@ -437,7 +470,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Update proposal
 	// 更新提案的质押金额信息
-	proposal.SetTotalDeposit(proposal.GetTotalDeposit().Add(depositAmount))
+	proposal.TotalDeposit = proposal.TotalDeposit.Add(depositAmount)
 	keeper.SetProposal(ctx, proposal)
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
@@ -447,7 +480,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// 可以看出来，是属于每次增持质押时主动触发  提案状态的变更
 	activatedVotingPeriod := false
 	// 如果现在属于 质押状态 且 所质押的钱满足 最小 提案投票质押金门槛
-	if proposal.GetStatus() == StatusDepositPeriod && proposal.GetTotalDeposit().IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
 		// 开始激活提案投票周期
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
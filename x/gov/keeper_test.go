@@ -16,11 +16,14 @@ func TestGetSetProposal(t *testing.T) {
 	mapp.BeginBlock(abci.RequestBeginBlock{})
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	proposalID := proposal.GetProposalID()
+	tp := NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
 	keeper.SetProposal(ctx, proposal)
 
-	gotProposal := keeper.GetProposal(ctx, proposalID)
+	gotProposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
 	require.True(t, ProposalEqual(proposal, gotProposal))
 }
 
@@ -29,14 +32,46 @@ func TestIncrementProposalNumber(t *testing.T) {
 	mapp.BeginBlock(abci.RequestBeginBlock{})
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	proposal6 := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	tp := NewTextProposal("Test", "description")
+	keeper.SubmitProposal(ctx, tp)
+	keeper.SubmitProposal(ctx, tp)
+	keeper.SubmitProposal(ctx, tp)
+	keeper.SubmitProposal(ctx, tp)
+	keeper.SubmitProposal(ctx, tp)
+	proposal6, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
 
-	require.Equal(t, uint64(6), proposal6.GetProposalID())
+	require.Equal(t, uint64(6), proposal6.ProposalID)
+}
+
+type invalidProposalRoute struct{ TextProposal }
+
+func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
+
+func TestSubmitProposal(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	testCases := []struct {
+		content     Content
+		expectedErr sdk.CodeType
+	}{
+		{TextProposal{"title", "description"}, 0},
+		// the same content is allowed twice
+		{TextProposal{"title", "description"}, 0},
+		{invalidProposalRoute{TextProposal{"title", "description"}}, CodeNoProposalHandlerExists},
+	}
+
+	for i, tc := range testCases {
+		_, err := keeper.SubmitProposal(ctx, tc.content)
+		if tc.expectedErr == 0 {
+			require.Nil(t, err, "tc #%d", i)
+		} else {
+			require.NotNil(t, err, "tc #%d", i)
+			require.Equal(t, tc.expectedErr, err.Code(), "tc #%d", i)
+		}
+	}
 }
 
 func TestActivateVotingPeriod(t *testing.T) {
@@ -44,19 +79,23 @@ func TestActivateVotingPeriod(t *testing.T) {
 	mapp.BeginBlock(abci.RequestBeginBlock{})
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	tp := NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
 
-	require.True(t, proposal.GetVotingStartTime().Equal(time.Time{}))
+	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
 
 	keeper.activateVotingPeriod(ctx, proposal)
 
-	require.True(t, proposal.GetVotingStartTime().Equal(ctx.BlockHeader().Time))
+	proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.True(t, proposal.VotingStartTime.Equal(ctx.BlockHeader().Time))
 
-	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.GetVotingEndTime())
+	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
 	require.True(t, activeIterator.Valid())
 	var proposalID uint64
 	keeper.cdc.UnmarshalBinaryLengthPrefixed(activeIterator.Value(), &proposalID)
-	require.Equal(t, proposalID, proposal.GetProposalID())
+	require.Equal(t, proposalID, proposal.ProposalID)
 	activeIterator.Close()
 }
 
@@ -66,8 +105,10 @@ func TestDeposits(t *testing.T) {
 	mapp.BeginBlock(abci.RequestBeginBlock{})
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	proposalID := proposal.GetProposalID()
+	tp := NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
 
 	fourSteak := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(4))}
 	fiveSteak := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(5))}
@@ -77,12 +118,14 @@ func TestDeposits(t *testing.T) {
 
 	expTokens := sdk.TokensFromTendermintPower(42)
 	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, expTokens)}, addr0Initial)
-	require.True(t, proposal.GetTotalDeposit().IsEqual(sdk.Coins{}))
+	require.True(t, proposal.TotalDeposit.IsEqual(sdk.Coins{}))
 
 	// Check no deposits at beginning
 	deposit, found := keeper.GetDeposit(ctx, proposalID, addrs[1])
 	require.False(t, found)
-	require.True(t, keeper.GetProposal(ctx, proposalID).GetVotingStartTime().Equal(time.Time{}))
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
 
 	// Check first deposit
 	err, votingStarted := keeper.AddDeposit(ctx, proposalID, addrs[0], fourSteak)
@@ -92,7 +135,9 @@ func TestDeposits(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, fourSteak, deposit.Amount)
 	require.Equal(t, addrs[0], deposit.Depositor)
-	require.Equal(t, fourSteak, keeper.GetProposal(ctx, proposalID).GetTotalDeposit())
+	proposal, ok = keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, fourSteak, proposal.TotalDeposit)
 	require.Equal(t, addr0Initial.Sub(fourSteak), keeper.ck.GetCoins(ctx, addrs[0]))
 
 	// Check a second deposit from same address
@@ -103,7 +148,9 @@ func TestDeposits(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, fourSteak.Add(fiveSteak), deposit.Amount)
 	require.Equal(t, addrs[0], deposit.Depositor)
-	require.Equal(t, fourSteak.Add(fiveSteak), keeper.GetProposal(ctx, proposalID).GetTotalDeposit())
+	proposal, ok = keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, fourSteak.Add(fiveSteak), proposal.TotalDeposit)
 	require.Equal(t, addr0Initial.Sub(fourSteak).Sub(fiveSteak), keeper.ck.GetCoins(ctx, addrs[0]))
 
 	// Check third deposit from a new address
@@ -114,11 +161,15 @@ func TestDeposits(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, addrs[1], deposit.Depositor)
 	require.Equal(t, fourSteak, deposit.Amount)
-	require.Equal(t, fourSteak.Add(fiveSteak).Add(fourSteak), keeper.GetProposal(ctx, proposalID).GetTotalDeposit())
+	proposal, ok = keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, fourSteak.Add(fiveSteak).Add(fourSteak), proposal.TotalDeposit)
 	require.Equal(t, addr1Initial.Sub(fourSteak), keeper.ck.GetCoins(ctx, addrs[1]))
 
 	// Check that proposal moved to voting period
-	require.True(t, keeper.GetProposal(ctx, proposalID).GetVotingStartTime().Equal(ctx.BlockHeader().Time))
+	proposal, ok = keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.VotingStartTime.Equal(ctx.BlockHeader().Time))
 
 	// Test deposit iterator
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
@@ -152,10 +203,12 @@ func TestVotes(t *testing.T) {
 	mapp.BeginBlock(abci.RequestBeginBlock{})
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
-	proposalID := proposal.GetProposalID()
+	tp := NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
 
-	proposal.SetStatus(StatusVotingPeriod)
+	proposal.Status = StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
 	// Test first vote
@@ -209,20 +262,25 @@ func TestProposalQueues(t *testing.T) {
 	mapp.InitChainer(ctx, abci.RequestInitChain{})
 
 	// create test proposals
-	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	tp := NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
 
-	inactiveIterator := keeper.InactiveProposalQueueIterator(ctx, proposal.GetDepositEndTime())
+	inactiveIterator := keeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
 	require.True(t, inactiveIterator.Valid())
 	var proposalID uint64
 	keeper.cdc.UnmarshalBinaryLengthPrefixed(inactiveIterator.Value(), &proposalID)
-	require.Equal(t, proposalID, proposal.GetProposalID())
+	require.Equal(t, proposalID, proposal.ProposalID)
 	inactiveIterator.Close()
 
 	keeper.activateVotingPeriod(ctx, proposal)
 
-	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.GetVotingEndTime())
+	proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+
+	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
 	require.True(t, activeIterator.Valid())
 	keeper.cdc.UnmarshalBinaryLengthPrefixed(activeIterator.Value(), &proposalID)
-	require.Equal(t, proposalID, proposal.GetProposalID())
+	require.Equal(t, proposalID, proposal.ProposalID)
 	activeIterator.Close()
 }
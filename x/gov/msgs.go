@@ -11,9 +11,6 @@ const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
 	TypeMsgSubmitProposal = "submit_proposal"
-
-	MaxDescriptionLength int = 5000
-	MaxTitleLength       int = 140
 )
 
 /**
@@ -24,26 +21,17 @@ var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
 // MsgSubmitProposal
 // 提交一个提案 入参
 type MsgSubmitProposal struct {
-	// 提案的标题
-	Title          string         `json:"title"`           //  Title of the proposal
-	// 这个是文本格式的 提案内容
-	Description    string         `json:"description"`     //  Description of the proposal
-	// 标识提案的类型
-	// 目前只有四种提案类型，代码中只用到三种
-	// nil提案类型、 文本提案、 参数改变提案、 软件升级提案
-	ProposalType   ProposalKind   `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	// 提案的具体内容 (文本提案、参数改变提案等等)
+	Content Content `json:"content"` //  Content of the proposal
 	// 发起提案者的 地址
-	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
+	Proposer sdk.AccAddress `json:"proposer"` //  Address of the proposer
 	// 提案发起人支付的初始存款。 必须严格积极。
-	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
+	InitialDeposit sdk.Coins `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
 }
 
-func NewMsgSubmitProposal(title, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
-	//
+func NewMsgSubmitProposal(content Content, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
 	return MsgSubmitProposal{
-		Title:          title,
-		Description:    description,
-		ProposalType:   proposalType,
+		Content:        content,
 		Proposer:       proposer,
 		InitialDeposit: initialDeposit,
 	}
@@ -55,20 +43,11 @@ func (msg MsgSubmitProposal) Type() string  { return TypeMsgSubmitProposal }
 
 // Implements Msg.
 func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
-	if len(msg.Title) == 0 {
-		return ErrInvalidTitle(DefaultCodespace, "No title present in proposal")
-	}
-	if len(msg.Title) > MaxTitleLength {
-		return ErrInvalidTitle(DefaultCodespace, fmt.Sprintf("Proposal title is longer than max length of %d", MaxTitleLength))
-	}
-	if len(msg.Description) == 0 {
-		return ErrInvalidDescription(DefaultCodespace, "No description present in proposal")
+	if msg.Content == nil {
+		return ErrInvalidProposalContent(DefaultCodespace, "missing content")
 	}
-	if len(msg.Description) > MaxDescriptionLength {
-		return ErrInvalidDescription(DefaultCodespace, fmt.Sprintf("Proposal description is longer than max length of %d", MaxDescriptionLength))
-	}
-	if !validProposalType(msg.ProposalType) {
-		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	if !IsValidProposalType(msg.Content.ProposalType()) {
+		return ErrInvalidProposalType(DefaultCodespace, msg.Content.ProposalType())
 	}
 	if msg.Proposer.Empty() {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
@@ -79,11 +58,12 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.InitialDeposit.IsAnyNegative() {
 		return sdk.ErrInvalidCoins(msg.InitialDeposit.String())
 	}
-	return nil
+
+	return msg.Content.ValidateBasic()
 }
 
 func (msg MsgSubmitProposal) String() string {
-	return fmt.Sprintf("MsgSubmitProposal{%s, %s, %s, %v}", msg.Title, msg.Description, msg.ProposalType, msg.InitialDeposit)
+	return fmt.Sprintf("MsgSubmitProposal{%s, %v}", msg.Content, msg.InitialDeposit)
 }
 
 // Implements Msg.
@@ -7,7 +7,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "my-cosmos/cosmos-sdk/types"
+	distr "my-cosmos/cosmos-sdk/x/distribution"
 	"my-cosmos/cosmos-sdk/x/mock"
+	"my-cosmos/cosmos-sdk/x/params"
+	"my-cosmos/cosmos-sdk/x/upgrade"
 )
 
 var (
@@ -26,26 +29,58 @@ func TestMsgSubmitProposal(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
 	tests := []struct {
 		title, description string
-		proposalType       ProposalKind
 		proposerAddr       sdk.AccAddress
 		initialDeposit     sdk.Coins
 		expectPass         bool
 	}{
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
-		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeParameterChange, addrs[0], coinsPos, true},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, true},
-		{"Test Proposal", "the purpose of this proposal is to test", 0x05, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, true},
-		{strings.Repeat("#", MaxTitleLength*2), "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, false},
-		{"Test Proposal", strings.Repeat("#", MaxDescriptionLength*2), ProposalTypeText, addrs[0], coinsMulti, false},
+		{"Test Proposal", "the purpose of this proposal is to test", addrs[0], coinsPos, true},
+		{"", "the purpose of this proposal is to test", addrs[0], coinsPos, false},
+		{"Test Proposal", "", addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", sdk.AccAddress{}, coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", addrs[0], coinsZero, true},
+		{"Test Proposal", "the purpose of this proposal is to test", addrs[0], coinsMulti, true},
+		{strings.Repeat("#", MaxTitleLength*2), "the purpose of this proposal is to test", addrs[0], coinsMulti, false},
+		{"Test Proposal", strings.Repeat("#", MaxDescriptionLength*2), addrs[0], coinsMulti, false},
 	}
 
 	for i, tc := range tests {
-		msg := NewMsgSubmitProposal(tc.title, tc.description, tc.proposalType, tc.proposerAddr, tc.initialDeposit)
+		msg := NewMsgSubmitProposal(NewTextProposal(tc.title, tc.description), tc.proposerAddr, tc.initialDeposit)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+type invalidProposalType struct{ TextProposal }
+
+func (invalidProposalType) ProposalType() string { return "Unknown" }
+
+// test ValidateBasic for MsgSubmitProposal with the contents of other modules
+func TestMsgSubmitProposalContent(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+	change := params.NewParamChange("gov", "votingparams", `{"voting_period":"1"}`)
+
+	tests := []struct {
+		content    Content
+		expectPass bool
+	}{
+		{nil, false},
+		{invalidProposalType{TextProposal{"Test Proposal", "test"}}, false},
+		{params.NewParameterChangeProposal("Test Proposal", "test", params.ParamChanges{change}), true},
+		{params.NewParameterChangeProposal("Test Proposal", "test", params.ParamChanges{}), false},
+		{params.NewParameterChangeProposal("Test Proposal", "test", params.ParamChanges{params.NewParamChange("", "votingparams", "1")}), false},
+		{upgrade.NewSoftwareUpgradeProposal("Test Proposal", "test", upgrade.Plan{Name: "v2", Height: 100}), true},
+		{upgrade.NewSoftwareUpgradeProposal("Test Proposal", "test", upgrade.Plan{Height: 100}), false},
+		{upgrade.NewSoftwareUpgradeProposal("Test Proposal", "test", upgrade.Plan{Name: "v2"}), false},
+		{distr.NewCommunityPoolSpendProposal("Test Proposal", "test", addrs[1], coinsPos), true},
+		{distr.NewCommunityPoolSpendProposal("Test Proposal", "test", sdk.AccAddress{}, coinsPos), false},
+		{distr.NewCommunityPoolSpendProposal("Test Proposal", "test", addrs[1], coinsZero), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitProposal(tc.content, addrs[0], coinsPos)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
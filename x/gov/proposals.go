@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -9,151 +10,57 @@ import (
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
-// Proposal interface
-type Proposal interface {
-	GetProposalID() uint64
-	SetProposalID(uint64)
-
-	GetTitle() string
-	SetTitle(string)
-
-	GetDescription() string
-	SetDescription(string)
-
-	GetProposalType() ProposalKind
-	SetProposalType(ProposalKind)
-
-	GetStatus() ProposalStatus
-	SetStatus(ProposalStatus)
-
-	GetFinalTallyResult() TallyResult
-	SetFinalTallyResult(TallyResult)
-
-	GetSubmitTime() time.Time
-	SetSubmitTime(time.Time)
-
-	GetDepositEndTime() time.Time
-	SetDepositEndTime(time.Time)
-
-	GetTotalDeposit() sdk.Coins
-	SetTotalDeposit(sdk.Coins)
-
-	GetVotingStartTime() time.Time
-	SetVotingStartTime(time.Time)
-
-	GetVotingEndTime() time.Time
-	SetVotingEndTime(time.Time)
-
-	String() string
-}
-
-// Proposals is an array of proposal
-type Proposals []Proposal
-
-func (p Proposals) String() string {
-	out := "ID - (Status) [Type] Title\n"
-	for _, prop := range p {
-		out += fmt.Sprintf("%d - (%s) [%s] %s\n",
-			prop.GetProposalID(), prop.GetStatus(),
-			prop.GetProposalType(), prop.GetTitle())
-	}
-	return strings.TrimSpace(out)
-}
-
-// checks if two proposals are equal
-func ProposalEqual(proposalA Proposal, proposalB Proposal) bool {
-	if proposalA.GetProposalID() == proposalB.GetProposalID() &&
-		proposalA.GetTitle() == proposalB.GetTitle() &&
-		proposalA.GetDescription() == proposalB.GetDescription() &&
-		proposalA.GetProposalType() == proposalB.GetProposalType() &&
-		proposalA.GetStatus() == proposalB.GetStatus() &&
-		proposalA.GetFinalTallyResult().Equals(proposalB.GetFinalTallyResult()) &&
-		proposalA.GetSubmitTime().Equal(proposalB.GetSubmitTime()) &&
-		proposalA.GetDepositEndTime().Equal(proposalB.GetDepositEndTime()) &&
-		proposalA.GetTotalDeposit().IsEqual(proposalB.GetTotalDeposit()) &&
-		proposalA.GetVotingStartTime().Equal(proposalB.GetVotingStartTime()) &&
-		proposalA.GetVotingEndTime().Equal(proposalB.GetVotingEndTime()) {
-		return true
-	}
-	return false
-}
-
-// Text Proposals
+// Proposal defines a struct used by the governance module to allow for voting
+// on network changes. What is actually voted on is its Content, which is
+// executed by the handler registered for its route once the proposal passes.
 /**
-文本 提议
-TODO 目前Proposal接口的实现，只有这个
+治理提案
+提案的具体内容由 Content 描述 (文本提案、参数改变提案等等)
  */
-type TextProposal struct {
-	// 提议ID
-	ProposalID   uint64       `json:"proposal_id"`   //  ID of the proposal
-	// 提议主题
-	Title        string       `json:"title"`         //  Title of the proposal
-	// 真正的提议内容(文本的内容)
-	Description  string       `json:"description"`   //  Description of the proposal
+type Proposal struct {
+	// 提案的具体内容
+	Content `json:"content"` // Proposal content interface
 
-	// 提议的类型
-	ProposalType ProposalKind `json:"proposal_type"` //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	// 提议ID
+	ProposalID uint64 `json:"proposal_id"` //  ID of the proposal
 
 	// 该提议的状态
-	Status           ProposalStatus `json:"proposal_status"`    //  Status of the Proposal {Pending, Active, Passed, Rejected}
+	Status ProposalStatus `json:"proposal_status"` //  Status of the Proposal {Pending, Active, Passed, Rejected, Failed}
 
 	// 该提议的最终计算结果详情
-	FinalTallyResult TallyResult    `json:"final_tally_result"` //  Result of Tallys
+	FinalTallyResult TallyResult `json:"final_tally_result"` //  Result of Tallys
 
 	// 提议的提交时间
 	// 包含TxGovSubmitProposal的块的时间
-	SubmitTime     time.Time `json:"submit_time"`      //  Time of the block where TxGovSubmitProposal was included
+	SubmitTime time.Time `json:"submit_time"` //  Time of the block where TxGovSubmitProposal was included
 
 	// 如果未达到存款金额，提案将到期的时间
 	DepositEndTime time.Time `json:"deposit_end_time"` // Time that the Proposal would expire if deposit amount isn't met
 
 	// 此提案的当前存款。 初始值在InitialDeposit中设置
-	TotalDeposit   sdk.Coins `json:"total_deposit"`    //  Current deposit on this proposal. Initial value is set at InitialDeposit
+	TotalDeposit sdk.Coins `json:"total_deposit"` //  Current deposit on this proposal. Initial value is set at InitialDeposit
 
 	// 到达MinDeposit的块的时间。 -1如果未达到MinDeposit
 	VotingStartTime time.Time `json:"voting_start_time"` //  Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 
-	// 此提案的VotingPeriod将结束并且将计算投票的时间
 	// 该提议的投票轮的到期时间
-	VotingEndTime   time.Time `json:"voting_end_time"`   // Time that the VotingPeriod for this proposal will end and votes will be tallied
+	VotingEndTime time.Time `json:"voting_end_time"` // Time that the VotingPeriod for this proposal will end and votes will be tallied
 }
 
-// Implements Proposal Interface
-var _ Proposal = (*TextProposal)(nil)
-
-// nolint
-func (tp TextProposal) GetProposalID() uint64                      { return tp.ProposalID }
-func (tp *TextProposal) SetProposalID(proposalID uint64)           { tp.ProposalID = proposalID }
-func (tp TextProposal) GetTitle() string                           { return tp.Title }
-func (tp *TextProposal) SetTitle(title string)                     { tp.Title = title }
-func (tp TextProposal) GetDescription() string                     { return tp.Description }
-func (tp *TextProposal) SetDescription(description string)         { tp.Description = description }
-func (tp TextProposal) GetProposalType() ProposalKind              { return tp.ProposalType }
-func (tp *TextProposal) SetProposalType(proposalType ProposalKind) { tp.ProposalType = proposalType }
-func (tp TextProposal) GetStatus() ProposalStatus                  { return tp.Status }
-func (tp *TextProposal) SetStatus(status ProposalStatus)           { tp.Status = status }
-func (tp TextProposal) GetFinalTallyResult() TallyResult           { return tp.FinalTallyResult }
-func (tp *TextProposal) SetFinalTallyResult(tallyResult TallyResult) {
-	tp.FinalTallyResult = tallyResult
-}
-func (tp TextProposal) GetSubmitTime() time.Time            { return tp.SubmitTime }
-func (tp *TextProposal) SetSubmitTime(submitTime time.Time) { tp.SubmitTime = submitTime }
-func (tp TextProposal) GetDepositEndTime() time.Time        { return tp.DepositEndTime }
-func (tp *TextProposal) SetDepositEndTime(depositEndTime time.Time) {
-	tp.DepositEndTime = depositEndTime
-}
-func (tp TextProposal) GetTotalDeposit() sdk.Coins              { return tp.TotalDeposit }
-func (tp *TextProposal) SetTotalDeposit(totalDeposit sdk.Coins) { tp.TotalDeposit = totalDeposit }
-func (tp TextProposal) GetVotingStartTime() time.Time           { return tp.VotingStartTime }
-func (tp *TextProposal) SetVotingStartTime(votingStartTime time.Time) {
-	tp.VotingStartTime = votingStartTime
-}
-func (tp TextProposal) GetVotingEndTime() time.Time { return tp.VotingEndTime }
-func (tp *TextProposal) SetVotingEndTime(votingEndTime time.Time) {
-	tp.VotingEndTime = votingEndTime
+// NewProposal creates a proposal in its deposit period
+func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
+	return Proposal{
+		Content:          content,
+		ProposalID:       id,
+		Status:           StatusDepositPeriod,
+		FinalTallyResult: EmptyTallyResult(),
+		TotalDeposit:     sdk.Coins{},
+		SubmitTime:       submitTime,
+		DepositEndTime:   depositEndTime,
+	}
 }
 
-func (tp TextProposal) String() string {
+func (p Proposal) String() string {
 	return fmt.Sprintf(`Proposal %d:
   Title:              %s
   Type:               %s
@@ -162,113 +69,32 @@ func (tp TextProposal) String() string {
   Deposit End Time:   %s
   Total Deposit:      %s
   Voting Start Time:  %s
-  Voting End Time:    %s`, tp.ProposalID, tp.Title, tp.ProposalType,
-		tp.Status, tp.SubmitTime, tp.DepositEndTime,
-		tp.TotalDeposit, tp.VotingStartTime, tp.VotingEndTime)
-}
-
-// ProposalQueue
-type ProposalQueue []uint64
-
-// ProposalKind
-
-// Type that represents Proposal Type as a byte
-// 表示 治理的提案类型
-type ProposalKind byte
-
-//nolint
-const (
-	// nil提案
-	ProposalTypeNil             ProposalKind = 0x00
-	// 文本提案
-	ProposalTypeText            ProposalKind = 0x01
-	// 参数改变提案
-	ProposalTypeParameterChange ProposalKind = 0x02
-	// 软件升级提案
-	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
-)
-
-// String to proposalType byte. Returns 0xff if invalid.
-func ProposalTypeFromString(str string) (ProposalKind, error) {
-	switch str {
-	case "Text":
-		return ProposalTypeText, nil
-	case "ParameterChange":
-		return ProposalTypeParameterChange, nil
-	case "SoftwareUpgrade":
-		return ProposalTypeSoftwareUpgrade, nil
-	default:
-		return ProposalKind(0xff), fmt.Errorf("'%s' is not a valid proposal type", str)
-	}
+  Voting End Time:    %s
+  Description:        %s`, p.ProposalID, p.GetTitle(), p.ProposalType(),
+		p.Status, p.SubmitTime, p.DepositEndTime,
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription())
 }
 
-// is defined ProposalType?
-func validProposalType(pt ProposalKind) bool {
-	if pt == ProposalTypeText ||
-		pt == ProposalTypeParameterChange ||
-		pt == ProposalTypeSoftwareUpgrade {
-		return true
-	}
-	return false
-}
-
-// Marshal needed for protobuf compatibility
-func (pt ProposalKind) Marshal() ([]byte, error) {
-	return []byte{byte(pt)}, nil
-}
-
-// Unmarshal needed for protobuf compatibility
-func (pt *ProposalKind) Unmarshal(data []byte) error {
-	*pt = ProposalKind(data[0])
-	return nil
-}
-
-// Marshals to JSON using string
-func (pt ProposalKind) MarshalJSON() ([]byte, error) {
-	return json.Marshal(pt.String())
-}
-
-// Unmarshals from JSON assuming Bech32 encoding
-func (pt *ProposalKind) UnmarshalJSON(data []byte) error {
-	var s string
-	err := json.Unmarshal(data, &s)
-	if err != nil {
-		return err
-	}
+// Proposals is an array of proposal
+type Proposals []Proposal
 
-	bz2, err := ProposalTypeFromString(s)
-	if err != nil {
-		return err
+func (p Proposals) String() string {
+	out := "ID - (Status) [Type] Title\n"
+	for _, prop := range p {
+		out += fmt.Sprintf("%d - (%s) [%s] %s\n",
+			prop.ProposalID, prop.Status,
+			prop.ProposalType(), prop.GetTitle())
 	}
-	*pt = bz2
-	return nil
+	return strings.TrimSpace(out)
 }
 
-// Turns VoteOption byte to String
-func (pt ProposalKind) String() string {
-	switch pt {
-	case ProposalTypeText:
-		return "Text"
-	case ProposalTypeParameterChange:
-		return "ParameterChange"
-	case ProposalTypeSoftwareUpgrade:
-		return "SoftwareUpgrade"
-	default:
-		return ""
-	}
+// checks if two proposals are equal
+func ProposalEqual(proposalA Proposal, proposalB Proposal) bool {
+	return bytes.Equal(msgCdc.MustMarshalBinaryBare(proposalA), msgCdc.MustMarshalBinaryBare(proposalB))
 }
 
-// For Printf / Sprintf, returns bech32 when using %s
-// nolint: errcheck
-func (pt ProposalKind) Format(s fmt.State, verb rune) {
-	switch verb {
-	case 's':
-		s.Write([]byte(pt.String()))
-	default:
-		// TODO: Do this conversion more directly
-		s.Write([]byte(fmt.Sprintf("%v", byte(pt))))
-	}
-}
+// ProposalQueue
+type ProposalQueue []uint64
 
 // ProposalStatus
 
@@ -288,6 +114,8 @@ const (
 	StatusPassed        ProposalStatus = 0x03
 	// 提案被拒绝
 	StatusRejected      ProposalStatus = 0x04
+	// 提案通过但执行失败
+	StatusFailed        ProposalStatus = 0x05
 )
 
 // ProposalStatusToString turns a string into a ProposalStatus
@@ -301,6 +129,8 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 		return StatusPassed, nil
 	case "Rejected":
 		return StatusRejected, nil
+	case "Failed":
+		return StatusFailed, nil
 	case "":
 		return StatusNil, nil
 	default:
@@ -314,7 +144,8 @@ func validProposalStatus(status ProposalStatus) bool {
 	if status == StatusDepositPeriod ||
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
-		status == StatusRejected {
+		status == StatusRejected ||
+		status == StatusFailed {
 		return true
 	}
 	return false
@@ -363,6 +194,8 @@ func (status ProposalStatus) String() string {
 		return "Passed"
 	case StatusRejected:
 		return "Rejected"
+	case StatusFailed:
+		return "Failed"
 	default:
 		return ""
 	}
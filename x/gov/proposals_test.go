@@ -7,20 +7,24 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestProposalKind_Format(t *testing.T) {
-	typeText, _ := ProposalTypeFromString("Text")
+func TestProposalTypes(t *testing.T) {
 	tests := []struct {
-		pt                   ProposalKind
-		sprintFArgs          string
-		expectedStringOutput string
+		proposalType string
+		expectValid  bool
 	}{
-		{typeText, "%s", "Text"},
-		{typeText, "%v", "1"},
+		{ProposalTypeText, true},
+		// registered by the params module
+		{"ParameterChange", true},
+		{"Unknown", false},
+		{"", false},
 	}
 	for _, tt := range tests {
-		got := fmt.Sprintf(tt.sprintFArgs, tt.pt)
-		require.Equal(t, tt.expectedStringOutput, got)
+		require.Equal(t, tt.expectValid, IsValidProposalType(tt.proposalType), tt.proposalType)
 	}
+
+	require.Equal(t, NewTextProposal("Test", "description"), ContentFromProposalType("Test", "description", ProposalTypeText))
+	require.Nil(t, ContentFromProposalType("Test", "description", "ParameterChange"))
+	require.Panics(t, func() { RegisterProposalType(ProposalTypeText) })
 }
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -187,16 +187,16 @@ func testQueries(t *testing.T) {
 	depositParams, _, _ := getQueriedParams(t, ctx, cdc, querier)
 
 	// addrs[0] proposes (and deposits) proposals #1 and #2
-	res := handler(ctx, NewMsgSubmitProposal("title", "description", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
+	res := handler(ctx, NewMsgSubmitProposal(TextProposal{"title", "description"}, addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
 	var proposalID1 uint64
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID1)
 
-	res = handler(ctx, NewMsgSubmitProposal("title", "description", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
+	res = handler(ctx, NewMsgSubmitProposal(TextProposal{"title", "description"}, addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
 	var proposalID2 uint64
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID2)
 
 	// addrs[1] proposes (and deposits) proposals #3
-	res = handler(ctx, NewMsgSubmitProposal("title", "description", ProposalTypeText, addrs[1], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
+	res = handler(ctx, NewMsgSubmitProposal(TextProposal{"title", "description"}, addrs[1], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
 	var proposalID3 uint64
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID3)
 
@@ -227,12 +227,12 @@ func testQueries(t *testing.T) {
 	// Only proposal #1 should be in Deposit Period
 	proposals := getQueriedProposals(t, ctx, cdc, querier, nil, nil, StatusDepositPeriod, 0)
 	require.Len(t, proposals, 1)
-	require.Equal(t, proposalID1, proposals[0].GetProposalID())
+	require.Equal(t, proposalID1, proposals[0].ProposalID)
 	// Only proposals #2 and #3 should be in Voting Period
 	proposals = getQueriedProposals(t, ctx, cdc, querier, nil, nil, StatusVotingPeriod, 0)
 	require.Len(t, proposals, 2)
-	require.Equal(t, proposalID2, proposals[0].GetProposalID())
-	require.Equal(t, proposalID3, proposals[1].GetProposalID())
+	require.Equal(t, proposalID2, proposals[0].ProposalID)
+	require.Equal(t, proposalID3, proposals[1].ProposalID)
 
 	// Addrs[0] votes on proposals #2 & #3
 	handler(ctx, NewMsgVote(addrs[0], proposalID2, OptionYes))
@@ -243,8 +243,8 @@ func testQueries(t *testing.T) {
 
 	// Test query voted by addrs[0]
 	proposals = getQueriedProposals(t, ctx, cdc, querier, nil, addrs[0], StatusNil, 0)
-	require.Equal(t, proposalID2, (proposals[0]).GetProposalID())
-	require.Equal(t, proposalID3, (proposals[1]).GetProposalID())
+	require.Equal(t, proposalID2, proposals[0].ProposalID)
+	require.Equal(t, proposalID3, proposals[1].ProposalID)
 
 	// Test query votes on Proposal 2
 	votes := getQueriedVotes(t, ctx, cdc, querier, proposalID2)
@@ -263,26 +263,26 @@ func testQueries(t *testing.T) {
 
 	// Test query all proposals
 	proposals = getQueriedProposals(t, ctx, cdc, querier, nil, nil, StatusNil, 0)
-	require.Equal(t, proposalID1, (proposals[0]).GetProposalID())
-	require.Equal(t, proposalID2, (proposals[1]).GetProposalID())
-	require.Equal(t, proposalID3, (proposals[2]).GetProposalID())
+	require.Equal(t, proposalID1, proposals[0].ProposalID)
+	require.Equal(t, proposalID2, proposals[1].ProposalID)
+	require.Equal(t, proposalID3, proposals[2].ProposalID)
 
 	// Test query voted by addrs[1]
 	proposals = getQueriedProposals(t, ctx, cdc, querier, nil, addrs[1], StatusNil, 0)
-	require.Equal(t, proposalID3, (proposals[0]).GetProposalID())
+	require.Equal(t, proposalID3, proposals[0].ProposalID)
 
 	// Test query deposited by addrs[0]
 	proposals = getQueriedProposals(t, ctx, cdc, querier, addrs[0], nil, StatusNil, 0)
-	require.Equal(t, proposalID1, (proposals[0]).GetProposalID())
+	require.Equal(t, proposalID1, proposals[0].ProposalID)
 
 	// Test query deposited by addr2
 	proposals = getQueriedProposals(t, ctx, cdc, querier, addrs[1], nil, StatusNil, 0)
-	require.Equal(t, proposalID2, (proposals[0]).GetProposalID())
-	require.Equal(t, proposalID3, (proposals[1]).GetProposalID())
+	require.Equal(t, proposalID2, proposals[0].ProposalID)
+	require.Equal(t, proposalID3, proposals[1].ProposalID)
 
 	// Test query voted AND deposited by addr1
 	proposals = getQueriedProposals(t, ctx, cdc, querier, addrs[0], addrs[0], StatusNil, 0)
-	require.Equal(t, proposalID2, (proposals[0]).GetProposalID())
+	require.Equal(t, proposalID2, proposals[0].ProposalID)
 
 	// Test Tally Query
 	tally := getQueriedTally(t, ctx, cdc, querier, proposalID2)
@@ -102,13 +102,11 @@ func simulateHandleMsgSubmitProposal(msg gov.MsgSubmitProposal, handler sdk.Hand
 
 func simulationCreateMsgSubmitProposal(r *rand.Rand, sender simulation.Account) (msg gov.MsgSubmitProposal, err error) {
 	deposit := randomDeposit(r)
-	msg = gov.NewMsgSubmitProposal(
+	content := gov.NewTextProposal(
 		simulation.RandStringOfLength(r, 5),
 		simulation.RandStringOfLength(r, 5),
-		gov.ProposalTypeText,
-		sender.Address,
-		deposit,
 	)
+	msg = gov.NewMsgSubmitProposal(content, sender.Address, deposit)
 	if msg.ValidateBasic() != nil {
 		err = fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 	}
@@ -14,8 +14,12 @@ import (
 	sdk "my-cosmos/cosmos-sdk/types"
 	"my-cosmos/cosmos-sdk/x/auth"
 	"my-cosmos/cosmos-sdk/x/bank"
+	distr "my-cosmos/cosmos-sdk/x/distribution"
 	"my-cosmos/cosmos-sdk/x/mock"
+	"my-cosmos/cosmos-sdk/x/params"
 	"my-cosmos/cosmos-sdk/x/staking"
+	"my-cosmos/cosmos-sdk/x/supply"
+	"my-cosmos/cosmos-sdk/x/upgrade"
 )
 
 // initialize the mock application for this module
@@ -23,27 +27,56 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 	mapp *mock.App, keeper Keeper, sk staking.Keeper, addrs []sdk.AccAddress,
 	pubKeys []crypto.PubKey, privKeys []crypto.PrivKey) {
 
+	mapp, keeper, sk, _, _, addrs, pubKeys, privKeys = getMockAppWithKeepers(t, numGenAccs, genState, genAccs)
+	return mapp, keeper, sk, addrs, pubKeys, privKeys
+}
+
+// initialize the mock application for this module, also returning the
+// keepers the proposal contents are routed to
+func getMockAppWithKeepers(t *testing.T, numGenAccs int, genState GenesisState, genAccs []auth.Account) (
+	mapp *mock.App, keeper Keeper, sk staking.Keeper, uk upgrade.Keeper, dk distr.Keeper,
+	addrs []sdk.AccAddress, pubKeys []crypto.PubKey, privKeys []crypto.PrivKey) {
+
 	mapp = mock.NewApp()
 
 	staking.RegisterCodec(mapp.Cdc)
+	params.RegisterCodec(mapp.Cdc)
+	upgrade.RegisterCodec(mapp.Cdc)
+	distr.RegisterCodec(mapp.Cdc)
 	RegisterCodec(mapp.Cdc)
 
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keyGov := sdk.NewKVStoreKey(StoreKey)
+	keyUpgrade := sdk.NewKVStoreKey(upgrade.StoreKey)
+	keyDistr := sdk.NewKVStoreKey(distr.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 
 	pk := mapp.ParamsKeeper
 	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
-	sk = staking.NewKeeper(mapp.Cdc, keyStaking, tkeyStaking, ck, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
-	keeper = NewKeeper(mapp.Cdc, keyGov, pk, pk.Subspace("testgov"), ck, sk, DefaultCodespace)
+	supplyKeeper := supply.NewKeeper(mapp.Cdc, keySupply, mapp.AccountKeeper, ck, map[string][]string{
+		auth.FeeCollectorName: nil,
+		distr.ModuleName:      nil,
+	})
+	sk = staking.NewKeeper(mapp.Cdc, keyStaking, tkeyStaking, ck, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	uk = upgrade.NewKeeper(mapp.Cdc, keyUpgrade)
+	dk = distr.NewKeeper(mapp.Cdc, keyDistr, pk.Subspace(distr.DefaultParamspace), supplyKeeper, sk, mapp.FeeCollectionKeeper, distr.DefaultCodespace)
+
+	rtr := NewRouter().
+		AddRoute(RouterKey, ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(pk)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(uk)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(dk))
+
+	keeper = NewKeeper(mapp.Cdc, keyGov, pk, pk.Subspace("testgov"), ck, sk, DefaultCodespace, rtr)
 
 	mapp.Router().AddRoute(RouterKey, NewHandler(keeper))
 	mapp.QueryRouter().AddRoute(QuerierRoute, NewQuerier(keeper))
 
 	mapp.SetEndBlocker(getEndBlocker(keeper))
-	mapp.SetInitChainer(getInitChainer(mapp, keeper, sk, genState))
+	mapp.SetInitChainer(getInitChainer(mapp, keeper, sk, dk, genState))
 
-	require.NoError(t, mapp.CompleteSetup(keyStaking, tkeyStaking, keyGov))
+	require.NoError(t, mapp.CompleteSetup(keyStaking, tkeyStaking, keyGov, keyUpgrade, keyDistr, keySupply))
 
 	valTokens := sdk.TokensFromTendermintPower(42)
 	if genAccs == nil || len(genAccs) == 0 {
@@ -53,7 +86,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 
 	mock.SetGenesis(mapp, genAccs)
 
-	return mapp, keeper, sk, addrs, pubKeys, privKeys
+	return mapp, keeper, sk, uk, dk, addrs, pubKeys, privKeys
 }
 
 // gov and staking endblocker
@@ -67,7 +100,7 @@ func getEndBlocker(keeper Keeper) sdk.EndBlocker {
 }
 
 // gov and staking initchainer
-func getInitChainer(mapp *mock.App, keeper Keeper, stakingKeeper staking.Keeper, genState GenesisState) sdk.InitChainer {
+func getInitChainer(mapp *mock.App, keeper Keeper, stakingKeeper staking.Keeper, distrKeeper distr.Keeper, genState GenesisState) sdk.InitChainer {
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
 
@@ -79,6 +112,8 @@ func getInitChainer(mapp *mock.App, keeper Keeper, stakingKeeper staking.Keeper,
 		if err != nil {
 			panic(err)
 		}
+		distrKeeper.SetFeePool(ctx, distr.InitialFeePool())
+
 		if genState.IsEmpty() {
 			InitGenesis(ctx, keeper, DefaultGenesisState())
 		} else {
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec used by x/gov to encode messages. Proposal contents
+// defined in other modules are registered on it through
+// RegisterProposalTypeCodec, so that MsgSubmitProposal can be encoded.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers the Content interface and the contents defined by
+// x/gov on the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Content)(nil), nil)
+	cdc.RegisterConcrete(TextProposal{}, "gov/TextProposal", nil)
+}
+
+// RegisterProposalTypeCodec registers an external proposal content type defined
+// in another module on ModuleCdc. This allows the MsgSubmitProposal to be
+// correctly Amino encoded and decoded.
+func RegisterProposalTypeCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Constants pertaining to a Content object
+const (
+	MaxDescriptionLength int = 5000
+	MaxTitleLength       int = 140
+)
+
+// Content defines an interface that a proposal must implement. It contains
+// information such as the title and description along with the type and
+// routing information for the appropriate handler to process the proposal.
+// Content can have additional fields, which will be handled by the proposal's
+// Handler.
+type Content interface {
+	GetTitle() string
+	GetDescription() string
+	ProposalRoute() string
+	ProposalType() string
+	ValidateBasic() sdk.Error
+	String() string
+}
+
+// Handler defines a function that handles a proposal after it has passed the
+// governance process. All state changes are made on a cached context, which is
+// discarded when an error is returned.
+type Handler func(ctx sdk.Context, content Content) sdk.Error
+
+// ValidateAbstract validates the title and description every proposal content
+// carries. Content implementations should call it from their ValidateBasic.
+func ValidateAbstract(codespace sdk.CodespaceType, c Content) sdk.Error {
+	title := c.GetTitle()
+	if len(strings.TrimSpace(title)) == 0 {
+		return ErrInvalidTitle(codespace, "No title present in proposal")
+	}
+	if len(title) > MaxTitleLength {
+		return ErrInvalidTitle(codespace, fmt.Sprintf("Proposal title is longer than max length of %d", MaxTitleLength))
+	}
+
+	description := c.GetDescription()
+	if len(description) == 0 {
+		return ErrInvalidDescription(codespace, "No description present in proposal")
+	}
+	if len(description) > MaxDescriptionLength {
+		return ErrInvalidDescription(codespace, fmt.Sprintf("Proposal description is longer than max length of %d", MaxDescriptionLength))
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+// nolint
+package types
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = "gov"
+
+	CodeInvalidTitle           sdk.CodeType = 6
+	CodeInvalidDescription     sdk.CodeType = 7
+	CodeInvalidProposalType    sdk.CodeType = 8
+	CodeInvalidProposalContent sdk.CodeType = 12
+)
+
+// Error constructors
+
+func ErrInvalidTitle(codespace sdk.CodespaceType, errorMsg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTitle, errorMsg)
+}
+
+func ErrInvalidDescription(codespace sdk.CodespaceType, errorMsg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDescription, errorMsg)
+}
+
+func ErrInvalidProposalType(codespace sdk.CodespaceType, proposalType string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalType, fmt.Sprintf("Proposal Type '%s' is not valid", proposalType))
+}
+
+func ErrInvalidProposalContent(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalContent, fmt.Sprintf("Invalid proposal content: %s", msg))
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RouterKey is the route of the proposal contents handled by x/gov itself
+	RouterKey = "gov"
+
+	// ProposalTypeText is the type of a plain text proposal
+	ProposalTypeText = "Text"
+)
+
+// Text Proposals
+
+// TextProposal defines a standard text proposal whose changes need to be
+// manually updated in case of approval
+type TextProposal struct {
+	Title       string `json:"title"`       //  Title of the proposal
+	Description string `json:"description"` //  Description of the proposal
+}
+
+// NewTextProposal creates a text proposal Content
+func NewTextProposal(title, description string) Content {
+	return TextProposal{title, description}
+}
+
+// Implements Content Interface
+var _ Content = TextProposal{}
+
+// nolint
+func (tp TextProposal) GetTitle() string         { return tp.Title }
+func (tp TextProposal) GetDescription() string   { return tp.Description }
+func (tp TextProposal) ProposalRoute() string    { return RouterKey }
+func (tp TextProposal) ProposalType() string     { return ProposalTypeText }
+func (tp TextProposal) ValidateBasic() sdk.Error { return ValidateAbstract(DefaultCodespace, tp) }
+
+func (tp TextProposal) String() string {
+	return fmt.Sprintf(`Text Proposal:
+  Title:       %s
+  Description: %s
+`, tp.Title, tp.Description)
+}
+
+// Proposal types
+
+var validProposalTypes = map[string]struct{}{
+	ProposalTypeText: {},
+}
+
+// RegisterProposalType registers a proposal type. It will panic if the type is
+// already registered.
+func RegisterProposalType(ty string) {
+	if _, ok := validProposalTypes[ty]; ok {
+		panic(fmt.Sprintf("already registered proposal type: %s", ty))
+	}
+
+	validProposalTypes[ty] = struct{}{}
+}
+
+// IsValidProposalType returns a boolean determining if the proposal type is
+// valid.
+func IsValidProposalType(ty string) bool {
+	_, ok := validProposalTypes[ty]
+	return ok
+}
+
+// ContentFromProposalType returns a Content object based on the proposal type.
+// Only text proposals can be built from a title and a description, nil is
+// returned for every other type.
+func ContentFromProposalType(title, desc, ty string) Content {
+	switch ty {
+	case ProposalTypeText:
+		return NewTextProposal(title, desc)
+
+	default:
+		return nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"my-cosmos/cosmos-sdk/x/auth"
 	"my-cosmos/cosmos-sdk/x/bank"
 	"my-cosmos/cosmos-sdk/x/mock"
+	"my-cosmos/cosmos-sdk/x/supply"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -19,27 +20,26 @@ func getMockApp(t *testing.T) *mock.App {
 	mapp := mock.NewApp()
 
 	RegisterCodec(mapp.Cdc)
-	keyIBC := sdk.NewKVStoreKey("ibc")
-	ibcMapper := NewMapper(mapp.Cdc, keyIBC, DefaultCodespace)
+	keyIBC := sdk.NewKVStoreKey(StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	ibcKeeper := NewKeeper(mapp.Cdc, keyIBC, DefaultCodespace)
 	bankKeeper := bank.NewBaseKeeper(mapp.AccountKeeper,
 		mapp.ParamsKeeper.Subspace(bank.DefaultParamspace),
 		bank.DefaultCodespace)
-	mapp.Router().AddRoute("ibc", NewHandler(ibcMapper, bankKeeper))
+	supplyKeeper := supply.NewKeeper(mapp.Cdc, keySupply, mapp.AccountKeeper, bankKeeper,
+		map[string][]string{ModuleName: {auth.Minter, auth.Burner}})
+	mapp.Router().AddRoute(RouterKey, NewHandler(ibcKeeper, bankKeeper, supplyKeeper))
 
-	require.NoError(t, mapp.CompleteSetup(keyIBC))
+	require.NoError(t, mapp.CompleteSetup(keyIBC, keySupply))
 	return mapp
 }
 
 func TestIBCMsgs(t *testing.T) {
 	mapp := getMockApp(t)
 
-	sourceChain := "source-chain"
-	destChain := "dest-chain"
-
 	priv1 := secp256k1.GenPrivKey()
 	addr1 := sdk.AccAddress(priv1.PubKey().Address())
 	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
-	var emptyCoins sdk.Coins
 
 	acc := &auth.BaseAccount{
 		Address: addr1,
@@ -54,28 +54,23 @@ func TestIBCMsgs(t *testing.T) {
 	res1 := mapp.AccountKeeper.GetAccount(ctxCheck, addr1)
 	require.Equal(t, acc, res1)
 
-	packet := IBCPacket{
-		SrcAddr:   addr1,
-		DestAddr:  addr1,
-		Coins:     coins,
-		SrcChain:  sourceChain,
-		DestChain: destChain,
-	}
-
-	transferMsg := MsgIBCTransfer{
-		IBCPacket: packet,
-	}
-
+	valSet, privVals := makeValidators(1)
+	header := makeHeader("counterparty-chain", 1, []byte("root"), valSet, valSet, privVals)
+	createMsg := NewMsgCreateClient("client-a", NewConsensusState(header), addr1)
+	transferMsg := NewMsgIBCTransfer("chana", addr1, addr1, coins, 100)
 	receiveMsg := MsgIBCReceive{
-		IBCPacket: packet,
-		Relayer:   addr1,
-		Sequence:  0,
+		IBCPacket:   NewIBCPacket(0, "chanb", "chana", addr1, addr1, coins, 100),
+		Proof:       constructProof(),
+		ProofHeight: 1,
+		Relayer:     addr1,
 	}
 
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{transferMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
-	mock.CheckBalance(t, mapp, addr1, emptyCoins)
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{transferMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{receiveMsg}, []uint64{0}, []uint64{2}, true, true, priv1)
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{createMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+	// the client already exists
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{createMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
+	// there is no open channel to send or receive packets on
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{transferMsg}, []uint64{0}, []uint64{2}, false, false, priv1)
+	mock.CheckBalance(t, mapp, addr1, coins)
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{receiveMsg}, []uint64{0}, []uint64{3}, false, false, priv1)
 	mock.CheckBalance(t, mapp, addr1, coins)
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, []sdk.Msg{receiveMsg}, []uint64{0}, []uint64{2}, false, false, priv1)
 }
@@ -0,0 +1,47 @@
+package ibc
+
+// ChannelState is the state of a channel end during and after the channel
+// handshake
+type ChannelState byte
+
+// nolint
+const (
+	ChannelInit    ChannelState = 0x01
+	ChannelTryOpen ChannelState = 0x02
+	ChannelOpen    ChannelState = 0x03
+)
+
+func (s ChannelState) String() string {
+	switch s {
+	case ChannelInit:
+		return "INIT"
+	case ChannelTryOpen:
+		return "TRYOPEN"
+	case ChannelOpen:
+		return "OPEN"
+	default:
+		return ""
+	}
+}
+
+// ChannelEnd is one side of an ordered channel built on an open connection.
+// Packets are sent and received on channels.
+/**
+通道的一端，建立在已打开的连接之上
+握手流程与连接相同: INIT -> TRYOPEN -> OPEN -> OPEN
+*/
+type ChannelEnd struct {
+	State                 ChannelState `json:"state"`
+	ConnectionID          string       `json:"connection_id"`           // connection the channel is built on
+	CounterpartyChannelID string       `json:"counterparty_channel_id"` // channel ID on the counterparty
+}
+
+// counterpartyEnd returns the channel end the counterparty is expected to
+// store in the given state
+func (c ChannelEnd) counterpartyEnd(chanID string, conn ConnectionEnd, state ChannelState) ChannelEnd {
+	return ChannelEnd{
+		State:                 state,
+		ConnectionID:          conn.CounterpartyConnectionID,
+		CounterpartyChannelID: chanID,
+	}
+}
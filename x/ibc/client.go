@@ -0,0 +1,119 @@
+package ibc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// ClientState tracks a Tendermint light client of a counterparty chain.
+/**
+对方链的轻客户端
+每次更新都会在 ConsensusStateKey(clientID, height) 下保存一个新的 ConsensusState
+*/
+type ClientState struct {
+	ChainID      string `json:"chain_id"`      // chain ID of the counterparty
+	LatestHeight int64  `json:"latest_height"` // height of the latest verified header
+}
+
+// ConsensusState is what a light client trusts about the counterparty at a
+// given height: the application hash committed by the header and the
+// validator set expected to sign the next one.
+/**
+轻客户端在某个高度上所信任的对方链状态
+Root 为该高度区块头中的 AppHash, 即对方链在 Height-1 高度提交后的 rootmulti 根哈希
+*/
+type ConsensusState struct {
+	ChainID          string                `json:"chain_id"`
+	Height           int64                 `json:"height"`
+	Root             []byte                `json:"root"`
+	NextValidatorSet *tmtypes.ValidatorSet `json:"next_validator_set"`
+}
+
+// NewConsensusState creates a consensus state from a trusted header
+func NewConsensusState(header Header) ConsensusState {
+	return ConsensusState{
+		ChainID:          header.SignedHeader.ChainID,
+		Height:           header.SignedHeader.Height,
+		Root:             header.SignedHeader.AppHash,
+		NextValidatorSet: header.NextValidatorSet,
+	}
+}
+
+// ValidateBasic performs stateless checks on a consensus state
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.ChainID == "" {
+		return errors.New("empty chain ID")
+	}
+	if cs.Height <= 0 {
+		return fmt.Errorf("invalid height %d", cs.Height)
+	}
+	if len(cs.Root) == 0 {
+		return errors.New("empty root")
+	}
+	if cs.NextValidatorSet == nil || cs.NextValidatorSet.Size() == 0 {
+		return errors.New("empty next validator set")
+	}
+	return nil
+}
+
+// CheckValidityAndUpdateState verifies a header against the consensus state
+// and returns the consensus state at the header's height.
+//
+// The header's commit must be signed by +2/3 of its own validator set and by
+// more than 2/3 of the trusted next validator set. Adjacent headers must
+// additionally be signed by exactly the trusted next validator set, which is
+// how validator set changes are followed.
+func (cs ConsensusState) CheckValidityAndUpdateState(header Header) (ConsensusState, error) {
+	if err := header.ValidateBasic(cs.ChainID); err != nil {
+		return ConsensusState{}, err
+	}
+
+	sh := header.SignedHeader
+	if sh.Height <= cs.Height {
+		return ConsensusState{}, fmt.Errorf("header height %d is not greater than the trusted height %d", sh.Height, cs.Height)
+	}
+	if sh.Height == cs.Height+1 && !bytes.Equal(sh.ValidatorsHash, cs.NextValidatorSet.Hash()) {
+		return ConsensusState{}, errors.New("validator set does not match the trusted next validator set")
+	}
+
+	// VerifyFutureCommit checks the commit against the header's validator set
+	// and that more than 2/3 of the trusted validators signed it as well
+	err := cs.NextValidatorSet.VerifyFutureCommit(header.ValidatorSet, cs.ChainID, sh.Commit.BlockID, sh.Height, sh.Commit)
+	if err != nil {
+		return ConsensusState{}, err
+	}
+
+	return NewConsensusState(header), nil
+}
+
+// Header is a signed header of the counterparty together with the validator
+// sets needed to verify it and the next one.
+type Header struct {
+	SignedHeader     tmtypes.SignedHeader  `json:"signed_header"`
+	ValidatorSet     *tmtypes.ValidatorSet `json:"validator_set"`
+	NextValidatorSet *tmtypes.ValidatorSet `json:"next_validator_set"`
+}
+
+// ValidateBasic checks that the header is well formed, belongs to the given
+// chain and commits to both of its validator sets
+func (h Header) ValidateBasic(chainID string) error {
+	if h.SignedHeader.Header == nil || h.SignedHeader.Commit == nil {
+		return errors.New("missing header or commit")
+	}
+	if err := h.SignedHeader.ValidateBasic(chainID); err != nil {
+		return err
+	}
+	if h.ValidatorSet == nil || h.NextValidatorSet == nil {
+		return errors.New("missing validator set")
+	}
+	if !bytes.Equal(h.SignedHeader.ValidatorsHash, h.ValidatorSet.Hash()) {
+		return errors.New("header does not commit to the validator set")
+	}
+	if !bytes.Equal(h.SignedHeader.NextValidatorsHash, h.NextValidatorSet.Hash()) {
+		return errors.New("header does not commit to the next validator set")
+	}
+	return nil
+}
@@ -0,0 +1,406 @@
+package cli
+
+import (
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/ibc"
+	ibcutils "my-cosmos/cosmos-sdk/x/ibc/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// flags
+const (
+	FlagCounterpartyNode = "counterparty-node"
+)
+
+// IBCHandshakeCmds returns the commands that create light clients and run the
+// connection and channel handshakes. Every command that needs a proof of the
+// counterparty state reads it, and the header to verify it against, from the
+// counterparty node.
+func IBCHandshakeCmds(cdc *codec.Codec) []*cobra.Command {
+	cmds := []*cobra.Command{
+		IBCCreateClientCmd(cdc),
+		IBCUpdateClientCmd(cdc),
+		IBCConnOpenInitCmd(cdc),
+		IBCConnOpenTryCmd(cdc),
+		IBCConnOpenAckCmd(cdc),
+		IBCConnOpenConfirmCmd(cdc),
+		IBCChanOpenInitCmd(cdc),
+		IBCChanOpenTryCmd(cdc),
+		IBCChanOpenAckCmd(cdc),
+		IBCChanOpenConfirmCmd(cdc),
+	}
+	return client.PostCommands(cmds...)
+}
+
+func newContexts(cdc *codec.Codec) (authtxb.TxBuilder, context.CLIContext, context.CLIContext) {
+	txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc)
+	cpCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithNodeURI(viper.GetString(FlagCounterpartyNode))
+	return txBldr, cliCtx, cpCtx
+}
+
+func addCounterpartyFlag(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(FlagCounterpartyNode, "tcp://localhost:36657", "<host>:<port> to tendermint rpc interface of the counterparty chain")
+	return cmd
+}
+
+// IBCCreateClientCmd creates a light client of the counterparty from its
+// latest header
+func IBCCreateClientCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-client [client-id]",
+		Short: "Create a light client of the counterparty chain, trusting its latest header",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+
+			header, err := ibcutils.QueryLatestHeader(cpCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.NewMsgCreateClient(args[0], ibc.NewConsensusState(header), cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// IBCUpdateClientCmd updates a light client to the latest header of the
+// counterparty
+func IBCUpdateClientCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-client [client-id]",
+		Short: "Update a light client with the latest header of the counterparty chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+
+			header, err := ibcutils.QueryLatestHeader(cpCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.NewMsgUpdateClient(args[0], header, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// IBCConnOpenInitCmd starts a connection handshake
+func IBCConnOpenInitCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "conn-open-init [connection-id] [client-id] [counterparty-connection-id] [counterparty-client-id]",
+		Short: "Start a connection handshake with the counterparty chain",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, _ := newContexts(cdc)
+
+			msg := ibc.MsgConnOpenInit{
+				ConnectionID:             args[0],
+				ClientID:                 args[1],
+				CounterpartyConnectionID: args[2],
+				CounterpartyClientID:     args[3],
+				Signer:                   cliCtx.GetFromAddress(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
+// IBCConnOpenTryCmd answers a connection handshake started on the
+// counterparty
+func IBCConnOpenTryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conn-open-try [connection-id] [client-id] [counterparty-connection-id] [counterparty-client-id]",
+		Short: "Answer a connection handshake started on the counterparty chain",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			msgs, proofHeight, err := ibcutils.UpdateClient(cliCtx, cpCtx, cdc, args[1], from)
+			if err != nil {
+				return err
+			}
+			_, proof, err := ibcutils.QueryProof(cpCtx, ibc.ConnectionKey(args[2]), proofHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.MsgConnOpenTry{
+				ConnectionID:             args[0],
+				ClientID:                 args[1],
+				CounterpartyConnectionID: args[2],
+				CounterpartyClientID:     args[3],
+				Proof:                    proof,
+				ProofHeight:              proofHeight,
+				Signer:                   from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// IBCConnOpenAckCmd opens a connection once the counterparty has answered
+func IBCConnOpenAckCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conn-open-ack [connection-id]",
+		Short: "Open a connection once the counterparty chain has answered the handshake",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			msgs, proof, proofHeight, err := proveConnection(cliCtx, cpCtx, cdc, args[0], from)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.MsgConnOpenAck{
+				ConnectionID: args[0],
+				Proof:        proof,
+				ProofHeight:  proofHeight,
+				Signer:       from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// IBCConnOpenConfirmCmd opens a connection once the counterparty has opened
+// its end
+func IBCConnOpenConfirmCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conn-open-confirm [connection-id]",
+		Short: "Open a connection once the counterparty chain has opened its end",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			msgs, proof, proofHeight, err := proveConnection(cliCtx, cpCtx, cdc, args[0], from)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.MsgConnOpenConfirm{
+				ConnectionID: args[0],
+				Proof:        proof,
+				ProofHeight:  proofHeight,
+				Signer:       from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// proveConnection proves the counterparty end of a local connection
+func proveConnection(cliCtx, cpCtx context.CLIContext, cdc *codec.Codec, connID string,
+	signer sdk.AccAddress) (msgs []sdk.Msg, proof *merkle.Proof, proofHeight int64, err error) {
+
+	var conn ibc.ConnectionEnd
+	if err = ibcutils.QueryStore(cliCtx, cdc, ibc.ConnectionKey(connID), &conn); err != nil {
+		return
+	}
+
+	msgs, proofHeight, err = ibcutils.UpdateClient(cliCtx, cpCtx, cdc, conn.ClientID, signer)
+	if err != nil {
+		return
+	}
+
+	_, proof, err = ibcutils.QueryProof(cpCtx, ibc.ConnectionKey(conn.CounterpartyConnectionID), proofHeight)
+	return
+}
+
+// IBCChanOpenInitCmd starts a channel handshake
+func IBCChanOpenInitCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "chan-open-init [channel-id] [connection-id] [counterparty-channel-id]",
+		Short: "Start a channel handshake on an open connection",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, _ := newContexts(cdc)
+
+			msg := ibc.MsgChanOpenInit{
+				ChannelID:             args[0],
+				ConnectionID:          args[1],
+				CounterpartyChannelID: args[2],
+				Signer:                cliCtx.GetFromAddress(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
+// IBCChanOpenTryCmd answers a channel handshake started on the counterparty
+func IBCChanOpenTryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chan-open-try [channel-id] [connection-id] [counterparty-channel-id]",
+		Short: "Answer a channel handshake started on the counterparty chain",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			var conn ibc.ConnectionEnd
+			if err := ibcutils.QueryStore(cliCtx, cdc, ibc.ConnectionKey(args[1]), &conn); err != nil {
+				return err
+			}
+
+			msgs, proofHeight, err := ibcutils.UpdateClient(cliCtx, cpCtx, cdc, conn.ClientID, from)
+			if err != nil {
+				return err
+			}
+			_, proof, err := ibcutils.QueryProof(cpCtx, ibc.ChannelKey(args[2]), proofHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.MsgChanOpenTry{
+				ChannelID:             args[0],
+				ConnectionID:          args[1],
+				CounterpartyChannelID: args[2],
+				Proof:                 proof,
+				ProofHeight:           proofHeight,
+				Signer:                from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// IBCChanOpenAckCmd opens a channel once the counterparty has answered
+func IBCChanOpenAckCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chan-open-ack [channel-id]",
+		Short: "Open a channel once the counterparty chain has answered the handshake",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			msgs, proof, proofHeight, err := proveChannel(cliCtx, cpCtx, cdc, args[0], from)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.MsgChanOpenAck{
+				ChannelID:   args[0],
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				Signer:      from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// IBCChanOpenConfirmCmd opens a channel once the counterparty has opened its
+// end
+func IBCChanOpenConfirmCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chan-open-confirm [channel-id]",
+		Short: "Open a channel once the counterparty chain has opened its end",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			msgs, proof, proofHeight, err := proveChannel(cliCtx, cpCtx, cdc, args[0], from)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.MsgChanOpenConfirm{
+				ChannelID:   args[0],
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				Signer:      from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// proveChannel proves the counterparty end of a local channel
+func proveChannel(cliCtx, cpCtx context.CLIContext, cdc *codec.Codec, chanID string,
+	signer sdk.AccAddress) (msgs []sdk.Msg, proof *merkle.Proof, proofHeight int64, err error) {
+
+	var channel ibc.ChannelEnd
+	if err = ibcutils.QueryStore(cliCtx, cdc, ibc.ChannelKey(chanID), &channel); err != nil {
+		return
+	}
+
+	var conn ibc.ConnectionEnd
+	if err = ibcutils.QueryStore(cliCtx, cdc, ibc.ConnectionKey(channel.ConnectionID), &conn); err != nil {
+		return
+	}
+
+	msgs, proofHeight, err = ibcutils.UpdateClient(cliCtx, cpCtx, cdc, conn.ClientID, signer)
+	if err != nil {
+		return
+	}
+
+	_, proof, err = ibcutils.QueryProof(cpCtx, ibc.ChannelKey(channel.CounterpartyChannelID), proofHeight)
+	return
+}
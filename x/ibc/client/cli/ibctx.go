@@ -3,7 +3,6 @@ package cli
 import (
 	"encoding/hex"
 
-	"my-cosmos/cosmos-sdk/client"
 	"my-cosmos/cosmos-sdk/client/context"
 	"my-cosmos/cosmos-sdk/client/utils"
 	"my-cosmos/cosmos-sdk/codec"
@@ -16,15 +15,17 @@ import (
 )
 
 const (
-	flagTo     = "to"
-	flagAmount = "amount"
-	flagChain  = "chain"
+	flagTo      = "to"
+	flagAmount  = "amount"
+	flagChannel = "channel"
+	flagTimeout = "timeout-height"
 )
 
 // IBCTransferCmd implements the IBC transfer command.
 func IBCTransferCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "transfer",
+		Use:   "transfer",
+		Short: "Transfer coins to an address on the counterparty chain of an open channel",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().
@@ -43,7 +44,8 @@ func IBCTransferCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagTo, "", "Address to send coins")
 	cmd.Flags().String(flagAmount, "", "Amount of coins to send")
-	cmd.Flags().String(flagChain, "", "Destination chain to send coins")
+	cmd.Flags().String(flagChannel, "", "Open channel to the destination chain")
+	cmd.Flags().Int64(flagTimeout, 0, "Height of the destination chain from which the coins are refunded instead of delivered")
 
 	return cmd
 }
@@ -62,11 +64,9 @@ func buildMsg(from sdk.AccAddress) (sdk.Msg, error) {
 	}
 	to := sdk.AccAddress(bz)
 
-	packet := ibc.NewIBCPacket(from, to, coins, viper.GetString(client.FlagChainID),
-		viper.GetString(flagChain))
-
-	msg := ibc.MsgIBCTransfer{
-		IBCPacket: packet,
+	msg := ibc.NewMsgIBCTransfer(viper.GetString(flagChannel), from, to, coins, viper.GetInt64(flagTimeout))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
 	}
 
 	return msg, nil
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/ibc"
+	ibcutils "my-cosmos/cosmos-sdk/x/ibc/client/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// IBCPacketCmds returns the commands that complete a sent packet, either with
+// the acknowledgement of the counterparty or by refunding it after it timed
+// out
+func IBCPacketCmds(cdc *codec.Codec) []*cobra.Command {
+	cmds := []*cobra.Command{
+		IBCAcknowledgePacketCmd(cdc),
+		IBCTimeoutPacketCmd(cdc),
+	}
+	return client.PostCommands(cmds...)
+}
+
+// IBCAcknowledgePacketCmd relays the acknowledgement of a sent packet back
+// from the counterparty
+func IBCAcknowledgePacketCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-ack [channel-id] [sequence]",
+		Short: "Complete a sent packet with its acknowledgement, refunding it if the counterparty chain did not execute it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			packet, clientID, err := queryPendingPacket(cliCtx, cdc, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msgs, proofHeight, err := ibcutils.UpdateClient(cliCtx, cpCtx, cdc, clientID, from)
+			if err != nil {
+				return err
+			}
+			bz, proof, err := ibcutils.QueryProof(cpCtx, ibc.AcknowledgementKey(packet.DestChannel, packet.Sequence), proofHeight)
+			if err != nil {
+				return err
+			}
+
+			var ack ibc.IBCAcknowledgement
+			if err := cdc.UnmarshalBinaryLengthPrefixed(bz, &ack); err != nil {
+				return err
+			}
+
+			msg := ibc.MsgIBCAcknowledgement{
+				IBCPacket:       packet,
+				Acknowledgement: ack,
+				Proof:           proof,
+				ProofHeight:     proofHeight,
+				Relayer:         from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// IBCTimeoutPacketCmd refunds a sent packet that the counterparty did not
+// receive before its timeout height
+func IBCTimeoutPacketCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-timeout [channel-id] [sequence]",
+		Short: "Refund a sent packet that the counterparty chain did not receive before its timeout height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr, cliCtx, cpCtx := newContexts(cdc)
+			from := cliCtx.GetFromAddress()
+
+			packet, clientID, err := queryPendingPacket(cliCtx, cdc, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msgs, proofHeight, err := ibcutils.UpdateClient(cliCtx, cpCtx, cdc, clientID, from)
+			if err != nil {
+				return err
+			}
+			if !packet.TimedOut(proofHeight) {
+				return fmt.Errorf("packet times out at height %d, the counterparty is at height %d",
+					packet.TimeoutHeight, proofHeight)
+			}
+			proof, err := ibcutils.QueryAbsenceProof(cpCtx, ibc.AcknowledgementKey(packet.DestChannel, packet.Sequence), proofHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := ibc.MsgIBCTimeout{
+				IBCPacket:   packet,
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				Relayer:     from,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, append(msgs, msg), false)
+		},
+	}
+	return addCounterpartyFlag(cmd)
+}
+
+// queryPendingPacket returns a packet sent on a local channel that is neither
+// acknowledged nor refunded yet, and the client of the counterparty chain
+func queryPendingPacket(cliCtx context.CLIContext, cdc *codec.Codec, chanID, seqStr string) (
+	packet ibc.IBCPacket, clientID string, err error) {
+
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return
+	}
+
+	if err = ibcutils.QueryStore(cliCtx, cdc, ibc.PacketCommitmentKey(chanID, seq), &packet); err != nil {
+		return
+	}
+
+	var channel ibc.ChannelEnd
+	if err = ibcutils.QueryStore(cliCtx, cdc, ibc.ChannelKey(chanID), &channel); err != nil {
+		return
+	}
+
+	var conn ibc.ConnectionEnd
+	if err = ibcutils.QueryStore(cliCtx, cdc, ibc.ConnectionKey(channel.ConnectionID), &conn); err != nil {
+		return
+	}
+
+	return packet, conn.ClientID, nil
+}
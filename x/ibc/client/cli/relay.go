@@ -1,210 +1,143 @@
 package cli
 
 import (
+	"context"
+	"fmt"
 	"os"
-	"time"
-
-	"my-cosmos/cosmos-sdk/client/utils"
-
-	bam "my-cosmos/cosmos-sdk/baseapp"
-	"my-cosmos/cosmos-sdk/client/context"
-	"my-cosmos/cosmos-sdk/client/keys"
-	"my-cosmos/cosmos-sdk/codec"
-	sdk "my-cosmos/cosmos-sdk/types"
-	"my-cosmos/cosmos-sdk/x/auth"
-	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
-	"my-cosmos/cosmos-sdk/x/ibc"
+	"os/signal"
+	"path/filepath"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/client/keys"
+	"my-cosmos/cosmos-sdk/codec"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/ibc/client/relayer"
 )
 
 // flags
 const (
-	FlagFromChainID   = "from-chain-id"
-	FlagFromChainNode = "from-chain-node"
-	FlagToChainID     = "to-chain-id"
-	FlagToChainNode   = "to-chain-node"
+	FlagChainAID      = "chain-a-id"
+	FlagChainANode    = "chain-a-node"
+	FlagChainAKey     = "chain-a-key"
+	FlagChainBID      = "chain-b-id"
+	FlagChainBNode    = "chain-b-node"
+	FlagChainBKey     = "chain-b-key"
+	FlagStateFile     = "state-file"
+	FlagMaxMsgs       = "max-msgs"
+	FlagPollInterval  = "poll-interval"
+	FlagMaxBackoff    = "max-backoff"
+	FlagMetricsListen = "metrics-listen-addr"
 )
 
-type relayCommander struct {
-	cdc       *codec.Codec
-	address   sdk.AccAddress
-	decoder   auth.AccountDecoder
-	mainStore string
-	ibcStore  string
-	accStore  string
-
-	logger log.Logger
-}
+// transactions of the relayer that run out of gas are only retried after a
+// backoff, so their gas estimates leave some room
+const defaultGasAdjustment = 1.5
 
-// IBCRelayCmd implements the IBC relay command.
+// IBCRelayCmd runs a relayer between the ends of a channel
 func IBCRelayCmd(cdc *codec.Codec) *cobra.Command {
-	cmdr := relayCommander{
-		cdc:       cdc,
-		decoder:   context.GetAccountDecoder(cdc),
-		ibcStore:  "ibc",
-		mainStore: bam.MainStoreKey,
-		accStore:  auth.StoreKey,
-
-		logger: log.NewTMLogger(log.NewSyncWriter(os.Stdout)),
-	}
-
 	cmd := &cobra.Command{
-		Use: "relay",
-		Run: cmdr.runIBCRelay,
-	}
-
-	cmd.Flags().String(FlagFromChainID, "", "Chain ID for ibc node to check outgoing packets")
-	cmd.Flags().String(FlagFromChainNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
-	cmd.Flags().String(FlagToChainID, "", "Chain ID for ibc node to broadcast incoming packets")
-	cmd.Flags().String(FlagToChainNode, "tcp://localhost:36657", "<host>:<port> to tendermint rpc interface for this chain")
-
-	cmd.MarkFlagRequired(FlagFromChainID)
-	cmd.MarkFlagRequired(FlagFromChainNode)
-	cmd.MarkFlagRequired(FlagToChainID)
-	cmd.MarkFlagRequired(FlagToChainNode)
-
-	viper.BindPFlag(FlagFromChainID, cmd.Flags().Lookup(FlagFromChainID))
-	viper.BindPFlag(FlagFromChainNode, cmd.Flags().Lookup(FlagFromChainNode))
-	viper.BindPFlag(FlagToChainID, cmd.Flags().Lookup(FlagToChainID))
-	viper.BindPFlag(FlagToChainNode, cmd.Flags().Lookup(FlagToChainNode))
-
-	return cmd
-}
-
-// nolint: unparam
-func (c relayCommander) runIBCRelay(cmd *cobra.Command, args []string) {
-	fromChainID := viper.GetString(FlagFromChainID)
-	fromChainNode := viper.GetString(FlagFromChainNode)
-	toChainID := viper.GetString(FlagToChainID)
-	toChainNode := viper.GetString(FlagToChainNode)
-
-	address := context.NewCLIContext().GetFromAddress()
-	c.address = address
-
-	c.loop(fromChainID, fromChainNode, toChainID, toChainNode)
-}
-
-// This is nolinted as someone is in the process of refactoring this to remove the goto
-func (c relayCommander) loop(fromChainID, fromChainNode, toChainID, toChainNode string) {
-	cliCtx := context.NewCLIContext()
-
-	name := cliCtx.GetFromName()
-	passphrase, err := keys.ReadPassphraseFromStdin(name)
-	if err != nil {
-		panic(err)
-	}
-
-	ingressKey := ibc.IngressSequenceKey(fromChainID)
-	lengthKey := ibc.EgressLengthKey(toChainID)
-
-OUTER:
-	for {
-		time.Sleep(5 * time.Second)
-
-		processedbz, err := query(toChainNode, ingressKey, c.ibcStore)
-		if err != nil {
-			panic(err)
-		}
-
-		var processed uint64
-		if processedbz == nil {
-			processed = 0
-		} else if err = c.cdc.UnmarshalBinaryLengthPrefixed(processedbz, &processed); err != nil {
-			panic(err)
-		}
-
-		egressLengthbz, err := query(fromChainNode, lengthKey, c.ibcStore)
-		if err != nil {
-			c.logger.Error("error querying outgoing packet list length", "err", err)
-			continue OUTER // TODO replace with continue (I think it should just to the correct place where OUTER is now)
-		}
-
-		var egressLength uint64
-		if egressLengthbz == nil {
-			egressLength = 0
-		} else if err = c.cdc.UnmarshalBinaryLengthPrefixed(egressLengthbz, &egressLength); err != nil {
-			panic(err)
-		}
-
-		if egressLength > processed {
-			c.logger.Info("Detected IBC packet", "number", egressLength-1)
-		}
-
-		seq := c.getSequence(toChainNode)
-
-		for i := processed; i < egressLength; i++ {
-			egressbz, err := query(fromChainNode, ibc.EgressKey(toChainID, i), c.ibcStore)
+		Use:   "relay",
+		Short: "Relay the packets and acknowledgements of a channel between two chains",
+		Long: `Relay the packets and acknowledgements of a channel between two chains until
+stopped. The relayer follows both chains over websocket, and whenever either
+commits a block it relays what is pending in one transaction per chain. The
+transactions sent to each chain are signed with a key of their own.
+
+The packets to relay are read from the chains, which only move on once the
+transactions of the relayer are committed. The first packets of each end found
+completed are saved to a state file, so that a restarted relayer does not scan
+them again. The passphrases of both keys are read from stdin.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+
+			txBldr := authtxb.NewTxBuilderFromCLI()
+			a, err := newRelayerChain(cdc, txBldr, FlagChainAID, FlagChainANode, FlagChainAKey, viper.GetString(flagChannel))
 			if err != nil {
-				c.logger.Error("error querying egress packet", "err", err)
-				continue OUTER // TODO replace to break, will break first loop then send back to the beginning (aka OUTER)
+				return err
 			}
-
-			err = c.broadcastTx(toChainNode, c.refine(egressbz, i, seq, passphrase))
-
-			seq++
-
+			b, err := newRelayerChain(cdc, txBldr, FlagChainBID, FlagChainBNode, FlagChainBKey, "")
 			if err != nil {
-				c.logger.Error("error broadcasting ingress packet", "err", err)
-				continue OUTER // TODO replace to break, will break first loop then send back to the beginning (aka OUTER)
+				return err
 			}
 
-			c.logger.Info("Relayed IBC packet", "number", i)
-		}
-	}
-}
-
-func query(node string, key []byte, storeName string) (res []byte, err error) {
-	return context.NewCLIContext().WithNodeURI(node).QueryStore(key, storeName)
-}
+			statePath := viper.GetString(FlagStateFile)
+			if statePath == "" {
+				statePath = filepath.Join(viper.GetString(cli.HomeFlag), "relayer",
+					fmt.Sprintf("%s_%s.json", a.ChainID, a.ChannelID))
+			}
+			state, err := relayer.LoadState(statePath)
+			if err != nil {
+				return err
+			}
 
-// nolint: unparam
-func (c relayCommander) broadcastTx(node string, tx []byte) error {
-	_, err := context.NewCLIContext().WithNodeURI(node).BroadcastTx(tx)
-	return err
-}
+			metrics := relayer.NopMetrics()
+			if addr := viper.GetString(FlagMetricsListen); addr != "" {
+				metrics = relayer.PrometheusMetrics("gaiacli")
+				go func() {
+					if err := relayer.ServeMetrics(addr); err != nil {
+						logger.Error("Failed to serve metrics", "err", err)
+					}
+				}()
+			}
 
-func (c relayCommander) getSequence(node string) uint64 {
-	res, err := query(node, auth.AddressStoreKey(c.address), c.accStore)
-	if err != nil {
-		panic(err)
+			config := relayer.DefaultConfig()
+			config.MaxMsgs = viper.GetInt(FlagMaxMsgs)
+			config.PollInterval = viper.GetDuration(FlagPollInterval)
+			config.MaxBackoff = viper.GetDuration(FlagMaxBackoff)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				sigs := make(chan os.Signal, 1)
+				signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+				<-sigs
+				cancel()
+			}()
+
+			return relayer.NewRelayer(cdc, a, b, state, config, metrics, logger).Run(ctx)
+		},
 	}
 
-	if nil != res {
-		account, err := c.decoder(res)
-		if err != nil {
-			panic(err)
-		}
-
-		return account.GetSequence()
+	defaults := relayer.DefaultConfig()
+	cmd.Flags().String(FlagChainAID, "", "Chain ID of the first chain")
+	cmd.Flags().String(FlagChainANode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface of the first chain")
+	cmd.Flags().String(FlagChainAKey, "", "Name of the key that signs the transactions sent to the first chain")
+	cmd.Flags().String(FlagChainBID, "", "Chain ID of the second chain")
+	cmd.Flags().String(FlagChainBNode, "tcp://localhost:36657", "<host>:<port> to tendermint rpc interface of the second chain")
+	cmd.Flags().String(FlagChainBKey, "", "Name of the key that signs the transactions sent to the second chain")
+	cmd.Flags().String(flagChannel, "", "Channel of the first chain to relay; the end on the second chain is looked up")
+	cmd.Flags().String(FlagStateFile, "", "File to save the completed sequences to (default <home>/relayer/<chain-a-id>_<channel>.json)")
+	cmd.Flags().Int(FlagMaxMsgs, defaults.MaxMsgs, "Maximum number of packets and acknowledgements relayed in one transaction")
+	cmd.Flags().Duration(FlagPollInterval, defaults.PollInterval, "Interval to check the chains at in case block events are missed")
+	cmd.Flags().Duration(FlagMaxBackoff, defaults.MaxBackoff, "Maximum delay before retrying a failed relay")
+	cmd.Flags().String(FlagMetricsListen, "", "Address to serve prometheus metrics on, e.g. :26670 (disabled if empty)")
+	cmd.Flags().Float64(client.FlagGasAdjustment, defaultGasAdjustment, "Adjustment factor multiplied against the simulated gas of each transaction")
+	cmd.Flags().String(client.FlagGasPrices, "", "Gas prices to determine the transaction fees (e.g. 0.00001stake)")
+
+	for _, flag := range []string{FlagChainAID, FlagChainAKey, FlagChainBID, FlagChainBKey, flagChannel} {
+		cmd.MarkFlagRequired(flag)
 	}
-
-	return 0
+	return cmd
 }
 
-func (c relayCommander) refine(bz []byte, ibcSeq, accSeq uint64, passphrase string) []byte {
-	var packet ibc.IBCPacket
-	if err := c.cdc.UnmarshalBinaryLengthPrefixed(bz, &packet); err != nil {
-		panic(err)
-	}
-
-	msg := ibc.MsgIBCReceive{
-		IBCPacket: packet,
-		Relayer:   c.address,
-		Sequence:  ibcSeq,
-	}
-
-	txBldr := authtxb.NewTxBuilderFromCLI().WithSequence(accSeq).WithTxEncoder(utils.GetTxEncoder(c.cdc))
-	cliCtx := context.NewCLIContext()
+// newRelayerChain returns the channel end described by the given flags,
+// reading the passphrase of its key from stdin
+func newRelayerChain(cdc *codec.Codec, txBldr authtxb.TxBuilder, idFlag, nodeFlag, keyFlag, chanID string) (
+	*relayer.Chain, error) {
 
-	name := cliCtx.GetFromName()
-	res, err := txBldr.BuildAndSign(name, passphrase, []sdk.Msg{msg})
+	name := viper.GetString(keyFlag)
+	passphrase, err := keys.ReadPassphraseFromStdin(name)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	return res
+	return relayer.NewChain(cdc, viper.GetString(idFlag), viper.GetString(nodeFlag), chanID,
+		txBldr, name, passphrase)
 }
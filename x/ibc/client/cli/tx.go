@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/ibc"
+)
+
+// GetTxCmd returns the transaction commands for this module, and the relayer
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	ibcTxCmd := &cobra.Command{
+		Use:   ibc.ModuleName,
+		Short: "IBC transactions subcommands",
+	}
+
+	ibcTxCmd.AddCommand(client.PostCommands(IBCTransferCmd(cdc))...)
+	ibcTxCmd.AddCommand(client.LineBreak)
+	ibcTxCmd.AddCommand(IBCHandshakeCmds(cdc)...)
+	ibcTxCmd.AddCommand(client.LineBreak)
+	ibcTxCmd.AddCommand(IBCPacketCmds(cdc)...)
+	ibcTxCmd.AddCommand(client.LineBreak)
+	ibcTxCmd.AddCommand(IBCRelayCmd(cdc))
+
+	return ibcTxCmd
+}
@@ -0,0 +1,44 @@
+package relayer
+
+import (
+	"sync"
+	"time"
+)
+
+// backoff doubles the delay before each retry of a failing operation, up to a
+// maximum, until it is reset by a success
+type backoff struct {
+	mtx   sync.Mutex
+	min   time.Duration
+	max   time.Duration
+	delay time.Duration
+}
+
+func newBackoff(min, max time.Duration) *backoff {
+	return &backoff{min: min, max: max}
+}
+
+// next returns the delay before the next retry
+func (b *backoff) next() time.Duration {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	switch {
+	case b.delay == 0:
+		b.delay = b.min
+	case b.delay < b.max:
+		b.delay *= 2
+	}
+	if b.delay > b.max {
+		b.delay = b.max
+	}
+	return b.delay
+}
+
+// reset starts over with the minimum delay
+func (b *backoff) reset() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.delay = 0
+}
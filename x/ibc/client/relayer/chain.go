@@ -0,0 +1,160 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	clictx "my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+)
+
+// Chain is one end of the channel a relayer serves: a node of the chain to
+// read from and send transactions to, and the key that signs them
+type Chain struct {
+	ChainID   string
+	ChannelID string
+
+	nodeURI    string
+	cliCtx     clictx.CLIContext
+	txBldr     authtxb.TxBuilder
+	keyName    string
+	passphrase string
+}
+
+// NewChain returns an end of a channel. Transactions are signed with a key of
+// the keybase of txBldr, whose fees and gas adjustment they use.
+func NewChain(cdc *codec.Codec, chainID, nodeURI, chanID string, txBldr authtxb.TxBuilder,
+	keyName, passphrase string) (*Chain, error) {
+
+	if txBldr.Keybase() == nil {
+		return nil, errors.New("the transaction builder has no keybase to sign with")
+	}
+	info, err := txBldr.Keybase().Get(keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the light clients on chain verify everything relayed from one chain to
+	// the other, so the relayer can trust the nodes it reads from
+	cliCtx := clictx.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc).
+		WithNodeURI(nodeURI).
+		WithTrustNode(true).
+		WithFromName(keyName).
+		WithFromAddress(info.GetAddress())
+
+	return &Chain{
+		ChainID:    chainID,
+		ChannelID:  chanID,
+		nodeURI:    nodeURI,
+		cliCtx:     cliCtx,
+		txBldr:     txBldr.WithChainID(chainID).WithTxEncoder(utils.GetTxEncoder(cdc)),
+		keyName:    keyName,
+		passphrase: passphrase,
+	}, nil
+}
+
+// Address returns the account that signs the transactions of the relayer
+func (c *Chain) Address() sdk.AccAddress {
+	return c.cliCtx.GetFromAddress()
+}
+
+// latestHeight returns the height of the latest block of the chain
+func (c *Chain) latestHeight() (int64, error) {
+	node, err := c.cliCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	status, err := node.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// sendMsgs sends the messages in a single transaction and waits for it to be
+// committed. The gas of the transaction is estimated by simulating it, and the
+// account number and sequence are read from the chain every time, so that the
+// key can be shared with other clients.
+func (c *Chain) sendMsgs(msgs []sdk.Msg) (gas uint64, err error) {
+	txBldr, err := utils.PrepareTxBuilder(c.txBldr, c.cliCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	txBldr, err = utils.EnrichWithGas(txBldr, c.cliCtx, msgs)
+	if err != nil {
+		return 0, err
+	}
+
+	txBytes, err := txBldr.BuildAndSign(c.keyName, c.passphrase, msgs)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = c.cliCtx.BroadcastTxAndAwaitCommit(txBytes)
+	return txBldr.Gas(), err
+}
+
+// follow signals wake whenever the chain commits a block, until ctx is done.
+// The websocket subscription is opened again with backoff when it is lost.
+func (c *Chain) follow(ctx context.Context, wake chan<- struct{}, bo *backoff, logger log.Logger) {
+	for {
+		err := c.subscribe(ctx, wake, bo)
+		if ctx.Err() != nil {
+			return
+		}
+
+		delay := bo.next()
+		logger.Error("Lost the block subscription", "chain", c.ChainID, "err", err, "retry", delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (c *Chain) subscribe(ctx context.Context, wake chan<- struct{}, bo *backoff) error {
+	// the subscription does not survive a reconnection of the client, so
+	// follow opens a new one instead
+	ws := rpcclient.NewWSClient(c.nodeURI, "/websocket", rpcclient.MaxReconnectAttempts(0))
+	if err := ws.Start(); err != nil {
+		return err
+	}
+	defer ws.Stop() // nolint: errcheck
+
+	if err := ws.Subscribe(ctx, tmtypes.EventQueryNewBlockHeader.String()); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ws.Quit():
+			return errors.New("websocket connection closed")
+		case res := <-ws.ResponsesCh:
+			if res.Error != nil {
+				return res.Error
+			}
+			bo.reset()
+
+			// wake up the relayer once for any number of blocks committed
+			// while it was busy
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package relayer
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsSubsystem is the subsystem label of the relayer metrics
+const MetricsSubsystem = "ibc_relayer"
+
+// Metrics of a relayer. Every metric is labelled with the chain it describes.
+type Metrics struct {
+	// Height of the latest block seen on a chain
+	Height metrics.Gauge
+	// Number of packets relayed to a chain, by message type
+	Packets metrics.Counter
+	// Number of packets sent to a chain that wait to be received
+	PendingPackets metrics.Gauge
+	// Number of transactions committed on a chain
+	Txs metrics.Counter
+	// Number of transactions that failed on a chain
+	FailedTxs metrics.Counter
+	// Gas wanted by the transactions sent to a chain
+	GasWanted metrics.Histogram
+}
+
+// PrometheusMetrics returns metrics reported to prometheus
+func PrometheusMetrics(namespace string) *Metrics {
+	labels := []string{"chain_id"}
+	return &Metrics{
+		Height: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "height",
+			Help:      "Height of the latest block seen on the chain.",
+		}, labels),
+		Packets: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "packets",
+			Help:      "Number of packets relayed to the chain.",
+		}, append(labels, "type")),
+		PendingPackets: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pending_packets",
+			Help:      "Number of packets sent to the chain that wait to be received.",
+		}, labels),
+		Txs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "txs",
+			Help:      "Number of transactions committed on the chain.",
+		}, labels),
+		FailedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "failed_txs",
+			Help:      "Number of transactions that failed on the chain.",
+		}, labels),
+		GasWanted: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gas_wanted",
+			Help:      "Gas wanted by the transactions sent to the chain.",
+			Buckets:   stdprometheus.ExponentialBuckets(50000, 2, 8),
+		}, labels),
+	}
+}
+
+// NopMetrics returns metrics that are discarded
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Height:         discard.NewGauge(),
+		Packets:        discard.NewCounter(),
+		PendingPackets: discard.NewGauge(),
+		Txs:            discard.NewCounter(),
+		FailedTxs:      discard.NewCounter(),
+		GasWanted:      discard.NewHistogram(),
+	}
+}
+
+// ServeMetrics serves the prometheus metrics on addr until the server fails
+func ServeMetrics(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return http.ListenAndServe(addr, mux)
+}
@@ -0,0 +1,337 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/ibc"
+	ibcutils "my-cosmos/cosmos-sdk/x/ibc/client/utils"
+)
+
+// A relayer serves one channel between two chains. Whenever either chain
+// commits a block it relays, in a single transaction per chain:
+//
+// - the packets the counterparty sent that the chain has not received yet,
+//   in order, and
+// - the acknowledgements the counterparty wrote for packets the chain sent,
+//   which complete the packets and refund the failed ones,
+//
+// behind the client update their proofs are checked against.
+//
+// The relayer never times packets out. The receiving chain acknowledges a
+// packet that arrives after its timeout height as failed, which refunds it
+// just the same and keeps the ordered channel going. A timeout is only needed
+// when the counterparty stops, and can be sent with the packet-timeout command.
+
+// Config of a relayer
+type Config struct {
+	// MaxMsgs is the largest number of packets and acknowledgements relayed
+	// in one transaction
+	MaxMsgs int
+
+	// PollInterval is how often the chains are checked when no new block is
+	// heard of, in case the websocket subscriptions missed some
+	PollInterval time.Duration
+
+	// MinBackoff and MaxBackoff bound the delay before retrying a failure
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultConfig returns the default relayer config
+func DefaultConfig() Config {
+	return Config{
+		MaxMsgs:      20,
+		PollInterval: 30 * time.Second,
+		MinBackoff:   time.Second,
+		MaxBackoff:   time.Minute,
+	}
+}
+
+// Relayer relays packets and acknowledgements between the ends of a channel
+type Relayer struct {
+	cdc     *codec.Codec
+	a, b    *Chain
+	config  Config
+	state   *State
+	metrics *Metrics
+	logger  log.Logger
+}
+
+// NewRelayer returns a relayer between two ends of a channel. The channel of
+// chain b is looked up on chain a when the relayer starts.
+func NewRelayer(cdc *codec.Codec, a, b *Chain, state *State, config Config,
+	metrics *Metrics, logger log.Logger) *Relayer {
+
+	return &Relayer{
+		cdc:     cdc,
+		a:       a,
+		b:       b,
+		config:  config,
+		state:   state,
+		metrics: metrics,
+		logger:  logger,
+	}
+}
+
+// Run relays between the chains until ctx is done
+func (r *Relayer) Run(ctx context.Context) error {
+	if err := r.resolveChannel(); err != nil {
+		return err
+	}
+	r.logger.Info("Relaying IBC packets",
+		"chain_a", r.a.ChainID, "channel_a", r.a.ChannelID,
+		"chain_b", r.b.ChainID, "channel_b", r.b.ChannelID)
+
+	wake := make(chan struct{}, 1)
+	go r.a.follow(ctx, wake, newBackoff(r.config.MinBackoff, r.config.MaxBackoff), r.logger)
+	go r.b.follow(ctx, wake, newBackoff(r.config.MinBackoff, r.config.MaxBackoff), r.logger)
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	bo := newBackoff(r.config.MinBackoff, r.config.MaxBackoff)
+	for {
+		var retry <-chan time.Time
+		if err := r.relay(); err != nil {
+			delay := bo.next()
+			r.logger.Error("Failed to relay IBC packets", "err", err, "retry", delay)
+			retry = time.After(delay)
+		} else {
+			bo.reset()
+		}
+
+		// a failed round is retried after its backoff, whatever happens on
+		// the chains in the meantime
+		if retry != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-retry:
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// resolveChannel looks up the counterparty end of the channel of chain a
+func (r *Relayer) resolveChannel() error {
+	var channel ibc.ChannelEnd
+	if err := ibcutils.QueryStore(r.a.cliCtx, r.cdc, ibc.ChannelKey(r.a.ChannelID), &channel); err != nil {
+		return err
+	}
+
+	if r.b.ChannelID == "" {
+		r.b.ChannelID = channel.CounterpartyChannelID
+	}
+	if r.b.ChannelID != channel.CounterpartyChannelID {
+		return fmt.Errorf("channel %s of %s leads to channel %s, not %s",
+			r.a.ChannelID, r.a.ChainID, channel.CounterpartyChannelID, r.b.ChannelID)
+	}
+	return nil
+}
+
+// relay relays to both chains and saves the packets found completed
+func (r *Relayer) relay() error {
+	errA := r.relayTo(r.a, r.b)
+	errB := r.relayTo(r.b, r.a)
+
+	if err := r.state.Save(); err != nil {
+		return err
+	}
+	if errA != nil {
+		return errA
+	}
+	return errB
+}
+
+// relayTo relays the packets and acknowledgements of the counterparty to a
+// chain
+func (r *Relayer) relayTo(dst, src *Chain) error {
+	end := r.state.End(dst.ChainID, dst.ChannelID)
+
+	height, err := dst.latestHeight()
+	if err != nil {
+		return err
+	}
+	r.metrics.Height.With("chain_id", dst.ChainID).Set(float64(height))
+
+	var channel ibc.ChannelEnd
+	if err := ibcutils.QueryStore(dst.cliCtx, r.cdc, ibc.ChannelKey(dst.ChannelID), &channel); err != nil {
+		return err
+	}
+	if channel.State != ibc.ChannelOpen {
+		r.logger.Debug("Waiting for the channel to open", "chain", dst.ChainID, "channel", dst.ChannelID)
+		return nil
+	}
+
+	var conn ibc.ConnectionEnd
+	if err := ibcutils.QueryStore(dst.cliCtx, r.cdc, ibc.ConnectionKey(channel.ConnectionID), &conn); err != nil {
+		return err
+	}
+
+	received, err := ibcutils.QuerySequence(dst.cliCtx, r.cdc, ibc.NextSequenceRecvKey(dst.ChannelID))
+	if err != nil {
+		return err
+	}
+	sent, err := ibcutils.QuerySequence(src.cliCtx, r.cdc, ibc.NextSequenceSendKey(src.ChannelID))
+	if err != nil {
+		return err
+	}
+	r.metrics.PendingPackets.With("chain_id", dst.ChainID).Set(float64(sent - received))
+
+	pending, err := r.skipCompleted(dst, end)
+	if err != nil {
+		return err
+	}
+	acked, err := r.hasAcknowledgement(src, end.CompletedSequence)
+	if err != nil {
+		return err
+	}
+	if received == sent && !acked {
+		return nil
+	}
+
+	update, proofHeight, err := ibcutils.UpdateClient(dst.cliCtx, src.cliCtx, r.cdc, conn.ClientID, dst.Address())
+	if err != nil {
+		return err
+	}
+
+	receives, err := r.receives(dst, src, received, sent, proofHeight, r.config.MaxMsgs)
+	if err != nil {
+		return err
+	}
+	acks, err := r.acknowledgements(dst, src, end.CompletedSequence, pending, proofHeight, r.config.MaxMsgs-len(receives))
+	if err != nil {
+		return err
+	}
+	if len(receives)+len(acks) == 0 {
+		return nil
+	}
+
+	msgs := append(append(update, receives...), acks...)
+	gas, err := dst.sendMsgs(msgs)
+	if err != nil {
+		r.metrics.FailedTxs.With("chain_id", dst.ChainID).Add(1)
+		return fmt.Errorf("failed to relay to %s: %v", dst.ChainID, err)
+	}
+	r.metrics.Txs.With("chain_id", dst.ChainID).Add(1)
+	r.metrics.GasWanted.With("chain_id", dst.ChainID).Observe(float64(gas))
+	r.metrics.Packets.With("chain_id", dst.ChainID, "type", "receive").Add(float64(len(receives)))
+	r.metrics.Packets.With("chain_id", dst.ChainID, "type", "acknowledgement").Add(float64(len(acks)))
+
+	r.logger.Info("Relayed IBC packets", "chain", dst.ChainID,
+		"received", len(receives), "acknowledged", len(acks), "gas", gas)
+	return nil
+}
+
+// skipCompleted moves the state past the first packets sent from a chain that
+// are no longer pending, and returns the sequence of the next packet the chain
+// will send. Packets of an ordered channel are acknowledged in order, so they
+// mostly complete in order too.
+func (r *Relayer) skipCompleted(c *Chain, end *EndState) (uint64, error) {
+	sent, err := ibcutils.QuerySequence(c.cliCtx, r.cdc, ibc.NextSequenceSendKey(c.ChannelID))
+	if err != nil {
+		return 0, err
+	}
+
+	for ; end.CompletedSequence < sent; end.CompletedSequence++ {
+		res, err := c.cliCtx.QueryStore(ibc.PacketCommitmentKey(c.ChannelID, end.CompletedSequence), ibc.StoreKey)
+		if err != nil {
+			return 0, err
+		}
+		if res != nil {
+			break
+		}
+	}
+	return sent, nil
+}
+
+// hasAcknowledgement reports whether the counterparty acknowledged a packet
+// sent to it
+func (r *Relayer) hasAcknowledgement(src *Chain, seq uint64) (bool, error) {
+	res, err := src.cliCtx.QueryStore(ibc.AcknowledgementKey(src.ChannelID, seq), ibc.StoreKey)
+	return res != nil, err
+}
+
+// receives returns the messages receiving the packets sent by the counterparty
+// from sequence from on, as far as they can be proven at proofHeight
+func (r *Relayer) receives(dst, src *Chain, from, to uint64, proofHeight int64, max int) ([]sdk.Msg, error) {
+	var msgs []sdk.Msg
+	for seq := from; seq < to && len(msgs) < max; seq++ {
+		bz, proof, err := ibcutils.QueryWithProof(src.cliCtx, ibc.PacketCommitmentKey(src.ChannelID, seq), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		// packets sent after the proof height are relayed in a later round
+		if bz == nil {
+			break
+		}
+
+		var packet ibc.IBCPacket
+		if err := r.cdc.UnmarshalBinaryLengthPrefixed(bz, &packet); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, ibc.MsgIBCReceive{
+			IBCPacket:   packet,
+			Proof:       proof,
+			ProofHeight: proofHeight,
+			Relayer:     dst.Address(),
+		})
+	}
+	return msgs, nil
+}
+
+// acknowledgements returns the messages completing the pending packets sent by
+// the chain from sequence from on with the acknowledgements of the
+// counterparty, as far as they can be proven at proofHeight
+func (r *Relayer) acknowledgements(dst, src *Chain, from, to uint64, proofHeight int64, max int) ([]sdk.Msg, error) {
+	var msgs []sdk.Msg
+	for seq := from; seq < to && len(msgs) < max; seq++ {
+		var packet ibc.IBCPacket
+		res, err := dst.cliCtx.QueryStore(ibc.PacketCommitmentKey(dst.ChannelID, seq), ibc.StoreKey)
+		if err != nil {
+			return nil, err
+		}
+		// timed out by someone else
+		if res == nil {
+			continue
+		}
+		if err := r.cdc.UnmarshalBinaryLengthPrefixed(res, &packet); err != nil {
+			return nil, err
+		}
+
+		bz, proof, err := ibcutils.QueryWithProof(src.cliCtx, ibc.AcknowledgementKey(packet.DestChannel, seq), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		if bz == nil {
+			break
+		}
+
+		var ack ibc.IBCAcknowledgement
+		if err := r.cdc.UnmarshalBinaryLengthPrefixed(bz, &ack); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, ibc.MsgIBCAcknowledgement{
+			IBCPacket:       packet,
+			Acknowledgement: ack,
+			Proof:           proof,
+			ProofHeight:     proofHeight,
+			Relayer:         dst.Address(),
+		})
+	}
+	return msgs, nil
+}
@@ -0,0 +1,81 @@
+package relayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// State is what a relayer remembers across restarts about the channel it
+// serves. The chains remain the authority on which packets were received and
+// completed: the packets to relay are read from the sequences of the chains,
+// which the relayer only moves on once its transactions are committed. The
+// state only spares a restarted relayer from scanning every packet ever sent
+// on the channel again for its acknowledgement.
+type State struct {
+	Ends map[string]*EndState `json:"ends"`
+
+	path string
+}
+
+// EndState tracks the packets sent from one end of the channel
+type EndState struct {
+	// CompletedSequence is the sequence of the first packet sent from this
+	// end that may still wait for an acknowledgement or a timeout. Every
+	// packet before it was completed.
+	CompletedSequence uint64 `json:"completed_sequence"`
+}
+
+// LoadState reads the state stored in a file, starting over if the file does
+// not exist yet
+func LoadState(path string) (*State, error) {
+	state := &State{Ends: make(map[string]*EndState), path: path}
+
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, fmt.Errorf("failed to parse relayer state %s: %v", path, err)
+	}
+	if state.Ends == nil {
+		state.Ends = make(map[string]*EndState)
+	}
+	return state, nil
+}
+
+// End returns the state of a channel end, identified by its chain and channel
+func (s *State) End(chainID, chanID string) *EndState {
+	key := fmt.Sprintf("%s/%s", chainID, chanID)
+	end, ok := s.Ends[key]
+	if !ok {
+		end = &EndState{}
+		s.Ends[key] = end
+	}
+	return end
+}
+
+// Save writes the state to its file. The file is replaced atomically so that
+// a relayer stopped while saving finds either the old or the new state.
+func (s *State) Save() error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
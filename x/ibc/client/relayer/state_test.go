@@ -0,0 +1,53 @@
+package relayer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "relayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "relayer", "state.json")
+
+	// a missing file starts over
+	state, err := LoadState(path)
+	require.NoError(t, err)
+	require.Equal(t, EndState{}, *state.End("chain-a", "chana"))
+
+	state.End("chain-a", "chana").CompletedSequence = 3
+	require.NoError(t, state.Save())
+
+	loaded, err := LoadState(path)
+	require.NoError(t, err)
+	require.Equal(t, EndState{CompletedSequence: 3}, *loaded.End("chain-a", "chana"))
+	require.Equal(t, EndState{}, *loaded.End("chain-b", "chanb"))
+
+	// the temporary file is renamed into place
+	_, err = os.Stat(path + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+	_, err = LoadState(path)
+	require.Error(t, err)
+}
+
+func TestBackoff(t *testing.T) {
+	bo := newBackoff(time.Second, 5*time.Second)
+
+	require.Equal(t, time.Second, bo.next())
+	require.Equal(t, 2*time.Second, bo.next())
+	require.Equal(t, 4*time.Second, bo.next())
+	require.Equal(t, 5*time.Second, bo.next())
+	require.Equal(t, 5*time.Second, bo.next())
+
+	bo.reset()
+	require.Equal(t, time.Second, bo.next())
+}
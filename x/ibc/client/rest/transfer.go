@@ -16,20 +16,21 @@ import (
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keys.Keybase) {
-	r.HandleFunc("/ibc/{destchain}/{address}/send", TransferRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
+	r.HandleFunc("/ibc/channels/{channel}/{address}/send", TransferRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
 }
 
 type transferReq struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Amount  sdk.Coins    `json:"amount"`
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Amount        sdk.Coins    `json:"amount"`
+	TimeoutHeight int64        `json:"timeout_height"`
 }
 
 // TransferRequestHandler - http request handler to transfer coins to a address
-// on a different chain via IBC.
+// on a different chain over an open IBC channel.
 func TransferRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		destChainID := vars["destchain"]
+		channel := vars["channel"]
 		bech32Addr := vars["address"]
 
 		to, err := sdk.AccAddressFromBech32(bech32Addr)
@@ -54,8 +55,11 @@ func TransferRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.
 			return
 		}
 
-		packet := ibc.NewIBCPacket(from, to, req.Amount, req.BaseReq.ChainID, destChainID)
-		msg := ibc.MsgIBCTransfer{IBCPacket: packet}
+		msg := ibc.NewMsgIBCTransfer(channel, from, to, req.Amount, req.TimeoutHeight)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/ibc"
+)
+
+// QueryHeader returns the header of the counterparty at the given height,
+// with the validator sets needed to verify it
+func QueryHeader(cliCtx context.CLIContext, height int64) (ibc.Header, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return ibc.Header{}, err
+	}
+
+	commit, err := node.Commit(&height)
+	if err != nil {
+		return ibc.Header{}, err
+	}
+
+	vals, err := node.Validators(&height)
+	if err != nil {
+		return ibc.Header{}, err
+	}
+
+	nextHeight := height + 1
+	nextVals, err := node.Validators(&nextHeight)
+	if err != nil {
+		return ibc.Header{}, err
+	}
+
+	return ibc.Header{
+		SignedHeader:     commit.SignedHeader,
+		ValidatorSet:     tmtypes.NewValidatorSet(vals.Validators),
+		NextValidatorSet: tmtypes.NewValidatorSet(nextVals.Validators),
+	}, nil
+}
+
+// QueryLatestHeader returns the most recent header of the counterparty that
+// can be verified. The validator set of the block after it must be known, so
+// this is the header before the latest block.
+func QueryLatestHeader(cliCtx context.CLIContext) (ibc.Header, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return ibc.Header{}, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return ibc.Header{}, err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight - 1
+	if height < 1 {
+		return ibc.Header{}, errors.New("counterparty has not produced enough blocks yet")
+	}
+	return QueryHeader(cliCtx, height)
+}
+
+// QueryProof returns the value stored under key in the IBC store of the
+// counterparty, with a merkle proof against the header at proofHeight
+func QueryProof(cliCtx context.CLIContext, key []byte, proofHeight int64) ([]byte, *merkle.Proof, error) {
+	value, proof, err := QueryWithProof(cliCtx, key, proofHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	if value == nil {
+		return nil, nil, fmt.Errorf("no value stored under %s at height %d", key, proofHeight-1)
+	}
+	return value, proof, nil
+}
+
+// QueryAbsenceProof returns a merkle proof against the header at proofHeight
+// that nothing is stored under key in the IBC store of the counterparty
+func QueryAbsenceProof(cliCtx context.CLIContext, key []byte, proofHeight int64) (*merkle.Proof, error) {
+	value, proof, err := QueryWithProof(cliCtx, key, proofHeight)
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		return nil, fmt.Errorf("a value is stored under %s at height %d", key, proofHeight-1)
+	}
+	return proof, nil
+}
+
+// QueryWithProof returns the value stored under key in the IBC store of the
+// counterparty, which is nil if there is none, with a merkle proof of either
+// against the header at proofHeight
+func QueryWithProof(cliCtx context.CLIContext, key []byte, proofHeight int64) ([]byte, *merkle.Proof, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the header at proofHeight commits to the state after block proofHeight-1
+	opts := rpcclient.ABCIQueryOptions{
+		Height: proofHeight - 1,
+		Prove:  true,
+	}
+	result, err := node.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", ibc.StoreKey), key, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return nil, nil, errors.New(resp.Log)
+	}
+	return resp.Value, resp.Proof, nil
+}
+
+// QueryStore returns a value of the local IBC store, failing if there is none
+func QueryStore(cliCtx context.CLIContext, cdc *codec.Codec, key []byte, ptr interface{}) error {
+	res, err := cliCtx.QueryStore(key, ibc.StoreKey)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return fmt.Errorf("no value stored under %s", key)
+	}
+	return cdc.UnmarshalBinaryLengthPrefixed(res, ptr)
+}
+
+// QuerySequence returns a packet sequence of the local IBC store, which is zero
+// until the first packet is sent or received
+func QuerySequence(cliCtx context.CLIContext, cdc *codec.Codec, key []byte) (uint64, error) {
+	res, err := cliCtx.QueryStore(key, ibc.StoreKey)
+	if err != nil {
+		return 0, err
+	}
+
+	var seq uint64
+	if res == nil {
+		return seq, nil
+	}
+	err = cdc.UnmarshalBinaryLengthPrefixed(res, &seq)
+	return seq, err
+}
+
+// UpdateClient returns the messages that bring the local client of the
+// counterparty to its latest header, if it is behind, and the height proofs
+// must then be checked against
+func UpdateClient(cliCtx, cpCtx context.CLIContext, cdc *codec.Codec, clientID string,
+	signer sdk.AccAddress) ([]sdk.Msg, int64, error) {
+
+	var client ibc.ClientState
+	if err := QueryStore(cliCtx, cdc, ibc.ClientStateKey(clientID), &client); err != nil {
+		return nil, 0, err
+	}
+
+	header, err := QueryLatestHeader(cpCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if header.SignedHeader.Height <= client.LatestHeight {
+		return nil, client.LatestHeight, nil
+	}
+	return []sdk.Msg{ibc.NewMsgUpdateClient(clientID, header, signer)}, header.SignedHeader.Height, nil
+}
@@ -0,0 +1,136 @@
+package ibc
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// makeValidators returns n validators of equal power together with their
+// private validators
+func makeValidators(n int) (*tmtypes.ValidatorSet, []tmtypes.PrivValidator) {
+	privVals := make([]tmtypes.PrivValidator, n)
+	for i := 0; i < n; i++ {
+		privVals[i] = tmtypes.NewMockPV()
+	}
+	return validatorSetOf(privVals), privVals
+}
+
+// validatorSetOf returns the validator set of the given private validators
+func validatorSetOf(privVals []tmtypes.PrivValidator) *tmtypes.ValidatorSet {
+	vals := make([]*tmtypes.Validator, len(privVals))
+	for i, pv := range privVals {
+		vals[i] = tmtypes.NewValidator(pv.GetPubKey(), 10)
+	}
+	return tmtypes.NewValidatorSet(vals)
+}
+
+// makeHeader returns a header of the given height committed by valSet. Every
+// validator of valSet must have its private validator in privVals.
+func makeHeader(chainID string, height int64, appHash []byte,
+	valSet, nextValSet *tmtypes.ValidatorSet, privVals []tmtypes.PrivValidator) Header {
+
+	tmHeader := tmtypes.Header{
+		ChainID:            chainID,
+		Height:             height,
+		Time:               time.Now().UTC(),
+		AppHash:            appHash,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: nextValSet.Hash(),
+	}
+	blockID := tmtypes.BlockID{Hash: tmHeader.Hash()}
+
+	// MakeCommit expects the signers in the order of the validator set
+	signers := make([]tmtypes.PrivValidator, valSet.Size())
+	for i, val := range valSet.Validators {
+		for _, pv := range privVals {
+			if bytes.Equal(pv.GetPubKey().Address(), val.Address) {
+				signers[i] = pv
+			}
+		}
+	}
+
+	voteSet := tmtypes.NewVoteSet(chainID, height, 1, tmtypes.PrecommitType, valSet)
+	commit, err := tmtypes.MakeCommit(blockID, height, 1, voteSet, signers)
+	if err != nil {
+		panic(err)
+	}
+
+	return Header{
+		SignedHeader:     tmtypes.SignedHeader{Header: &tmHeader, Commit: commit},
+		ValidatorSet:     valSet,
+		NextValidatorSet: nextValSet,
+	}
+}
+
+func TestConsensusStateUpdate(t *testing.T) {
+	chainID := "chain-a"
+	valSet, privVals := makeValidators(4)
+
+	cs := NewConsensusState(makeHeader(chainID, 1, []byte("root-1"), valSet, valSet, privVals))
+	require.NoError(t, cs.ValidateBasic())
+
+	// adjacent header signed by the trusted validators
+	cs2, err := cs.CheckValidityAndUpdateState(makeHeader(chainID, 2, []byte("root-2"), valSet, valSet, privVals))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), cs2.Height)
+	require.Equal(t, []byte("root-2"), cs2.Root)
+
+	// headers must move forward
+	_, err = cs2.CheckValidityAndUpdateState(makeHeader(chainID, 2, []byte("root-2"), valSet, valSet, privVals))
+	require.Error(t, err)
+
+	// headers of another chain are rejected
+	_, err = cs2.CheckValidityAndUpdateState(makeHeader("chain-b", 3, []byte("root-3"), valSet, valSet, privVals))
+	require.Error(t, err)
+
+	// headers signed by an unknown validator set are rejected, adjacent or not
+	otherSet, otherPrivVals := makeValidators(4)
+	_, err = cs2.CheckValidityAndUpdateState(makeHeader(chainID, 3, []byte("root-3"), otherSet, otherSet, otherPrivVals))
+	require.Error(t, err)
+	_, err = cs2.CheckValidityAndUpdateState(makeHeader(chainID, 10, []byte("root-10"), otherSet, otherSet, otherPrivVals))
+	require.Error(t, err)
+
+	// the header must commit to the validator sets it carries
+	header := makeHeader(chainID, 3, []byte("root-3"), valSet, valSet, privVals)
+	header.NextValidatorSet = otherSet
+	_, err = cs2.CheckValidityAndUpdateState(header)
+	require.Error(t, err)
+}
+
+func TestConsensusStateValidatorSetChange(t *testing.T) {
+	chainID := "chain-a"
+	valSet, privVals := makeValidators(4)
+	cs := NewConsensusState(makeHeader(chainID, 1, []byte("root-1"), valSet, valSet, privVals))
+
+	// a fifth validator joins at height 3, which is announced at height 2
+	allPrivVals := append(privVals, tmtypes.NewMockPV())
+	newSet := validatorSetOf(allPrivVals)
+
+	cs2, err := cs.CheckValidityAndUpdateState(makeHeader(chainID, 2, []byte("root-2"), valSet, newSet, privVals))
+	require.NoError(t, err)
+	require.Equal(t, newSet.Hash(), cs2.NextValidatorSet.Hash())
+
+	// an adjacent header must be signed by the announced set
+	_, err = cs2.CheckValidityAndUpdateState(makeHeader(chainID, 3, []byte("root-3"), valSet, valSet, privVals))
+	require.Error(t, err)
+
+	cs3, err := cs2.CheckValidityAndUpdateState(makeHeader(chainID, 3, []byte("root-3"), newSet, newSet, allPrivVals))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), cs3.Height)
+
+	// skipping heights is allowed while the trusted validators still sign
+	// more than 2/3 of the new commit
+	cs4, err := cs3.CheckValidityAndUpdateState(makeHeader(chainID, 8, []byte("root-8"), newSet, newSet, allPrivVals))
+	require.NoError(t, err)
+	require.Equal(t, int64(8), cs4.Height)
+
+	// but not once the trusted validators are gone
+	otherSet, otherPrivVals := makeValidators(5)
+	_, err = cs4.CheckValidityAndUpdateState(makeHeader(chainID, 20, []byte("root-20"), otherSet, otherSet, otherPrivVals))
+	require.Error(t, err)
+}
@@ -6,6 +6,18 @@ import (
 
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateClient{}, "cosmos-sdk/MsgCreateClient", nil)
+	cdc.RegisterConcrete(MsgUpdateClient{}, "cosmos-sdk/MsgUpdateClient", nil)
+	cdc.RegisterConcrete(MsgConnOpenInit{}, "cosmos-sdk/MsgConnOpenInit", nil)
+	cdc.RegisterConcrete(MsgConnOpenTry{}, "cosmos-sdk/MsgConnOpenTry", nil)
+	cdc.RegisterConcrete(MsgConnOpenAck{}, "cosmos-sdk/MsgConnOpenAck", nil)
+	cdc.RegisterConcrete(MsgConnOpenConfirm{}, "cosmos-sdk/MsgConnOpenConfirm", nil)
+	cdc.RegisterConcrete(MsgChanOpenInit{}, "cosmos-sdk/MsgChanOpenInit", nil)
+	cdc.RegisterConcrete(MsgChanOpenTry{}, "cosmos-sdk/MsgChanOpenTry", nil)
+	cdc.RegisterConcrete(MsgChanOpenAck{}, "cosmos-sdk/MsgChanOpenAck", nil)
+	cdc.RegisterConcrete(MsgChanOpenConfirm{}, "cosmos-sdk/MsgChanOpenConfirm", nil)
 	cdc.RegisterConcrete(MsgIBCTransfer{}, "cosmos-sdk/MsgIBCTransfer", nil)
 	cdc.RegisterConcrete(MsgIBCReceive{}, "cosmos-sdk/MsgIBCReceive", nil)
+	cdc.RegisterConcrete(MsgIBCAcknowledgement{}, "cosmos-sdk/MsgIBCAcknowledgement", nil)
+	cdc.RegisterConcrete(MsgIBCTimeout{}, "cosmos-sdk/MsgIBCTimeout", nil)
 }
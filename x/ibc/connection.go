@@ -0,0 +1,50 @@
+package ibc
+
+// ConnectionState is the state of a connection end during and after the
+// connection handshake
+type ConnectionState byte
+
+// nolint
+const (
+	ConnectionInit    ConnectionState = 0x01
+	ConnectionTryOpen ConnectionState = 0x02
+	ConnectionOpen    ConnectionState = 0x03
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionInit:
+		return "INIT"
+	case ConnectionTryOpen:
+		return "TRYOPEN"
+	case ConnectionOpen:
+		return "OPEN"
+	default:
+		return ""
+	}
+}
+
+// ConnectionEnd is one side of a connection between two chains. Each side
+// verifies the other through its light client of the counterparty.
+/**
+连接的一端
+握手流程: INIT (A) -> TRYOPEN (B) -> OPEN (A) -> OPEN (B)
+除 INIT 外，每一步都需要证明对方链上的连接端处于预期状态
+*/
+type ConnectionEnd struct {
+	State                    ConnectionState `json:"state"`
+	ClientID                 string          `json:"client_id"`                  // client of the counterparty on this chain
+	CounterpartyConnectionID string          `json:"counterparty_connection_id"` // connection ID on the counterparty
+	CounterpartyClientID     string          `json:"counterparty_client_id"`     // client of this chain on the counterparty
+}
+
+// counterpartyEnd returns the connection end the counterparty is expected to
+// store in the given state
+func (c ConnectionEnd) counterpartyEnd(connID string, state ConnectionState) ConnectionEnd {
+	return ConnectionEnd{
+		State:                    state,
+		ClientID:                 c.CounterpartyClientID,
+		CounterpartyConnectionID: connID,
+		CounterpartyClientID:     c.ClientID,
+	}
+}
@@ -1,6 +1,8 @@
 package ibc
 
 import (
+	"fmt"
+
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
@@ -9,17 +11,56 @@ const (
 	DefaultCodespace sdk.CodespaceType = "ibc"
 
 	// IBC errors reserve 200 - 299.
-	CodeInvalidSequence sdk.CodeType = 200
-	CodeIdenticalChains sdk.CodeType = 201
-	CodeUnknownRequest  sdk.CodeType = sdk.CodeUnknownRequest
+	CodeInvalidSequence        sdk.CodeType = 200
+	CodeInvalidIdentifier      sdk.CodeType = 201
+	CodeClientExists           sdk.CodeType = 202
+	CodeClientNotFound         sdk.CodeType = 203
+	CodeInvalidHeader          sdk.CodeType = 204
+	CodeConsensusStateNotFound sdk.CodeType = 205
+	CodeConnectionExists       sdk.CodeType = 206
+	CodeConnectionNotFound     sdk.CodeType = 207
+	CodeInvalidConnectionState sdk.CodeType = 208
+	CodeChannelExists          sdk.CodeType = 209
+	CodeChannelNotFound        sdk.CodeType = 210
+	CodeInvalidChannelState    sdk.CodeType = 211
+	CodeInvalidProof           sdk.CodeType = 212
+	CodeInvalidPacket          sdk.CodeType = 213
+	CodePacketNotTimedOut      sdk.CodeType = 214
+	CodeUnknownRequest         sdk.CodeType = sdk.CodeUnknownRequest
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
 	switch code {
 	case CodeInvalidSequence:
 		return "invalid IBC packet sequence"
-	case CodeIdenticalChains:
-		return "source and destination chain cannot be identical"
+	case CodeInvalidIdentifier:
+		return "invalid IBC identifier"
+	case CodeClientExists:
+		return "light client already exists"
+	case CodeClientNotFound:
+		return "light client not found"
+	case CodeInvalidHeader:
+		return "invalid light client header"
+	case CodeConsensusStateNotFound:
+		return "consensus state not found"
+	case CodeConnectionExists:
+		return "connection already exists"
+	case CodeConnectionNotFound:
+		return "connection not found"
+	case CodeInvalidConnectionState:
+		return "invalid connection state"
+	case CodeChannelExists:
+		return "channel already exists"
+	case CodeChannelNotFound:
+		return "channel not found"
+	case CodeInvalidChannelState:
+		return "invalid channel state"
+	case CodeInvalidProof:
+		return "invalid merkle proof"
+	case CodeInvalidPacket:
+		return "invalid IBC packet"
+	case CodePacketNotTimedOut:
+		return "IBC packet has not timed out"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -29,8 +70,47 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 func ErrInvalidSequence(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidSequence, "")
 }
-func ErrIdenticalChains(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeIdenticalChains, "")
+func ErrInvalidIdentifier(codespace sdk.CodespaceType, id string) sdk.Error {
+	return newError(codespace, CodeInvalidIdentifier, fmt.Sprintf("invalid identifier %q", id))
+}
+func ErrClientExists(codespace sdk.CodespaceType, clientID string) sdk.Error {
+	return newError(codespace, CodeClientExists, fmt.Sprintf("client %s already exists", clientID))
+}
+func ErrClientNotFound(codespace sdk.CodespaceType, clientID string) sdk.Error {
+	return newError(codespace, CodeClientNotFound, fmt.Sprintf("client %s not found", clientID))
+}
+func ErrInvalidHeader(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidHeader, msg)
+}
+func ErrConsensusStateNotFound(codespace sdk.CodespaceType, clientID string, height int64) sdk.Error {
+	return newError(codespace, CodeConsensusStateNotFound, fmt.Sprintf("client %s has no consensus state at height %d", clientID, height))
+}
+func ErrConnectionExists(codespace sdk.CodespaceType, connID string) sdk.Error {
+	return newError(codespace, CodeConnectionExists, fmt.Sprintf("connection %s already exists", connID))
+}
+func ErrConnectionNotFound(codespace sdk.CodespaceType, connID string) sdk.Error {
+	return newError(codespace, CodeConnectionNotFound, fmt.Sprintf("connection %s not found", connID))
+}
+func ErrInvalidConnectionState(codespace sdk.CodespaceType, connID string, state ConnectionState) sdk.Error {
+	return newError(codespace, CodeInvalidConnectionState, fmt.Sprintf("connection %s is in state %s", connID, state))
+}
+func ErrChannelExists(codespace sdk.CodespaceType, chanID string) sdk.Error {
+	return newError(codespace, CodeChannelExists, fmt.Sprintf("channel %s already exists", chanID))
+}
+func ErrChannelNotFound(codespace sdk.CodespaceType, chanID string) sdk.Error {
+	return newError(codespace, CodeChannelNotFound, fmt.Sprintf("channel %s not found", chanID))
+}
+func ErrInvalidChannelState(codespace sdk.CodespaceType, chanID string, state ChannelState) sdk.Error {
+	return newError(codespace, CodeInvalidChannelState, fmt.Sprintf("channel %s is in state %s", chanID, state))
+}
+func ErrInvalidProof(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidProof, msg)
+}
+func ErrInvalidPacket(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidPacket, msg)
+}
+func ErrPacketNotTimedOut(codespace sdk.CodespaceType, timeoutHeight, proofHeight int64) sdk.Error {
+	return newError(codespace, CodePacketNotTimedOut, fmt.Sprintf("packet times out at height %d, proof is at height %d", timeoutHeight, proofHeight))
 }
 
 // -------------------------
@@ -1,19 +1,45 @@
 package ibc
 
 import (
+	"fmt"
+
 	sdk "my-cosmos/cosmos-sdk/types"
 )
 
 /*
 用于InterBlockchain通信
 */
-func NewHandler(ibcm Mapper, ck BankKeeper) sdk.Handler {
+func NewHandler(k Keeper, ck BankKeeper, sk SupplyKeeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
+		case MsgCreateClient:
+			return resultOf(k.CreateClient(ctx, msg.ClientID, msg.ConsensusState))
+		case MsgUpdateClient:
+			return resultOf(k.UpdateClient(ctx, msg.ClientID, msg.Header))
+		case MsgConnOpenInit:
+			return resultOf(k.ConnOpenInit(ctx, msg.ConnectionID, msg.ClientID, msg.CounterpartyConnectionID, msg.CounterpartyClientID))
+		case MsgConnOpenTry:
+			return resultOf(k.ConnOpenTry(ctx, msg.ConnectionID, msg.ClientID, msg.CounterpartyConnectionID, msg.CounterpartyClientID, msg.ProofHeight, msg.Proof))
+		case MsgConnOpenAck:
+			return resultOf(k.ConnOpenAck(ctx, msg.ConnectionID, msg.ProofHeight, msg.Proof))
+		case MsgConnOpenConfirm:
+			return resultOf(k.ConnOpenConfirm(ctx, msg.ConnectionID, msg.ProofHeight, msg.Proof))
+		case MsgChanOpenInit:
+			return resultOf(k.ChanOpenInit(ctx, msg.ChannelID, msg.ConnectionID, msg.CounterpartyChannelID))
+		case MsgChanOpenTry:
+			return resultOf(k.ChanOpenTry(ctx, msg.ChannelID, msg.ConnectionID, msg.CounterpartyChannelID, msg.ProofHeight, msg.Proof))
+		case MsgChanOpenAck:
+			return resultOf(k.ChanOpenAck(ctx, msg.ChannelID, msg.ProofHeight, msg.Proof))
+		case MsgChanOpenConfirm:
+			return resultOf(k.ChanOpenConfirm(ctx, msg.ChannelID, msg.ProofHeight, msg.Proof))
 		case MsgIBCTransfer:
-			return handleIBCTransferMsg(ctx, ibcm, ck, msg)
+			return handleIBCTransferMsg(ctx, k, ck, sk, msg)
 		case MsgIBCReceive:
-			return handleIBCReceiveMsg(ctx, ibcm, ck, msg)
+			return handleIBCReceiveMsg(ctx, k, ck, sk, msg)
+		case MsgIBCAcknowledgement:
+			return handleIBCAcknowledgementMsg(ctx, k, ck, sk, msg)
+		case MsgIBCTimeout:
+			return handleIBCTimeoutMsg(ctx, k, ck, sk, msg)
 		default:
 			errMsg := "Unrecognized IBC Msg type: " + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -21,39 +47,101 @@ func NewHandler(ibcm Mapper, ck BankKeeper) sdk.Handler {
 	}
 }
 
-// MsgIBCTransfer deducts coins from the account and creates an egress IBC packet.
-func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBCTransfer) sdk.Result {
-	packet := msg.IBCPacket
+// resultOf turns the outcome of a keeper call into a handler result
+func resultOf(err sdk.Error) sdk.Result {
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
 
-	_, _, err := ck.SubtractCoins(ctx, packet.SrcAddr, packet.Coins)
+// MsgIBCTransfer escrows or burns coins of the account and commits an
+// outgoing IBC packet on the channel.
+func handleIBCTransferMsg(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, msg MsgIBCTransfer) sdk.Result {
+	err := sendCoins(ctx, k, ck, sk, msg.Channel, msg.SrcAddr, msg.Coins)
 	if err != nil {
 		return err.Result()
 	}
 
-	err = ibcm.PostIBCPacket(ctx, packet)
+	packet := IBCPacket{
+		SrcAddr:       msg.SrcAddr,
+		DestAddr:      msg.DestAddr,
+		Coins:         msg.Coins,
+		TimeoutHeight: msg.TimeoutHeight,
+	}
+	packet, err = k.SendPacket(ctx, msg.Channel, packet)
 	if err != nil {
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{
+		Data: k.cdc.MustMarshalBinaryLengthPrefixed(packet.Sequence),
+	}
 }
 
-// MsgIBCReceive adds coins to the destination address and creates an ingress IBC packet.
-func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBCReceive) sdk.Result {
+// MsgIBCReceive gives coins to the destination address once the packet is
+// proven to be committed on the source chain, and acknowledges the packet.
+// Packets that arrive after their timeout height are acknowledged as failed
+// without giving the coins, so that the source chain refunds them.
+func handleIBCReceiveMsg(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, msg MsgIBCReceive) sdk.Result {
 	packet := msg.IBCPacket
 
-	seq := ibcm.GetIngressSequence(ctx, packet.SrcChain)
-	if msg.Sequence != seq {
-		return ErrInvalidSequence(ibcm.codespace).Result()
+	err := k.RecvPacket(ctx, packet, msg.ProofHeight, msg.Proof)
+	if err != nil {
+		return err.Result()
+	}
+
+	ack := executePacket(ctx, k, ck, sk, packet)
+	k.SetAcknowledgement(ctx, packet.DestChannel, packet.Sequence, ack)
+
+	return sdk.Result{
+		Data: k.cdc.MustMarshalBinaryLengthPrefixed(ack),
+	}
+}
+
+// executePacket gives the coins of a received packet to its destination
+// address. A failure is recorded in the acknowledgement rather than failing
+// the message, so that the packet is not relayed forever.
+func executePacket(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, packet IBCPacket) IBCAcknowledgement {
+	if packet.TimedOut(ctx.BlockHeight()) {
+		return NewFailureAcknowledgement(fmt.Sprintf("packet timed out at height %d", packet.TimeoutHeight))
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := receiveCoins(cacheCtx, k, ck, sk, packet); err != nil {
+		return NewFailureAcknowledgement(err.Error())
 	}
 
-	// XXX Check that packet.Coins is valid and positive (nonzero)
-	_, _, err := ck.AddCoins(ctx, packet.DestAddr, packet.Coins)
+	write()
+	return NewSuccessAcknowledgement()
+}
+
+// MsgIBCAcknowledgement completes a sent packet once its acknowledgement is
+// proven, refunding the source address if the destination chain did not
+// execute it.
+func handleIBCAcknowledgementMsg(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, msg MsgIBCAcknowledgement) sdk.Result {
+	packet := msg.IBCPacket
+
+	err := k.AcknowledgePacket(ctx, packet, msg.Acknowledgement, msg.ProofHeight, msg.Proof)
 	if err != nil {
 		return err.Result()
 	}
 
-	ibcm.SetIngressSequence(ctx, packet.SrcChain, seq+1)
-
+	if !msg.Acknowledgement.Success {
+		return resultOf(refundCoins(ctx, k, ck, sk, packet))
+	}
 	return sdk.Result{}
 }
+
+// MsgIBCTimeout refunds the source address of a sent packet once it is proven
+// that the destination chain did not receive it before its timeout height.
+func handleIBCTimeoutMsg(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, msg MsgIBCTimeout) sdk.Result {
+	packet := msg.IBCPacket
+
+	err := k.TimeoutPacket(ctx, packet, msg.ProofHeight, msg.Proof)
+	if err != nil {
+		return err.Result()
+	}
+
+	return resultOf(refundCoins(ctx, k, ck, sk, packet))
+}
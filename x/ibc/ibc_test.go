@@ -7,8 +7,10 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"my-cosmos/cosmos-sdk/codec"
 	"my-cosmos/cosmos-sdk/store"
@@ -16,46 +18,136 @@ import (
 	"my-cosmos/cosmos-sdk/x/auth"
 	"my-cosmos/cosmos-sdk/x/bank"
 	"my-cosmos/cosmos-sdk/x/params"
+	"my-cosmos/cosmos-sdk/x/supply"
 )
 
-// AccountKeeper(/Keeper) and IBCMapper should use different StoreKey later
-
-type testInput struct {
-	cdc    *codec.Codec
-	ctx    sdk.Context
-	ak     auth.AccountKeeper
-	bk     bank.BaseKeeper
-	ibcKey *sdk.KVStoreKey
+// testChain is an in-process chain with a single validator. Its state is
+// committed to a real multistore so that the other chain can verify merkle
+// proofs of it against the headers signed by its validator.
+type testChain struct {
+	t       *testing.T
+	chainID string
+	cdc     *codec.Codec
+	ms      sdk.CommitMultiStore
+	ctx     sdk.Context
+	ak      auth.AccountKeeper
+	bk      bank.BaseKeeper
+	supply  supply.Keeper
+	keeper  Keeper
+	handler sdk.Handler
+
+	valSet   *tmtypes.ValidatorSet
+	privVals []tmtypes.PrivValidator
 }
 
-func setupTestInput() testInput {
+func newTestChain(t *testing.T, chainID string) *testChain {
 	db := dbm.NewMemDB()
 	cdc := makeCodec()
 
-	ibcKey := sdk.NewKVStoreKey("ibcCapKey")
+	// counterparties prove the IBC store under its module name
+	ibcKey := sdk.NewKVStoreKey(StoreKey)
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
-	fckCapKey := sdk.NewKVStoreKey("fckCapKey")
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
 	ms := store.NewCommitMultiStore(db)
-	ms.MountStoreWithDB(ibcKey, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(fckCapKey, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
-	ms.LoadLatestVersion()
+	// the stores are mounted without a DB, so that each gets its own prefix
+	// of the multistore DB and the proofs of one do not read the others
+	ms.MountStoreWithDB(ibcKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
 
 	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
 	ak := auth.NewAccountKeeper(
 		cdc, authCapKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount,
 	)
 	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
-	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+	sk := supply.NewKeeper(cdc, keySupply, ak, bk, map[string][]string{
+		ModuleName: {auth.Minter, auth.Burner},
+	})
+	keeper := NewKeeper(cdc, ibcKey, DefaultCodespace)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: chainID, Height: 1}, false, log.NewNopLogger())
 
 	ak.SetParams(ctx, auth.DefaultParams())
 
-	return testInput{cdc: cdc, ctx: ctx, ak: ak, bk: bk, ibcKey: ibcKey}
+	valSet, privVals := makeValidators(1)
+
+	return &testChain{
+		t:        t,
+		chainID:  chainID,
+		cdc:      cdc,
+		ms:       ms,
+		ctx:      ctx,
+		ak:       ak,
+		bk:       bk,
+		supply:   sk,
+		keeper:   keeper,
+		handler:  NewHandler(keeper, bk, sk),
+		valSet:   valSet,
+		privVals: privVals,
+	}
+}
+
+// commit commits the chain state and returns the header of the next block,
+// whose application hash is the root of the committed state. A value proven
+// against this header is therefore queried at header height - 1.
+func (c *testChain) commit() Header {
+	id := c.ms.Commit()
+	height := id.Version + 1
+	c.ctx = sdk.NewContext(c.ms, abci.Header{ChainID: c.chainID, Height: height}, false, log.NewNopLogger())
+	return makeHeader(c.chainID, height, id.Hash, c.valSet, c.valSet, c.privVals)
+}
+
+// queryProof returns a merkle proof of a key of the IBC store at the height
+// committed by header
+func (c *testChain) queryProof(key []byte, header Header) *merkle.Proof {
+	res := c.ms.(sdk.Queryable).Query(abci.RequestQuery{
+		Path:   "/" + StoreKey + "/key",
+		Data:   key,
+		Height: header.SignedHeader.Height - 1,
+		Prove:  true,
+	})
+	require.True(c.t, res.IsOK(), res.Log)
+	require.NotNil(c.t, res.Value)
+	return res.Proof
+}
+
+// queryAbsenceProof returns a merkle proof that a key of the IBC store is
+// absent at the height committed by header
+func (c *testChain) queryAbsenceProof(key []byte, header Header) *merkle.Proof {
+	res := c.ms.(sdk.Queryable).Query(abci.RequestQuery{
+		Path:   "/" + StoreKey + "/key",
+		Data:   key,
+		Height: header.SignedHeader.Height - 1,
+		Prove:  true,
+	})
+	require.True(c.t, res.IsOK(), res.Log)
+	require.Nil(c.t, res.Value)
+	return res.Proof
+}
+
+// deliver runs a message the way BaseApp does, discarding its writes if it
+// fails
+func (c *testChain) deliver(msg sdk.Msg) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	cacheCtx, write := c.ctx.CacheContext()
+	res := c.handler(cacheCtx, msg)
+	if res.IsOK() {
+		write()
+	}
+	return res
+}
+
+func (c *testChain) requireDeliver(msg sdk.Msg) {
+	res := c.deliver(msg)
+	require.True(c.t, res.IsOK(), res.Log)
 }
 
 func makeCodec() *codec.Codec {
@@ -64,12 +156,12 @@ func makeCodec() *codec.Codec {
 	// Register Msgs
 	cdc.RegisterInterface((*sdk.Msg)(nil), nil)
 	cdc.RegisterConcrete(bank.MsgSend{}, "test/ibc/Send", nil)
-	cdc.RegisterConcrete(MsgIBCTransfer{}, "test/ibc/MsgIBCTransfer", nil)
-	cdc.RegisterConcrete(MsgIBCReceive{}, "test/ibc/MsgIBCReceive", nil)
+	RegisterCodec(cdc)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/ibc/Account", nil)
+	cdc.RegisterConcrete(&auth.ModuleAccount{}, "test/ibc/ModuleAccount", nil)
 	codec.RegisterCrypto(cdc)
 
 	cdc.Seal()
@@ -87,74 +179,384 @@ func getCoins(ck bank.Keeper, ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins,
 	return coins, err
 }
 
+// connect creates a client of each chain on the other one and runs the
+// connection and channel handshakes between them, relaying every proof
+func connect(t *testing.T, chainA, chainB *testChain, relayer sdk.AccAddress) {
+	// clients
+	headerA := chainA.commit()
+	headerB := chainB.commit()
+	chainB.requireDeliver(NewMsgCreateClient("client-a", NewConsensusState(headerA), relayer))
+	chainA.requireDeliver(NewMsgCreateClient("client-b", NewConsensusState(headerB), relayer))
+
+	// connection handshake
+	chainA.requireDeliver(MsgConnOpenInit{"conn-a", "client-b", "conn-b", "client-a", relayer})
+	headerA = chainA.commit()
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	chainB.requireDeliver(MsgConnOpenTry{"conn-b", "client-a", "conn-a", "client-b",
+		chainA.queryProof(ConnectionKey("conn-a"), headerA), headerA.SignedHeader.Height, relayer})
+
+	headerB = chainB.commit()
+	chainA.requireDeliver(NewMsgUpdateClient("client-b", headerB, relayer))
+	chainA.requireDeliver(MsgConnOpenAck{"conn-a",
+		chainB.queryProof(ConnectionKey("conn-b"), headerB), headerB.SignedHeader.Height, relayer})
+
+	headerA = chainA.commit()
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	chainB.requireDeliver(MsgConnOpenConfirm{"conn-b",
+		chainA.queryProof(ConnectionKey("conn-a"), headerA), headerA.SignedHeader.Height, relayer})
+
+	// channel handshake
+	chainA.requireDeliver(MsgChanOpenInit{"chana", "conn-a", "chanb", relayer})
+	headerA = chainA.commit()
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	chainB.requireDeliver(MsgChanOpenTry{"chanb", "conn-b", "chana",
+		chainA.queryProof(ChannelKey("chana"), headerA), headerA.SignedHeader.Height, relayer})
+
+	headerB = chainB.commit()
+	chainA.requireDeliver(NewMsgUpdateClient("client-b", headerB, relayer))
+	chainA.requireDeliver(MsgChanOpenAck{"chana",
+		chainB.queryProof(ChannelKey("chanb"), headerB), headerB.SignedHeader.Height, relayer})
+
+	headerA = chainA.commit()
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	chainB.requireDeliver(MsgChanOpenConfirm{"chanb",
+		chainA.queryProof(ChannelKey("chana"), headerA), headerA.SignedHeader.Height, relayer})
+
+	chanA, _ := chainA.keeper.GetChannel(chainA.ctx, "chana")
+	chanB, _ := chainB.keeper.GetChannel(chainB.ctx, "chanb")
+	require.Equal(t, ChannelOpen, chanA.State)
+	require.Equal(t, ChannelOpen, chanB.State)
+}
+
+func TestHandshakeRequiresProof(t *testing.T) {
+	chainA := newTestChain(t, "chain-a")
+	chainB := newTestChain(t, "chain-b")
+	relayer := newAddress()
+
+	headerA := chainA.commit()
+	chainB.requireDeliver(NewMsgCreateClient("client-a", NewConsensusState(headerA), relayer))
+
+	// conn-a was never initialised on chain A
+	headerA = chainA.commit()
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	res := chainB.deliver(MsgConnOpenTry{"conn-b", "client-a", "conn-a", "client-b",
+		constructProof(), headerA.SignedHeader.Height, relayer})
+	require.False(t, res.IsOK())
+
+	// the proof must be checked against a verified height
+	chainA.keeper.setConnection(chainA.ctx, "conn-a", ConnectionEnd{ConnectionInit, "client-b", "conn-b", "client-a"})
+	headerA = chainA.commit()
+	proof := chainA.queryProof(ConnectionKey("conn-a"), headerA)
+	res = chainB.deliver(MsgConnOpenTry{"conn-b", "client-a", "conn-a", "client-b",
+		proof, headerA.SignedHeader.Height, relayer})
+	require.False(t, res.IsOK())
+
+	// the proof must match the counterparty identifiers
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	res = chainB.deliver(MsgConnOpenTry{"conn-c", "client-a", "conn-a", "client-b",
+		proof, headerA.SignedHeader.Height, relayer})
+	require.False(t, res.IsOK())
+
+	chainB.requireDeliver(MsgConnOpenTry{"conn-b", "client-a", "conn-a", "client-b",
+		proof, headerA.SignedHeader.Height, relayer})
+	conn, ok := chainB.keeper.GetConnection(chainB.ctx, "conn-b")
+	require.True(t, ok)
+	require.Equal(t, ConnectionTryOpen, conn.State)
+
+	// channels cannot be opened before the connection
+	res = chainB.deliver(MsgChanOpenInit{"chanb", "conn-b", "chana", relayer})
+	require.False(t, res.IsOK())
+}
+
 func TestIBC(t *testing.T) {
-	input := setupTestInput()
-	ctx := input.ctx
+	chainA := newTestChain(t, "chain-a")
+	chainB := newTestChain(t, "chain-b")
 
 	src := newAddress()
 	dest := newAddress()
-	chainid := "ibcchain"
+	relayer := newAddress()
 	zero := sdk.Coins(nil)
 	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
+	vouchers := sdk.Coins{sdk.NewInt64Coin(VoucherDenom("chanb", "mycoin"), 10)}
 
-	coins, _, err := input.bk.AddCoins(ctx, src, mycoins)
+	coins, _, err := chainA.bk.AddCoins(chainA.ctx, src, mycoins)
 	require.Nil(t, err)
 	require.Equal(t, mycoins, coins)
 
-	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
-	h := NewHandler(ibcm, input.bk)
-	packet := IBCPacket{
-		SrcAddr:   src,
-		DestAddr:  dest,
-		Coins:     mycoins,
-		SrcChain:  chainid,
-		DestChain: chainid,
-	}
+	// packets cannot be sent before the channel is open
+	timeout := int64(1000)
+	res := chainA.deliver(NewMsgIBCTransfer("chana", src, dest, mycoins, timeout))
+	require.False(t, res.IsOK())
 
-	store := ctx.KVStore(input.ibcKey)
+	connect(t, chainA, chainB, relayer)
 
-	var msg sdk.Msg
-	var res sdk.Result
-	var egl uint64
-	var igs uint64
+	require.Equal(t, uint64(0), chainA.keeper.GetNextSequenceSend(chainA.ctx, "chana"))
+	chainA.requireDeliver(NewMsgIBCTransfer("chana", src, dest, mycoins, timeout))
 
-	egl = ibcm.getEgressLength(store, chainid)
-	require.Equal(t, egl, uint64(0))
+	coins, err = getCoins(chainA.bk, chainA.ctx, src)
+	require.Nil(t, err)
+	require.Equal(t, zero, coins)
+	coins, err = getCoins(chainA.bk, chainA.ctx, EscrowAddress("chana"))
+	require.Nil(t, err)
+	require.Equal(t, mycoins, coins)
+	require.Equal(t, mycoins, chainA.keeper.GetEscrowedCoins(chainA.ctx, "chana"))
+	require.Equal(t, uint64(1), chainA.keeper.GetNextSequenceSend(chainA.ctx, "chana"))
+
+	packet, ok := chainA.keeper.GetPacketCommitment(chainA.ctx, "chana", 0)
+	require.True(t, ok)
+	require.Equal(t, NewIBCPacket(0, "chana", "chanb", src, dest, mycoins, timeout), packet)
+
+	// relay the packet
+	headerA := chainA.commit()
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	proof := chainA.queryProof(PacketCommitmentKey("chana", 0), headerA)
+	height := headerA.SignedHeader.Height
+
+	// a packet that differs from the committed one is rejected
+	forged := packet
+	forged.Coins = sdk.Coins{sdk.NewInt64Coin("mycoin", 1000)}
+	res = chainB.deliver(MsgIBCReceive{forged, proof, height, relayer})
+	require.False(t, res.IsOK())
 
-	msg = MsgIBCTransfer{
-		IBCPacket: packet,
-	}
-	res = h(ctx, msg)
-	require.True(t, res.IsOK())
+	// as is a proof against a height the client has not verified
+	res = chainB.deliver(MsgIBCReceive{packet, proof, height + 1, relayer})
+	require.False(t, res.IsOK())
+
+	require.Equal(t, uint64(0), chainB.keeper.GetNextSequenceRecv(chainB.ctx, "chanb"))
+	chainB.requireDeliver(MsgIBCReceive{packet, proof, height, relayer})
 
-	coins, err = getCoins(input.bk, ctx, src)
+	coins, err = getCoins(chainB.bk, chainB.ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, vouchers, coins)
+	require.Equal(t, vouchers, chainB.keeper.GetVoucherSupply(chainB.ctx))
+	require.Equal(t, vouchers, chainB.supply.GetTotalSupply(chainB.ctx))
+	require.Equal(t, uint64(1), chainB.keeper.GetNextSequenceRecv(chainB.ctx, "chanb"))
+
+	// packets are received only once
+	res = chainB.deliver(MsgIBCReceive{packet, proof, height, relayer})
+	require.False(t, res.IsOK())
+
+	coins, err = getCoins(chainB.bk, chainB.ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, vouchers, coins)
+
+	// relay the acknowledgement back
+	ack, ok := chainB.keeper.GetAcknowledgement(chainB.ctx, "chanb", 0)
+	require.True(t, ok)
+	require.True(t, ack.Success)
+
+	headerB := chainB.commit()
+	chainA.requireDeliver(NewMsgUpdateClient("client-b", headerB, relayer))
+	ackProof := chainB.queryProof(AcknowledgementKey("chanb", 0), headerB)
+	heightB := headerB.SignedHeader.Height
+
+	// the acknowledgement must be the one written by chain B
+	res = chainA.deliver(MsgIBCAcknowledgement{packet, NewFailureAcknowledgement("forged"), ackProof, heightB, relayer})
+	require.False(t, res.IsOK())
+
+	chainA.requireDeliver(MsgIBCAcknowledgement{packet, ack, ackProof, heightB, relayer})
+	_, ok = chainA.keeper.GetPacketCommitment(chainA.ctx, "chana", 0)
+	require.False(t, ok)
+
+	coins, err = getCoins(chainA.bk, chainA.ctx, src)
 	require.Nil(t, err)
 	require.Equal(t, zero, coins)
 
-	egl = ibcm.getEgressLength(store, chainid)
-	require.Equal(t, egl, uint64(1))
+	// packets are acknowledged only once
+	res = chainA.deliver(MsgIBCAcknowledgement{packet, ack, ackProof, heightB, relayer})
+	require.False(t, res.IsOK())
+}
+
+func TestIBCTimeout(t *testing.T) {
+	chainA := newTestChain(t, "chain-a")
+	chainB := newTestChain(t, "chain-b")
 
-	igs = ibcm.GetIngressSequence(ctx, chainid)
-	require.Equal(t, igs, uint64(0))
+	src := newAddress()
+	dest := newAddress()
+	relayer := newAddress()
+	zero := sdk.Coins(nil)
+	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
 
-	msg = MsgIBCReceive{
-		IBCPacket: packet,
-		Relayer:   src,
-		Sequence:  0,
-	}
-	res = h(ctx, msg)
-	require.True(t, res.IsOK())
+	_, _, err := chainA.bk.AddCoins(chainA.ctx, src, mycoins)
+	require.Nil(t, err)
+
+	connect(t, chainA, chainB, relayer)
+
+	// the packet times out two blocks from now on chain B
+	timeout := chainB.ctx.BlockHeight() + 2
+	chainA.requireDeliver(NewMsgIBCTransfer("chana", src, dest, mycoins, timeout))
+	packet, ok := chainA.keeper.GetPacketCommitment(chainA.ctx, "chana", 0)
+	require.True(t, ok)
+
+	headerA := chainA.commit()
+	packetProof := chainA.queryProof(PacketCommitmentKey("chana", 0), headerA)
+	heightA := headerA.SignedHeader.Height
+
+	// chain B has not reached the timeout height yet
+	headerB := chainB.commit()
+	chainA.requireDeliver(NewMsgUpdateClient("client-b", headerB, relayer))
+	proof := chainB.queryAbsenceProof(AcknowledgementKey("chanb", 0), headerB)
+	res := chainA.deliver(MsgIBCTimeout{packet, proof, headerB.SignedHeader.Height, relayer})
+	require.False(t, res.IsOK())
+
+	headerB = chainB.commit()
+	chainA.requireDeliver(NewMsgUpdateClient("client-b", headerB, relayer))
+	proof = chainB.queryAbsenceProof(AcknowledgementKey("chanb", 0), headerB)
+
+	// the proof must be of the absence of the packet's acknowledgement
+	res = chainA.deliver(MsgIBCTimeout{packet, chainB.queryProof(ChannelKey("chanb"), headerB), headerB.SignedHeader.Height, relayer})
+	require.False(t, res.IsOK())
+
+	chainA.requireDeliver(MsgIBCTimeout{packet, proof, headerB.SignedHeader.Height, relayer})
+	coins, err := getCoins(chainA.bk, chainA.ctx, src)
+	require.Nil(t, err)
+	require.Equal(t, mycoins, coins)
+	_, ok = chainA.keeper.GetPacketCommitment(chainA.ctx, "chana", 0)
+	require.False(t, ok)
+
+	// packets are refunded only once
+	res = chainA.deliver(MsgIBCTimeout{packet, proof, headerB.SignedHeader.Height, relayer})
+	require.False(t, res.IsOK())
+
+	// packets that the light client has already seen time out are not sent
+	res = chainA.deliver(NewMsgIBCTransfer("chana", src, dest, mycoins, headerB.SignedHeader.Height))
+	require.False(t, res.IsOK())
+
+	// the timed out packet may still be relayed, but chain B acknowledges it
+	// as failed without adding the coins
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	chainB.requireDeliver(MsgIBCReceive{packet, packetProof, heightA, relayer})
+	coins, err = getCoins(chainB.bk, chainB.ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, zero, coins)
+	ack, ok := chainB.keeper.GetAcknowledgement(chainB.ctx, "chanb", 0)
+	require.True(t, ok)
+	require.False(t, ack.Success)
+
+	// a packet received after its timeout is refunded through its
+	// acknowledgement
+	refunded := packet
+	timeout = chainB.ctx.BlockHeight() + 1
+	chainA.requireDeliver(NewMsgIBCTransfer("chana", src, dest, mycoins, timeout))
+	packet, ok = chainA.keeper.GetPacketCommitment(chainA.ctx, "chana", 1)
+	require.True(t, ok)
+
+	headerA = chainA.commit()
+	packetProof = chainA.queryProof(PacketCommitmentKey("chana", 1), headerA)
+	chainB.commit()
+	chainB.requireDeliver(NewMsgUpdateClient("client-a", headerA, relayer))
+	chainB.requireDeliver(MsgIBCReceive{packet, packetProof, headerA.SignedHeader.Height, relayer})
+
+	headerB = chainB.commit()
+	chainA.requireDeliver(NewMsgUpdateClient("client-b", headerB, relayer))
+
+	// the late acknowledgement of the refunded packet is rejected
+	ackProof := chainB.queryProof(AcknowledgementKey("chanb", 0), headerB)
+	res = chainA.deliver(MsgIBCAcknowledgement{refunded, ack, ackProof, headerB.SignedHeader.Height, relayer})
+	require.False(t, res.IsOK())
 
-	coins, err = getCoins(input.bk, ctx, dest)
+	ack, ok = chainB.keeper.GetAcknowledgement(chainB.ctx, "chanb", 1)
+	require.True(t, ok)
+	require.False(t, ack.Success)
+	ackProof = chainB.queryProof(AcknowledgementKey("chanb", 1), headerB)
+	chainA.requireDeliver(MsgIBCAcknowledgement{packet, ack, ackProof, headerB.SignedHeader.Height, relayer})
+
+	coins, err = getCoins(chainA.bk, chainA.ctx, src)
 	require.Nil(t, err)
 	require.Equal(t, mycoins, coins)
+	coins, err = getCoins(chainB.bk, chainB.ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, zero, coins)
+}
+
+// relayPacket relays a packet sent on chain from to chain to, whose client of
+// chain from is clientID, and returns its acknowledgement
+func relayPacket(t *testing.T, from, to *testChain, clientID, chanID string, sequence uint64,
+	relayer sdk.AccAddress) IBCAcknowledgement {
+
+	packet, ok := from.keeper.GetPacketCommitment(from.ctx, chanID, sequence)
+	require.True(t, ok)
+
+	header := from.commit()
+	to.requireDeliver(NewMsgUpdateClient(clientID, header, relayer))
+	proof := from.queryProof(PacketCommitmentKey(chanID, sequence), header)
+	to.requireDeliver(MsgIBCReceive{packet, proof, header.SignedHeader.Height, relayer})
+
+	ack, ok := to.keeper.GetAcknowledgement(to.ctx, packet.DestChannel, sequence)
+	require.True(t, ok)
+	return ack
+}
+
+func TestIBCVouchers(t *testing.T) {
+	chainA := newTestChain(t, "chain-a")
+	chainB := newTestChain(t, "chain-b")
+
+	addrA := newAddress()
+	addrB := newAddress()
+	relayer := newAddress()
+	zero := sdk.Coins(nil)
+	atoms := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
+	vouchers := sdk.Coins{sdk.NewInt64Coin("chanb/atom", 10)}
+
+	_, _, err := chainA.bk.AddCoins(chainA.ctx, addrA, atoms)
+	require.Nil(t, err)
+	// chain B has its own coin of the same name, which must not be mixed up
+	// with the one of chain A
+	_, _, err = chainB.bk.AddCoins(chainB.ctx, addrB, atoms)
+	require.Nil(t, err)
 
-	igs = ibcm.GetIngressSequence(ctx, chainid)
-	require.Equal(t, igs, uint64(1))
+	connect(t, chainA, chainB, relayer)
+	timeout := chainB.ctx.BlockHeight() + 1000
 
-	res = h(ctx, msg)
+	// chain A escrows its atoms and chain B mints vouchers for them
+	chainA.requireDeliver(NewMsgIBCTransfer("chana", addrA, addrB, atoms, timeout))
+	ack := relayPacket(t, chainA, chainB, "client-a", "chana", 0, relayer)
+	require.True(t, ack.Success, ack.Log)
+
+	coins, err := getCoins(chainB.bk, chainB.ctx, addrB)
+	require.Nil(t, err)
+	require.Equal(t, atoms.Add(vouchers), coins)
+
+	// chain B sends its own atoms, for which chain A mints vouchers rather
+	// than releasing the escrowed atoms
+	chainB.requireDeliver(NewMsgIBCTransfer("chanb", addrB, addrA, atoms, timeout))
+	ack = relayPacket(t, chainB, chainA, "client-b", "chanb", 0, relayer)
+	require.True(t, ack.Success, ack.Log)
+
+	coins, err = getCoins(chainA.bk, chainA.ctx, addrA)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("chana/atom", 10)}, coins)
+	require.Equal(t, atoms, chainA.keeper.GetEscrowedCoins(chainA.ctx, "chana"))
+
+	// vouchers sent back over their channel are burned, and chain A releases
+	// the escrowed atoms
+	chainB.requireDeliver(NewMsgIBCTransfer("chanb", addrB, addrA, vouchers, timeout))
+	require.Equal(t, sdk.Coins{}, chainB.keeper.GetVoucherSupply(chainB.ctx))
+	require.True(t, chainB.supply.GetTotalSupply(chainB.ctx).Empty())
+	ack = relayPacket(t, chainB, chainA, "client-b", "chanb", 1, relayer)
+	require.True(t, ack.Success, ack.Log)
+
+	coins, err = getCoins(chainA.bk, chainA.ctx, addrA)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("chana/atom", 10)}, coins)
+	require.Equal(t, sdk.Coins{}, chainA.keeper.GetEscrowedCoins(chainA.ctx, "chana"))
+	coins, err = getCoins(chainA.bk, chainA.ctx, EscrowAddress("chana"))
+	require.Nil(t, err)
+	require.Equal(t, zero, coins)
+
+	// nothing is escrowed any more, so chain A rejects more returning atoms
+	// than it sent out
+	_, _, err = chainB.bk.AddCoins(chainB.ctx, addrB, vouchers)
+	require.Nil(t, err)
+	res := chainB.deliver(NewMsgIBCTransfer("chanb", addrB, addrA, vouchers, timeout))
 	require.False(t, res.IsOK())
+	chainB.keeper.setVoucherSupply(chainB.ctx, vouchers)
+	chainB.supply.Inflate(chainB.ctx, vouchers)
+	chainB.requireDeliver(NewMsgIBCTransfer("chanb", addrB, addrA, vouchers, timeout))
+	ack = relayPacket(t, chainB, chainA, "client-b", "chanb", 2, relayer)
+	require.False(t, ack.Success)
 
-	igs = ibcm.GetIngressSequence(ctx, chainid)
-	require.Equal(t, igs, uint64(1))
+	coins, err = getCoins(chainA.bk, chainA.ctx, addrA)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("chana/atom", 10)}, coins)
 }
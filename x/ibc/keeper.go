@@ -0,0 +1,667 @@
+package ibc
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	codec "my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the IBC store
+/**
+IBC 的 keeper
+管理对方链的轻客户端、连接、通道以及数据包的提交与接收
+*/
+type Keeper struct {
+	key       sdk.StoreKey
+	cdc       *codec.Codec
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper returns an IBC keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		key:       key,
+		cdc:       cdc,
+		codespace: codespace,
+	}
+}
+
+// Codespace returns the keeper's codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// --------------------------
+// Light clients
+
+// GetClientState returns the state of a light client
+func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (ClientState, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(ClientStateKey(clientID))
+	if bz == nil {
+		return ClientState{}, false
+	}
+
+	var client ClientState
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &client)
+	return client, true
+}
+
+func (k Keeper) setClientState(ctx sdk.Context, clientID string, client ClientState) {
+	store := ctx.KVStore(k.key)
+	store.Set(ClientStateKey(clientID), k.cdc.MustMarshalBinaryLengthPrefixed(client))
+}
+
+// GetConsensusState returns the consensus state of a light client verified at
+// the given height
+func (k Keeper) GetConsensusState(ctx sdk.Context, clientID string, height int64) (ConsensusState, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(ConsensusStateKey(clientID, height))
+	if bz == nil {
+		return ConsensusState{}, false
+	}
+
+	var cs ConsensusState
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &cs)
+	return cs, true
+}
+
+func (k Keeper) setConsensusState(ctx sdk.Context, clientID string, cs ConsensusState) {
+	store := ctx.KVStore(k.key)
+	store.Set(ConsensusStateKey(clientID, cs.Height), k.cdc.MustMarshalBinaryLengthPrefixed(cs))
+}
+
+// CreateClient creates a light client of a counterparty from a trusted
+// consensus state
+func (k Keeper) CreateClient(ctx sdk.Context, clientID string, cs ConsensusState) sdk.Error {
+	if _, ok := k.GetClientState(ctx, clientID); ok {
+		return ErrClientExists(k.codespace, clientID)
+	}
+
+	k.setClientState(ctx, clientID, ClientState{ChainID: cs.ChainID, LatestHeight: cs.Height})
+	k.setConsensusState(ctx, clientID, cs)
+	return nil
+}
+
+// UpdateClient verifies a new header of the counterparty against the latest
+// consensus state of the client and stores the resulting consensus state
+func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header Header) sdk.Error {
+	client, ok := k.GetClientState(ctx, clientID)
+	if !ok {
+		return ErrClientNotFound(k.codespace, clientID)
+	}
+
+	latest, ok := k.GetConsensusState(ctx, clientID, client.LatestHeight)
+	if !ok {
+		return ErrConsensusStateNotFound(k.codespace, clientID, client.LatestHeight)
+	}
+
+	cs, err := latest.CheckValidityAndUpdateState(header)
+	if err != nil {
+		return ErrInvalidHeader(k.codespace, err.Error())
+	}
+
+	client.LatestHeight = cs.Height
+	k.setClientState(ctx, clientID, client)
+	k.setConsensusState(ctx, clientID, cs)
+	return nil
+}
+
+// verifyMembership checks that the counterparty tracked by the client stores
+// value under key in its IBC store.
+//
+// The root of the consensus state at proofHeight is the application hash of
+// the header at that height, which commits to the counterparty state after
+// block proofHeight-1. Proofs must therefore be queried at proofHeight-1.
+func (k Keeper) verifyMembership(ctx sdk.Context, clientID string, proofHeight int64,
+	proof *merkle.Proof, key, value []byte) sdk.Error {
+
+	cs, ok := k.GetConsensusState(ctx, clientID, proofHeight)
+	if !ok {
+		return ErrConsensusStateNotFound(k.codespace, clientID, proofHeight)
+	}
+
+	if err := verifyMembership(cs.Root, proof, key, value); err != nil {
+		return ErrInvalidProof(k.codespace, err.Error())
+	}
+	return nil
+}
+
+// verifyNonMembership checks that the counterparty tracked by the client
+// stores nothing under key in its IBC store, with the same height convention
+// as verifyMembership.
+func (k Keeper) verifyNonMembership(ctx sdk.Context, clientID string, proofHeight int64,
+	proof *merkle.Proof, key []byte) sdk.Error {
+
+	cs, ok := k.GetConsensusState(ctx, clientID, proofHeight)
+	if !ok {
+		return ErrConsensusStateNotFound(k.codespace, clientID, proofHeight)
+	}
+
+	if err := verifyNonMembership(cs.Root, proof, key); err != nil {
+		return ErrInvalidProof(k.codespace, err.Error())
+	}
+	return nil
+}
+
+// --------------------------
+// Connections
+
+// GetConnection returns a connection end
+func (k Keeper) GetConnection(ctx sdk.Context, connID string) (ConnectionEnd, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(ConnectionKey(connID))
+	if bz == nil {
+		return ConnectionEnd{}, false
+	}
+
+	var conn ConnectionEnd
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &conn)
+	return conn, true
+}
+
+func (k Keeper) setConnection(ctx sdk.Context, connID string, conn ConnectionEnd) {
+	store := ctx.KVStore(k.key)
+	store.Set(ConnectionKey(connID), k.cdc.MustMarshalBinaryLengthPrefixed(conn))
+}
+
+// verifyConnection checks that the counterparty stores the expected
+// connection end
+func (k Keeper) verifyConnection(ctx sdk.Context, conn ConnectionEnd, expected ConnectionEnd,
+	proofHeight int64, proof *merkle.Proof) sdk.Error {
+
+	return k.verifyMembership(ctx, conn.ClientID, proofHeight, proof,
+		ConnectionKey(conn.CounterpartyConnectionID), k.cdc.MustMarshalBinaryLengthPrefixed(expected))
+}
+
+// ConnOpenInit stores a connection end in INIT
+func (k Keeper) ConnOpenInit(ctx sdk.Context, connID, clientID, cpConnID, cpClientID string) sdk.Error {
+	if _, ok := k.GetConnection(ctx, connID); ok {
+		return ErrConnectionExists(k.codespace, connID)
+	}
+	if _, ok := k.GetClientState(ctx, clientID); !ok {
+		return ErrClientNotFound(k.codespace, clientID)
+	}
+
+	k.setConnection(ctx, connID, ConnectionEnd{
+		State:                    ConnectionInit,
+		ClientID:                 clientID,
+		CounterpartyConnectionID: cpConnID,
+		CounterpartyClientID:     cpClientID,
+	})
+	return nil
+}
+
+// ConnOpenTry stores a connection end in TRYOPEN once the counterparty end is
+// proven to be in INIT
+func (k Keeper) ConnOpenTry(ctx sdk.Context, connID, clientID, cpConnID, cpClientID string,
+	proofHeight int64, proof *merkle.Proof) sdk.Error {
+
+	if _, ok := k.GetConnection(ctx, connID); ok {
+		return ErrConnectionExists(k.codespace, connID)
+	}
+	if _, ok := k.GetClientState(ctx, clientID); !ok {
+		return ErrClientNotFound(k.codespace, clientID)
+	}
+
+	conn := ConnectionEnd{
+		State:                    ConnectionTryOpen,
+		ClientID:                 clientID,
+		CounterpartyConnectionID: cpConnID,
+		CounterpartyClientID:     cpClientID,
+	}
+	expected := conn.counterpartyEnd(connID, ConnectionInit)
+	if err := k.verifyConnection(ctx, conn, expected, proofHeight, proof); err != nil {
+		return err
+	}
+
+	k.setConnection(ctx, connID, conn)
+	return nil
+}
+
+// ConnOpenAck opens a connection in INIT once the counterparty end is proven
+// to be in TRYOPEN
+func (k Keeper) ConnOpenAck(ctx sdk.Context, connID string, proofHeight int64, proof *merkle.Proof) sdk.Error {
+	conn, ok := k.GetConnection(ctx, connID)
+	if !ok {
+		return ErrConnectionNotFound(k.codespace, connID)
+	}
+	if conn.State != ConnectionInit {
+		return ErrInvalidConnectionState(k.codespace, connID, conn.State)
+	}
+
+	expected := conn.counterpartyEnd(connID, ConnectionTryOpen)
+	if err := k.verifyConnection(ctx, conn, expected, proofHeight, proof); err != nil {
+		return err
+	}
+
+	conn.State = ConnectionOpen
+	k.setConnection(ctx, connID, conn)
+	return nil
+}
+
+// ConnOpenConfirm opens a connection in TRYOPEN once the counterparty end is
+// proven to be OPEN
+func (k Keeper) ConnOpenConfirm(ctx sdk.Context, connID string, proofHeight int64, proof *merkle.Proof) sdk.Error {
+	conn, ok := k.GetConnection(ctx, connID)
+	if !ok {
+		return ErrConnectionNotFound(k.codespace, connID)
+	}
+	if conn.State != ConnectionTryOpen {
+		return ErrInvalidConnectionState(k.codespace, connID, conn.State)
+	}
+
+	expected := conn.counterpartyEnd(connID, ConnectionOpen)
+	if err := k.verifyConnection(ctx, conn, expected, proofHeight, proof); err != nil {
+		return err
+	}
+
+	conn.State = ConnectionOpen
+	k.setConnection(ctx, connID, conn)
+	return nil
+}
+
+// --------------------------
+// Channels
+
+// GetChannel returns a channel end
+func (k Keeper) GetChannel(ctx sdk.Context, chanID string) (ChannelEnd, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(ChannelKey(chanID))
+	if bz == nil {
+		return ChannelEnd{}, false
+	}
+
+	var channel ChannelEnd
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &channel)
+	return channel, true
+}
+
+func (k Keeper) setChannel(ctx sdk.Context, chanID string, channel ChannelEnd) {
+	store := ctx.KVStore(k.key)
+	store.Set(ChannelKey(chanID), k.cdc.MustMarshalBinaryLengthPrefixed(channel))
+}
+
+// getOpenConnection returns the connection a channel is built on, which must
+// be open
+func (k Keeper) getOpenConnection(ctx sdk.Context, connID string) (ConnectionEnd, sdk.Error) {
+	conn, ok := k.GetConnection(ctx, connID)
+	if !ok {
+		return ConnectionEnd{}, ErrConnectionNotFound(k.codespace, connID)
+	}
+	if conn.State != ConnectionOpen {
+		return ConnectionEnd{}, ErrInvalidConnectionState(k.codespace, connID, conn.State)
+	}
+	return conn, nil
+}
+
+// verifyChannel checks that the counterparty stores the expected channel end
+func (k Keeper) verifyChannel(ctx sdk.Context, channel ChannelEnd, conn ConnectionEnd, expected ChannelEnd,
+	proofHeight int64, proof *merkle.Proof) sdk.Error {
+
+	return k.verifyMembership(ctx, conn.ClientID, proofHeight, proof,
+		ChannelKey(channel.CounterpartyChannelID), k.cdc.MustMarshalBinaryLengthPrefixed(expected))
+}
+
+// ChanOpenInit stores a channel end in INIT
+func (k Keeper) ChanOpenInit(ctx sdk.Context, chanID, connID, cpChanID string) sdk.Error {
+	if _, ok := k.GetChannel(ctx, chanID); ok {
+		return ErrChannelExists(k.codespace, chanID)
+	}
+	if _, err := k.getOpenConnection(ctx, connID); err != nil {
+		return err
+	}
+
+	k.setChannel(ctx, chanID, ChannelEnd{
+		State:                 ChannelInit,
+		ConnectionID:          connID,
+		CounterpartyChannelID: cpChanID,
+	})
+	return nil
+}
+
+// ChanOpenTry stores a channel end in TRYOPEN once the counterparty end is
+// proven to be in INIT
+func (k Keeper) ChanOpenTry(ctx sdk.Context, chanID, connID, cpChanID string,
+	proofHeight int64, proof *merkle.Proof) sdk.Error {
+
+	if _, ok := k.GetChannel(ctx, chanID); ok {
+		return ErrChannelExists(k.codespace, chanID)
+	}
+	conn, err := k.getOpenConnection(ctx, connID)
+	if err != nil {
+		return err
+	}
+
+	channel := ChannelEnd{
+		State:                 ChannelTryOpen,
+		ConnectionID:          connID,
+		CounterpartyChannelID: cpChanID,
+	}
+	expected := channel.counterpartyEnd(chanID, conn, ChannelInit)
+	if err := k.verifyChannel(ctx, channel, conn, expected, proofHeight, proof); err != nil {
+		return err
+	}
+
+	k.setChannel(ctx, chanID, channel)
+	return nil
+}
+
+// ChanOpenAck opens a channel in INIT once the counterparty end is proven to
+// be in TRYOPEN
+func (k Keeper) ChanOpenAck(ctx sdk.Context, chanID string, proofHeight int64, proof *merkle.Proof) sdk.Error {
+	return k.openChannel(ctx, chanID, ChannelInit, ChannelTryOpen, proofHeight, proof)
+}
+
+// ChanOpenConfirm opens a channel in TRYOPEN once the counterparty end is
+// proven to be OPEN
+func (k Keeper) ChanOpenConfirm(ctx sdk.Context, chanID string, proofHeight int64, proof *merkle.Proof) sdk.Error {
+	return k.openChannel(ctx, chanID, ChannelTryOpen, ChannelOpen, proofHeight, proof)
+}
+
+func (k Keeper) openChannel(ctx sdk.Context, chanID string, state, cpState ChannelState,
+	proofHeight int64, proof *merkle.Proof) sdk.Error {
+
+	channel, ok := k.GetChannel(ctx, chanID)
+	if !ok {
+		return ErrChannelNotFound(k.codespace, chanID)
+	}
+	if channel.State != state {
+		return ErrInvalidChannelState(k.codespace, chanID, channel.State)
+	}
+	conn, err := k.getOpenConnection(ctx, channel.ConnectionID)
+	if err != nil {
+		return err
+	}
+
+	expected := channel.counterpartyEnd(chanID, conn, cpState)
+	if err := k.verifyChannel(ctx, channel, conn, expected, proofHeight, proof); err != nil {
+		return err
+	}
+
+	channel.State = ChannelOpen
+	k.setChannel(ctx, chanID, channel)
+	return nil
+}
+
+// --------------------------
+// Packets
+
+// GetNextSequenceSend returns the sequence of the next packet sent on a
+// channel
+func (k Keeper) GetNextSequenceSend(ctx sdk.Context, chanID string) uint64 {
+	return k.getSequence(ctx, NextSequenceSendKey(chanID))
+}
+
+// GetNextSequenceRecv returns the sequence of the next packet expected on a
+// channel
+func (k Keeper) GetNextSequenceRecv(ctx sdk.Context, chanID string) uint64 {
+	return k.getSequence(ctx, NextSequenceRecvKey(chanID))
+}
+
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	var seq uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &seq)
+	return seq
+}
+
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(seq))
+}
+
+// GetPacketCommitment returns an outgoing packet committed on a channel
+func (k Keeper) GetPacketCommitment(ctx sdk.Context, chanID string, sequence uint64) (IBCPacket, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(PacketCommitmentKey(chanID, sequence))
+	if bz == nil {
+		return IBCPacket{}, false
+	}
+
+	var packet IBCPacket
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &packet)
+	return packet, true
+}
+
+// SendPacket assigns the next sequence and the counterparty channel to an
+// outgoing packet and commits it for the counterparty to prove
+func (k Keeper) SendPacket(ctx sdk.Context, chanID string, packet IBCPacket) (IBCPacket, sdk.Error) {
+	channel, ok := k.GetChannel(ctx, chanID)
+	if !ok {
+		return IBCPacket{}, ErrChannelNotFound(k.codespace, chanID)
+	}
+	if channel.State != ChannelOpen {
+		return IBCPacket{}, ErrInvalidChannelState(k.codespace, chanID, channel.State)
+	}
+
+	// the light client has already seen the counterparty reach the timeout
+	// height, so the packet could only ever be refunded
+	conn, err := k.getOpenConnection(ctx, channel.ConnectionID)
+	if err != nil {
+		return IBCPacket{}, err
+	}
+	client, _ := k.GetClientState(ctx, conn.ClientID)
+	if packet.TimedOut(client.LatestHeight) {
+		return IBCPacket{}, ErrInvalidPacket(k.codespace,
+			fmt.Sprintf("counterparty is already past timeout height %d", packet.TimeoutHeight))
+	}
+
+	seq := k.GetNextSequenceSend(ctx, chanID)
+	packet.Sequence = seq
+	packet.SrcChannel = chanID
+	packet.DestChannel = channel.CounterpartyChannelID
+
+	store := ctx.KVStore(k.key)
+	store.Set(PacketCommitmentKey(chanID, seq), k.cdc.MustMarshalBinaryLengthPrefixed(packet))
+	k.setSequence(ctx, NextSequenceSendKey(chanID), seq+1)
+
+	return packet, nil
+}
+
+// RecvPacket accepts the next packet of a channel once it is proven to be
+// committed on the counterparty. Packets are accepted even after they timed
+// out, so that the channel keeps its order; the caller must not execute those
+// and acknowledge them as failed instead.
+func (k Keeper) RecvPacket(ctx sdk.Context, packet IBCPacket, proofHeight int64, proof *merkle.Proof) sdk.Error {
+	chanID := packet.DestChannel
+	channel, ok := k.GetChannel(ctx, chanID)
+	if !ok {
+		return ErrChannelNotFound(k.codespace, chanID)
+	}
+	if channel.State != ChannelOpen {
+		return ErrInvalidChannelState(k.codespace, chanID, channel.State)
+	}
+	if packet.SrcChannel != channel.CounterpartyChannelID {
+		return ErrInvalidPacket(k.codespace, "packet was not sent on the counterparty channel")
+	}
+
+	seq := k.GetNextSequenceRecv(ctx, chanID)
+	if packet.Sequence != seq {
+		return ErrInvalidSequence(k.codespace)
+	}
+
+	conn, err := k.getOpenConnection(ctx, channel.ConnectionID)
+	if err != nil {
+		return err
+	}
+
+	err = k.verifyMembership(ctx, conn.ClientID, proofHeight, proof,
+		PacketCommitmentKey(packet.SrcChannel, packet.Sequence), k.cdc.MustMarshalBinaryLengthPrefixed(packet))
+	if err != nil {
+		return err
+	}
+
+	k.setSequence(ctx, NextSequenceRecvKey(chanID), seq+1)
+	return nil
+}
+
+// GetAcknowledgement returns the acknowledgement written for a received packet
+func (k Keeper) GetAcknowledgement(ctx sdk.Context, chanID string, sequence uint64) (IBCAcknowledgement, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(AcknowledgementKey(chanID, sequence))
+	if bz == nil {
+		return IBCAcknowledgement{}, false
+	}
+
+	var ack IBCAcknowledgement
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ack)
+	return ack, true
+}
+
+// SetAcknowledgement writes the acknowledgement of a received packet for the
+// counterparty to prove
+func (k Keeper) SetAcknowledgement(ctx sdk.Context, chanID string, sequence uint64, ack IBCAcknowledgement) {
+	store := ctx.KVStore(k.key)
+	store.Set(AcknowledgementKey(chanID, sequence), k.cdc.MustMarshalBinaryLengthPrefixed(ack))
+}
+
+// AcknowledgePacket clears the commitment of a sent packet once the
+// acknowledgement written by the counterparty is proven
+func (k Keeper) AcknowledgePacket(ctx sdk.Context, packet IBCPacket, ack IBCAcknowledgement,
+	proofHeight int64, proof *merkle.Proof) sdk.Error {
+
+	conn, err := k.getSentPacketConnection(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	err = k.verifyMembership(ctx, conn.ClientID, proofHeight, proof,
+		AcknowledgementKey(packet.DestChannel, packet.Sequence), k.cdc.MustMarshalBinaryLengthPrefixed(ack))
+	if err != nil {
+		return err
+	}
+
+	k.deletePacketCommitment(ctx, packet.SrcChannel, packet.Sequence)
+	return nil
+}
+
+// TimeoutPacket clears the commitment of a sent packet once it is proven that
+// the counterparty reached the timeout height without receiving it. The
+// counterparty acknowledges every packet it receives, so the absence of an
+// acknowledgement at or after the timeout height proves non-receipt.
+func (k Keeper) TimeoutPacket(ctx sdk.Context, packet IBCPacket, proofHeight int64, proof *merkle.Proof) sdk.Error {
+	conn, err := k.getSentPacketConnection(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	// the state proven at proofHeight includes every block before it, so any
+	// packet received before the timeout height is acknowledged in it
+	if !packet.TimedOut(proofHeight) {
+		return ErrPacketNotTimedOut(k.codespace, packet.TimeoutHeight, proofHeight)
+	}
+
+	err = k.verifyNonMembership(ctx, conn.ClientID, proofHeight, proof,
+		AcknowledgementKey(packet.DestChannel, packet.Sequence))
+	if err != nil {
+		return err
+	}
+
+	k.deletePacketCommitment(ctx, packet.SrcChannel, packet.Sequence)
+	return nil
+}
+
+// getSentPacketConnection checks that the packet is still committed on its
+// source channel and returns the connection of the channel
+func (k Keeper) getSentPacketConnection(ctx sdk.Context, packet IBCPacket) (ConnectionEnd, sdk.Error) {
+	chanID := packet.SrcChannel
+	channel, ok := k.GetChannel(ctx, chanID)
+	if !ok {
+		return ConnectionEnd{}, ErrChannelNotFound(k.codespace, chanID)
+	}
+	if packet.DestChannel != channel.CounterpartyChannelID {
+		return ConnectionEnd{}, ErrInvalidPacket(k.codespace, "packet was not sent to the counterparty channel")
+	}
+
+	commitment, ok := k.GetPacketCommitment(ctx, chanID, packet.Sequence)
+	if !ok {
+		return ConnectionEnd{}, ErrInvalidPacket(k.codespace,
+			fmt.Sprintf("no packet with sequence %d is pending on channel %s", packet.Sequence, chanID))
+	}
+	if !bytes.Equal(k.cdc.MustMarshalBinaryLengthPrefixed(commitment), k.cdc.MustMarshalBinaryLengthPrefixed(packet)) {
+		return ConnectionEnd{}, ErrInvalidPacket(k.codespace, "packet does not match the committed packet")
+	}
+
+	return k.getOpenConnection(ctx, channel.ConnectionID)
+}
+
+func (k Keeper) deletePacketCommitment(ctx sdk.Context, chanID string, sequence uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(PacketCommitmentKey(chanID, sequence))
+}
+
+// --------------------------
+// Escrow and vouchers
+
+// GetEscrowedCoins returns the native coins escrowed for packets sent on a
+// channel, which are released when vouchers for them come back
+func (k Keeper) GetEscrowedCoins(ctx sdk.Context, chanID string) sdk.Coins {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(EscrowKey(chanID))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	var coins sdk.Coins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &coins)
+	return coins
+}
+
+func (k Keeper) setEscrowedCoins(ctx sdk.Context, chanID string, coins sdk.Coins) {
+	store := ctx.KVStore(k.key)
+	if coins.IsZero() {
+		store.Delete(EscrowKey(chanID))
+		return
+	}
+	store.Set(EscrowKey(chanID), k.cdc.MustMarshalBinaryLengthPrefixed(coins))
+}
+
+// IterateEscrowedCoins iterates over the escrowed coins of every channel
+func (k Keeper) IterateEscrowedCoins(ctx sdk.Context, fn func(chanID string, coins sdk.Coins) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, EscrowKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		chanID := string(iter.Key()[len(EscrowKeyPrefix):])
+
+		var coins sdk.Coins
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &coins)
+		if fn(chanID, coins) {
+			break
+		}
+	}
+}
+
+// GetVoucherSupply returns the total supply of the vouchers minted for coins
+// that arrived from other chains
+func (k Keeper) GetVoucherSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(VoucherSupplyKey)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	var coins sdk.Coins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &coins)
+	return coins
+}
+
+func (k Keeper) setVoucherSupply(ctx sdk.Context, coins sdk.Coins) {
+	store := ctx.KVStore(k.key)
+	if coins.IsZero() {
+		store.Delete(VoucherSupplyKey)
+		return
+	}
+	store.Set(VoucherSupplyKey, k.cdc.MustMarshalBinaryLengthPrefixed(coins))
+}
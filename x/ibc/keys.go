@@ -0,0 +1,91 @@
+package ibc
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName is the name of the IBC module
+	ModuleName = "ibc"
+
+	// StoreKey is the name of the IBC store. A counterparty chain proves its
+	// state against the same store name, so every chain taking part in IBC
+	// must mount the module under it.
+	StoreKey = "ibc"
+
+	// RouterKey is the message route for the IBC module
+	RouterKey = "ibc"
+)
+
+var (
+	// EscrowKeyPrefix prefixes the coins escrowed for each channel
+	EscrowKeyPrefix = []byte("escrows/")
+
+	// VoucherSupplyKey stores the total supply of vouchers minted for coins
+	// that arrived from other chains
+	VoucherSupplyKey = []byte("vouchers")
+)
+
+// Keys are human readable so that relayers can query them through the
+// "/store/ibc/key" ABCI path and prove them with the returned merkle proof.
+//
+// - clients/<clientID>/state                   -> ClientState
+// - clients/<clientID>/consensus/<height>      -> ConsensusState
+// - connections/<connID>                       -> ConnectionEnd
+// - channels/<chanID>                          -> ChannelEnd
+// - channels/<chanID>/nextSequenceSend         -> uint64
+// - channels/<chanID>/nextSequenceRecv         -> uint64
+// - commitments/<chanID>/<sequence>            -> IBCPacket
+// - acknowledgements/<chanID>/<sequence>       -> IBCAcknowledgement
+// - escrows/<chanID>                           -> sdk.Coins
+// - vouchers                                   -> sdk.Coins
+
+// ClientStateKey stores the state of a light client
+func ClientStateKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("clients/%s/state", clientID))
+}
+
+// ConsensusStateKey stores the consensus state of a light client verified at
+// a given height
+func ConsensusStateKey(clientID string, height int64) []byte {
+	return []byte(fmt.Sprintf("clients/%s/consensus/%d", clientID, height))
+}
+
+// ConnectionKey stores a connection end
+func ConnectionKey(connID string) []byte {
+	return []byte(fmt.Sprintf("connections/%s", connID))
+}
+
+// ChannelKey stores a channel end
+func ChannelKey(chanID string) []byte {
+	return []byte(fmt.Sprintf("channels/%s", chanID))
+}
+
+// NextSequenceSendKey stores the sequence of the next packet sent on a channel
+func NextSequenceSendKey(chanID string) []byte {
+	return []byte(fmt.Sprintf("channels/%s/nextSequenceSend", chanID))
+}
+
+// NextSequenceRecvKey stores the sequence of the next packet expected on a
+// channel
+func NextSequenceRecvKey(chanID string) []byte {
+	return []byte(fmt.Sprintf("channels/%s/nextSequenceRecv", chanID))
+}
+
+// PacketCommitmentKey stores an outgoing packet, which the receiving chain
+// proves against this chain's state before accepting it
+func PacketCommitmentKey(chanID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("commitments/%s/%d", chanID, sequence))
+}
+
+// AcknowledgementKey stores the acknowledgement of a received packet. The
+// sending chain refunds a timed out packet once it proves that this key is
+// absent.
+func AcknowledgementKey(chanID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("acknowledgements/%s/%d", chanID, sequence))
+}
+
+// EscrowKey stores the native coins escrowed for packets sent on a channel
+func EscrowKey(chanID string) []byte {
+	return []byte(fmt.Sprintf("%s%s", EscrowKeyPrefix, chanID))
+}
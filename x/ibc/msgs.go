@@ -0,0 +1,375 @@
+package ibc
+
+import (
+	"regexp"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// identifiers are used in store keys, so they are restricted to characters
+// that need no escaping in a key path
+var reIdentifier = regexp.MustCompile(`^[a-zA-Z0-9\.\-_]{1,32}$`)
+
+// channel identifiers also prefix the denominations of the vouchers minted for
+// coins arriving on the channel, so they are restricted further to characters
+// that are valid in a denomination
+var reChannelIdentifier = regexp.MustCompile(`^[a-z][a-z0-9]{1,15}$`)
+
+func validateIdentifier(id string) sdk.Error {
+	if !reIdentifier.MatchString(id) {
+		return ErrInvalidIdentifier(DefaultCodespace, id)
+	}
+	return nil
+}
+
+func validateChannelIdentifier(id string) sdk.Error {
+	if !reChannelIdentifier.MatchString(id) {
+		return ErrInvalidIdentifier(DefaultCodespace, id)
+	}
+	return nil
+}
+
+func validateProof(proof *merkle.Proof, height int64) sdk.Error {
+	if proof == nil || len(proof.Ops) == 0 {
+		return ErrInvalidProof(DefaultCodespace, "empty proof")
+	}
+	if height <= 0 {
+		return ErrInvalidProof(DefaultCodespace, "proof height must be positive")
+	}
+	return nil
+}
+
+func mustSortedSignBytes(msg interface{}) []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ----------------------------------
+// Light client messages
+
+// MsgCreateClient creates a light client of a counterparty chain from a
+// trusted consensus state
+type MsgCreateClient struct {
+	ClientID       string         `json:"client_id"`
+	ConsensusState ConsensusState `json:"consensus_state"`
+	Signer         sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgCreateClient(clientID string, cs ConsensusState, signer sdk.AccAddress) MsgCreateClient {
+	return MsgCreateClient{
+		ClientID:       clientID,
+		ConsensusState: cs,
+		Signer:         signer,
+	}
+}
+
+// nolint
+func (msg MsgCreateClient) Route() string                { return RouterKey }
+func (msg MsgCreateClient) Type() string                 { return "create_client" }
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgCreateClient) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateClient) ValidateBasic() sdk.Error {
+	if err := validateIdentifier(msg.ClientID); err != nil {
+		return err
+	}
+	if err := msg.ConsensusState.ValidateBasic(); err != nil {
+		return ErrInvalidHeader(DefaultCodespace, err.Error())
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// MsgUpdateClient updates a light client with a new header of the
+// counterparty chain
+type MsgUpdateClient struct {
+	ClientID string         `json:"client_id"`
+	Header   Header         `json:"header"`
+	Signer   sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgUpdateClient(clientID string, header Header, signer sdk.AccAddress) MsgUpdateClient {
+	return MsgUpdateClient{
+		ClientID: clientID,
+		Header:   header,
+		Signer:   signer,
+	}
+}
+
+// nolint
+func (msg MsgUpdateClient) Route() string                { return RouterKey }
+func (msg MsgUpdateClient) Type() string                 { return "update_client" }
+func (msg MsgUpdateClient) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgUpdateClient) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateClient) ValidateBasic() sdk.Error {
+	if err := validateIdentifier(msg.ClientID); err != nil {
+		return err
+	}
+	if msg.Header.SignedHeader.Header == nil || msg.Header.SignedHeader.Commit == nil {
+		return ErrInvalidHeader(DefaultCodespace, "missing header or commit")
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// ----------------------------------
+// Connection handshake messages
+
+// MsgConnOpenInit starts the connection handshake on the first chain
+type MsgConnOpenInit struct {
+	ConnectionID             string         `json:"connection_id"`
+	ClientID                 string         `json:"client_id"`
+	CounterpartyConnectionID string         `json:"counterparty_connection_id"`
+	CounterpartyClientID     string         `json:"counterparty_client_id"`
+	Signer                   sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgConnOpenInit) Route() string                { return RouterKey }
+func (msg MsgConnOpenInit) Type() string                 { return "connection_open_init" }
+func (msg MsgConnOpenInit) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgConnOpenInit) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnOpenInit) ValidateBasic() sdk.Error {
+	for _, id := range []string{msg.ConnectionID, msg.ClientID, msg.CounterpartyConnectionID, msg.CounterpartyClientID} {
+		if err := validateIdentifier(id); err != nil {
+			return err
+		}
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// MsgConnOpenTry answers MsgConnOpenInit on the second chain, proving that the
+// counterparty connection end is in INIT
+type MsgConnOpenTry struct {
+	ConnectionID             string         `json:"connection_id"`
+	ClientID                 string         `json:"client_id"`
+	CounterpartyConnectionID string         `json:"counterparty_connection_id"`
+	CounterpartyClientID     string         `json:"counterparty_client_id"`
+	Proof                    *merkle.Proof  `json:"proof"`
+	ProofHeight              int64          `json:"proof_height"`
+	Signer                   sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgConnOpenTry) Route() string                { return RouterKey }
+func (msg MsgConnOpenTry) Type() string                 { return "connection_open_try" }
+func (msg MsgConnOpenTry) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgConnOpenTry) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnOpenTry) ValidateBasic() sdk.Error {
+	for _, id := range []string{msg.ConnectionID, msg.ClientID, msg.CounterpartyConnectionID, msg.CounterpartyClientID} {
+		if err := validateIdentifier(id); err != nil {
+			return err
+		}
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// MsgConnOpenAck opens the connection on the first chain, proving that the
+// counterparty connection end is in TRYOPEN
+type MsgConnOpenAck struct {
+	ConnectionID string         `json:"connection_id"`
+	Proof        *merkle.Proof  `json:"proof"`
+	ProofHeight  int64          `json:"proof_height"`
+	Signer       sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgConnOpenAck) Route() string                { return RouterKey }
+func (msg MsgConnOpenAck) Type() string                 { return "connection_open_ack" }
+func (msg MsgConnOpenAck) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgConnOpenAck) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnOpenAck) ValidateBasic() sdk.Error {
+	if err := validateIdentifier(msg.ConnectionID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// MsgConnOpenConfirm opens the connection on the second chain, proving that
+// the counterparty connection end is OPEN
+type MsgConnOpenConfirm struct {
+	ConnectionID string         `json:"connection_id"`
+	Proof        *merkle.Proof  `json:"proof"`
+	ProofHeight  int64          `json:"proof_height"`
+	Signer       sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgConnOpenConfirm) Route() string                { return RouterKey }
+func (msg MsgConnOpenConfirm) Type() string                 { return "connection_open_confirm" }
+func (msg MsgConnOpenConfirm) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgConnOpenConfirm) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnOpenConfirm) ValidateBasic() sdk.Error {
+	if err := validateIdentifier(msg.ConnectionID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// ----------------------------------
+// Channel handshake messages
+
+// MsgChanOpenInit starts the channel handshake on the first chain
+type MsgChanOpenInit struct {
+	ChannelID             string         `json:"channel_id"`
+	ConnectionID          string         `json:"connection_id"`
+	CounterpartyChannelID string         `json:"counterparty_channel_id"`
+	Signer                sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgChanOpenInit) Route() string                { return RouterKey }
+func (msg MsgChanOpenInit) Type() string                 { return "channel_open_init" }
+func (msg MsgChanOpenInit) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgChanOpenInit) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChanOpenInit) ValidateBasic() sdk.Error {
+	for _, id := range []string{msg.ChannelID, msg.CounterpartyChannelID} {
+		if err := validateChannelIdentifier(id); err != nil {
+			return err
+		}
+	}
+	if err := validateIdentifier(msg.ConnectionID); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// MsgChanOpenTry answers MsgChanOpenInit on the second chain, proving that the
+// counterparty channel end is in INIT
+type MsgChanOpenTry struct {
+	ChannelID             string         `json:"channel_id"`
+	ConnectionID          string         `json:"connection_id"`
+	CounterpartyChannelID string         `json:"counterparty_channel_id"`
+	Proof                 *merkle.Proof  `json:"proof"`
+	ProofHeight           int64          `json:"proof_height"`
+	Signer                sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgChanOpenTry) Route() string                { return RouterKey }
+func (msg MsgChanOpenTry) Type() string                 { return "channel_open_try" }
+func (msg MsgChanOpenTry) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgChanOpenTry) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChanOpenTry) ValidateBasic() sdk.Error {
+	for _, id := range []string{msg.ChannelID, msg.CounterpartyChannelID} {
+		if err := validateChannelIdentifier(id); err != nil {
+			return err
+		}
+	}
+	if err := validateIdentifier(msg.ConnectionID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// MsgChanOpenAck opens the channel on the first chain, proving that the
+// counterparty channel end is in TRYOPEN
+type MsgChanOpenAck struct {
+	ChannelID   string         `json:"channel_id"`
+	Proof       *merkle.Proof  `json:"proof"`
+	ProofHeight int64          `json:"proof_height"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgChanOpenAck) Route() string                { return RouterKey }
+func (msg MsgChanOpenAck) Type() string                 { return "channel_open_ack" }
+func (msg MsgChanOpenAck) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgChanOpenAck) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChanOpenAck) ValidateBasic() sdk.Error {
+	if err := validateChannelIdentifier(msg.ChannelID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
+
+// MsgChanOpenConfirm opens the channel on the second chain, proving that the
+// counterparty channel end is OPEN
+type MsgChanOpenConfirm struct {
+	ChannelID   string         `json:"channel_id"`
+	Proof       *merkle.Proof  `json:"proof"`
+	ProofHeight int64          `json:"proof_height"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// nolint
+func (msg MsgChanOpenConfirm) Route() string                { return RouterKey }
+func (msg MsgChanOpenConfirm) Type() string                 { return "channel_open_confirm" }
+func (msg MsgChanOpenConfirm) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg MsgChanOpenConfirm) GetSignBytes() []byte         { return mustSortedSignBytes(msg) }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChanOpenConfirm) ValidateBasic() sdk.Error {
+	if err := validateChannelIdentifier(msg.ChannelID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
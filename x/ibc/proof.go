@@ -0,0 +1,39 @@
+package ibc
+
+import (
+	"errors"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"my-cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// verifyMembership checks a merkle proof, as returned by a "/store/ibc/key"
+// query against the counterparty, that value is stored under key in the
+// counterparty's IBC store whose multistore root is root.
+func verifyMembership(root []byte, proof *merkle.Proof, key, value []byte) error {
+	if proof == nil {
+		return errors.New("empty proof")
+	}
+	prt := rootmulti.DefaultProofRuntime()
+	return prt.VerifyValue(proof, root, proofKeyPath(key), value)
+}
+
+// verifyNonMembership checks a merkle proof, as returned by a "/store/ibc/key"
+// query against the counterparty, that nothing is stored under key in the
+// counterparty's IBC store whose multistore root is root.
+func verifyNonMembership(root []byte, proof *merkle.Proof, key []byte) error {
+	if proof == nil {
+		return errors.New("empty proof")
+	}
+	prt := rootmulti.DefaultProofRuntime()
+	return prt.VerifyAbsence(proof, root, proofKeyPath(key))
+}
+
+// proofKeyPath is the key path of a key in the counterparty's IBC store
+func proofKeyPath(key []byte) string {
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(StoreKey), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+	return kp.String()
+}
@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/ibc"
+)
+
+// AllInvariants runs all invariants of the IBC module
+func AllInvariants(k ibc.Keeper, ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		err := EscrowInvariant(k, ak)(ctx)
+		if err != nil {
+			return err
+		}
+		err = VoucherSupplyInvariant(k, ak)(ctx)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// EscrowInvariant checks that the escrow account of every channel holds at
+// least the coins escrowed for it
+func EscrowInvariant(k ibc.Keeper, ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+		k.IterateEscrowedCoins(ctx, func(chanID string, escrowed sdk.Coins) (stop bool) {
+			var held sdk.Coins
+			if acc := ak.GetAccount(ctx, ibc.EscrowAddress(chanID)); acc != nil {
+				held = acc.GetCoins()
+			}
+
+			if !held.IsAllGTE(escrowed) {
+				err = fmt.Errorf("escrow account of channel %s holds %s, but %s are escrowed",
+					chanID, held, escrowed)
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
+
+// VoucherSupplyInvariant checks that the accounts hold exactly the vouchers
+// minted for coins from other chains
+func VoucherSupplyInvariant(k ibc.Keeper, ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		total := sdk.Coins{}
+		ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+			total = total.Add(acc.GetCoins())
+			return false
+		})
+
+		for _, voucher := range k.GetVoucherSupply(ctx) {
+			held := total.AmountOf(voucher.Denom)
+			if !held.Equal(voucher.Amount) {
+				return fmt.Errorf("accounts hold %s%s, but %s were minted",
+					held, voucher.Denom, voucher)
+			}
+		}
+		return nil
+	}
+}
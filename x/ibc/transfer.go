@@ -0,0 +1,160 @@
+package ibc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Coins leave a chain in one of two ways. Native coins are escrowed for the
+// channel they are sent on, and the receiving chain mints vouchers for them
+// whose denomination is prefixed with its end of the channel. Vouchers sent
+// back over the channel they arrived on are burned, and the chain they came
+// from releases the escrowed coins they stand for.
+//
+// A chain therefore never mints a native denomination for another chain: it
+// only releases what it escrowed itself.
+
+// VoucherDenom returns the denomination of the vouchers minted for coins of
+// denom that arrive on a channel
+func VoucherDenom(chanID, denom string) string {
+	return fmt.Sprintf("%s/%s", chanID, denom)
+}
+
+// EscrowAddress returns the account that holds the native coins escrowed for
+// packets sent on a channel
+func EscrowAddress(chanID string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("ibcEscrow/%s", chanID))))
+}
+
+// splitReturning splits coins into those whose denomination carries the
+// voucher prefix of the channel, with the prefix removed, and the others
+func splitReturning(chanID string, coins sdk.Coins) (returning, other sdk.Coins) {
+	prefix := VoucherDenom(chanID, "")
+	for _, coin := range coins {
+		if strings.HasPrefix(coin.Denom, prefix) {
+			returning = append(returning, sdk.Coin{Denom: strings.TrimPrefix(coin.Denom, prefix), Amount: coin.Amount})
+		} else {
+			other = append(other, coin)
+		}
+	}
+	return returning.Sort(), other
+}
+
+// voucherCoins returns the vouchers minted for coins arriving on a channel
+func voucherCoins(chanID string, coins sdk.Coins) sdk.Coins {
+	vouchers := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		vouchers[i] = sdk.Coin{Denom: VoucherDenom(chanID, coin.Denom), Amount: coin.Amount}
+	}
+	return vouchers.Sort()
+}
+
+// sendCoins takes the coins of a packet sent on a channel from the sender,
+// burning the vouchers that return over the channel and escrowing the rest
+func sendCoins(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, chanID string, sender sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	returning, escrowed := splitReturning(chanID, coins)
+
+	if !returning.Empty() {
+		vouchers := voucherCoins(chanID, returning)
+		supply, hasNeg := k.GetVoucherSupply(ctx).SafeSub(vouchers)
+		if hasNeg {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("%s were never minted on this chain", vouchers))
+		}
+		if err := sk.SendCoinsFromAccountToModule(ctx, sender, ModuleName, vouchers); err != nil {
+			return err
+		}
+		if err := sk.BurnCoins(ctx, ModuleName, vouchers); err != nil {
+			return err
+		}
+		k.setVoucherSupply(ctx, supply)
+	}
+
+	if !escrowed.Empty() {
+		if _, err := ck.SendCoins(ctx, sender, EscrowAddress(chanID), escrowed); err != nil {
+			return err
+		}
+		k.setEscrowedCoins(ctx, chanID, k.GetEscrowedCoins(ctx, chanID).Add(escrowed))
+	}
+
+	return nil
+}
+
+// receiveCoins gives the coins of a packet received on its destination
+// channel to the recipient. Coins coming home are released from the escrow of
+// the channel, and vouchers are minted for all others.
+func receiveCoins(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, packet IBCPacket) sdk.Error {
+	chanID := packet.DestChannel
+	released, minted := splitReturning(packet.SrcChannel, packet.Coins)
+
+	if !released.Empty() {
+		if err := releaseEscrow(ctx, k, ck, chanID, packet.DestAddr, released); err != nil {
+			return err
+		}
+	}
+
+	if !minted.Empty() {
+		vouchers := voucherCoins(chanID, minted)
+		if !vouchers.IsValid() {
+			return sdk.ErrInvalidCoins(fmt.Sprintf("invalid voucher denominations %s", vouchers))
+		}
+		if err := mintVouchers(ctx, k, sk, packet.DestAddr, vouchers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refundCoins returns the coins of a packet that was not executed by the
+// destination chain to the sender, undoing sendCoins
+func refundCoins(ctx sdk.Context, k Keeper, ck BankKeeper, sk SupplyKeeper, packet IBCPacket) sdk.Error {
+	chanID := packet.SrcChannel
+	returning, escrowed := splitReturning(chanID, packet.Coins)
+
+	if !returning.Empty() {
+		vouchers := voucherCoins(chanID, returning)
+		if err := mintVouchers(ctx, k, sk, packet.SrcAddr, vouchers); err != nil {
+			return err
+		}
+	}
+
+	if !escrowed.Empty() {
+		if err := releaseEscrow(ctx, k, ck, chanID, packet.SrcAddr, escrowed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mintVouchers mints vouchers in the IBC module account and gives them to the
+// recipient
+func mintVouchers(ctx sdk.Context, k Keeper, sk SupplyKeeper, to sdk.AccAddress, vouchers sdk.Coins) sdk.Error {
+	if err := sk.MintCoins(ctx, ModuleName, vouchers); err != nil {
+		return err
+	}
+	if err := sk.SendCoinsFromModuleToAccount(ctx, ModuleName, to, vouchers); err != nil {
+		return err
+	}
+	k.setVoucherSupply(ctx, k.GetVoucherSupply(ctx).Add(vouchers))
+	return nil
+}
+
+// releaseEscrow releases escrowed coins of a channel. A channel never
+// releases more than was escrowed for it, whatever its counterparty claims.
+func releaseEscrow(ctx sdk.Context, k Keeper, ck BankKeeper, chanID string, to sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	escrowed, hasNeg := k.GetEscrowedCoins(ctx, chanID).SafeSub(coins)
+	if hasNeg {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf("channel %s does not escrow %s", chanID, coins))
+	}
+
+	if _, err := ck.SendCoins(ctx, EscrowAddress(chanID), to, coins); err != nil {
+		return err
+	}
+	k.setEscrowedCoins(ctx, chanID, escrowed)
+	return nil
+}
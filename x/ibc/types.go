@@ -3,6 +3,8 @@ package ibc
 import (
 	"encoding/json"
 
+	"github.com/tendermint/tendermint/crypto/merkle"
+
 	codec "my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 )
@@ -13,6 +15,8 @@ var (
 
 func init() {
 	msgCdc = codec.New()
+	// light client headers and consensus states carry validator public keys
+	codec.RegisterCrypto(msgCdc)
 }
 
 // ------------------------------
@@ -20,27 +24,38 @@ func init() {
 
 // nolint - TODO rename to Packet as IBCPacket stutters (golint)
 // IBCPacket defines a piece of data that can be send between two separate
-// blockchains.
+// blockchains over a channel. The destination chain no longer executes the
+// packet from TimeoutHeight on, which lets the source chain refund it.
 type IBCPacket struct {
-	SrcAddr   sdk.AccAddress `json:"src_addr"`
-	DestAddr  sdk.AccAddress `json:"dest_addr"`
-	Coins     sdk.Coins      `json:"coins"`
-	SrcChain  string         `json:"src_chain"`
-	DestChain string         `json:"dest_chain"`
+	Sequence      uint64         `json:"sequence"`
+	SrcChannel    string         `json:"src_channel"`
+	DestChannel   string         `json:"dest_channel"`
+	SrcAddr       sdk.AccAddress `json:"src_addr"`
+	DestAddr      sdk.AccAddress `json:"dest_addr"`
+	Coins         sdk.Coins      `json:"coins"`
+	TimeoutHeight int64          `json:"timeout_height"`
 }
 
-func NewIBCPacket(srcAddr sdk.AccAddress, destAddr sdk.AccAddress, coins sdk.Coins,
-	srcChain string, destChain string) IBCPacket {
+func NewIBCPacket(sequence uint64, srcChannel, destChannel string,
+	srcAddr sdk.AccAddress, destAddr sdk.AccAddress, coins sdk.Coins, timeoutHeight int64) IBCPacket {
 
 	return IBCPacket{
-		SrcAddr:   srcAddr,
-		DestAddr:  destAddr,
-		Coins:     coins,
-		SrcChain:  srcChain,
-		DestChain: destChain,
+		Sequence:      sequence,
+		SrcChannel:    srcChannel,
+		DestChannel:   destChannel,
+		SrcAddr:       srcAddr,
+		DestAddr:      destAddr,
+		Coins:         coins,
+		TimeoutHeight: timeoutHeight,
 	}
 }
 
+// TimedOut returns whether the packet has timed out at the given height of
+// the destination chain
+func (p IBCPacket) TimedOut(height int64) bool {
+	return height >= p.TimeoutHeight
+}
+
 //nolint
 func (p IBCPacket) GetSignBytes() []byte {
 	b, err := msgCdc.MarshalJSON(p)
@@ -52,26 +67,71 @@ func (p IBCPacket) GetSignBytes() []byte {
 
 // validator the ibc packey
 func (p IBCPacket) ValidateBasic() sdk.Error {
-	if p.SrcChain == p.DestChain {
-		return ErrIdenticalChains(DefaultCodespace).TraceSDK("")
+	if err := validateChannelIdentifier(p.SrcChannel); err != nil {
+		return err
+	}
+	if err := validateChannelIdentifier(p.DestChannel); err != nil {
+		return err
+	}
+	if p.DestAddr.Empty() {
+		return sdk.ErrInvalidAddress("missing destination address")
 	}
-	if !p.Coins.IsValid() {
-		return sdk.ErrInvalidCoins("")
+	if !p.Coins.IsValid() || !p.Coins.IsAllPositive() {
+		return sdk.ErrInvalidCoins(p.Coins.String())
+	}
+	if p.TimeoutHeight <= 0 {
+		return ErrInvalidPacket(DefaultCodespace, "timeout height must be positive")
 	}
 	return nil
 }
 
+// ------------------------------
+// IBCAcknowledgement
+
+// IBCAcknowledgement is written by the destination chain for every packet it
+// receives. The source chain refunds the packet if it was not executed.
+type IBCAcknowledgement struct {
+	Success bool   `json:"success"`
+	Log     string `json:"log"`
+}
+
+// nolint
+func NewSuccessAcknowledgement() IBCAcknowledgement {
+	return IBCAcknowledgement{Success: true}
+}
+func NewFailureAcknowledgement(log string) IBCAcknowledgement {
+	return IBCAcknowledgement{Success: false, Log: log}
+}
+
 // ----------------------------------
 // MsgIBCTransfer
 
 // nolint - TODO rename to TransferMsg as folks will reference with ibc.TransferMsg
-// MsgIBCTransfer defines how another module can send an IBCPacket.
+// MsgIBCTransfer sends coins to an address on the other end of a channel. The
+// coins are refunded if the destination chain has not received them by
+// TimeoutHeight, one of its own block heights.
 type MsgIBCTransfer struct {
-	IBCPacket
+	Channel       string         `json:"channel"`
+	SrcAddr       sdk.AccAddress `json:"src_addr"`
+	DestAddr      sdk.AccAddress `json:"dest_addr"`
+	Coins         sdk.Coins      `json:"coins"`
+	TimeoutHeight int64          `json:"timeout_height"`
+}
+
+func NewMsgIBCTransfer(channel string, srcAddr, destAddr sdk.AccAddress, coins sdk.Coins,
+	timeoutHeight int64) MsgIBCTransfer {
+
+	return MsgIBCTransfer{
+		Channel:       channel,
+		SrcAddr:       srcAddr,
+		DestAddr:      destAddr,
+		Coins:         coins,
+		TimeoutHeight: timeoutHeight,
+	}
 }
 
 // nolint
-func (msg MsgIBCTransfer) Route() string { return "ibc" }
+func (msg MsgIBCTransfer) Route() string { return RouterKey }
 func (msg MsgIBCTransfer) Type() string  { return "transfer" }
 
 // x/bank/tx.go MsgSend.GetSigners()
@@ -79,12 +139,27 @@ func (msg MsgIBCTransfer) GetSigners() []sdk.AccAddress { return []sdk.AccAddres
 
 // get the sign bytes for ibc transfer message
 func (msg MsgIBCTransfer) GetSignBytes() []byte {
-	return msg.IBCPacket.GetSignBytes()
+	return mustSortedSignBytes(msg)
 }
 
 // validate ibc transfer message
 func (msg MsgIBCTransfer) ValidateBasic() sdk.Error {
-	return msg.IBCPacket.ValidateBasic()
+	if err := validateChannelIdentifier(msg.Channel); err != nil {
+		return err
+	}
+	if msg.SrcAddr.Empty() {
+		return sdk.ErrInvalidAddress("missing source address")
+	}
+	if msg.DestAddr.Empty() {
+		return sdk.ErrInvalidAddress("missing destination address")
+	}
+	if !msg.Coins.IsValid() || !msg.Coins.IsAllPositive() {
+		return sdk.ErrInvalidCoins(msg.Coins.String())
+	}
+	if msg.TimeoutHeight <= 0 {
+		return ErrInvalidPacket(DefaultCodespace, "timeout height must be positive")
+	}
+	return nil
 }
 
 // ----------------------------------
@@ -92,31 +167,149 @@ func (msg MsgIBCTransfer) ValidateBasic() sdk.Error {
 
 // nolint - TODO rename to ReceiveMsg as folks will reference with ibc.ReceiveMsg
 // MsgIBCReceive defines the message that a relayer uses to post an IBCPacket
-// to the destination chain.
+// to the destination chain. The packet is only accepted if Proof shows that it
+// is committed on the source chain at ProofHeight, as seen by the light client
+// of the source chain.
 type MsgIBCReceive struct {
 	IBCPacket
-	Relayer  sdk.AccAddress
-	Sequence uint64
+	Proof       *merkle.Proof  `json:"proof"`
+	ProofHeight int64          `json:"proof_height"`
+	Relayer     sdk.AccAddress `json:"relayer"`
 }
 
 // nolint
-func (msg MsgIBCReceive) Route() string            { return "ibc" }
-func (msg MsgIBCReceive) Type() string             { return "receive" }
-func (msg MsgIBCReceive) ValidateBasic() sdk.Error { return msg.IBCPacket.ValidateBasic() }
+func (msg MsgIBCReceive) Route() string { return RouterKey }
+func (msg MsgIBCReceive) Type() string  { return "receive" }
 
 // x/bank/tx.go MsgSend.GetSigners()
 func (msg MsgIBCReceive) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Relayer} }
 
+// validate ibc receive message
+func (msg MsgIBCReceive) ValidateBasic() sdk.Error {
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Relayer.Empty() {
+		return sdk.ErrInvalidAddress("missing relayer address")
+	}
+	return msg.IBCPacket.ValidateBasic()
+}
+
 // get the sign bytes for ibc receive message
 func (msg MsgIBCReceive) GetSignBytes() []byte {
 	b, err := msgCdc.MarshalJSON(struct {
-		IBCPacket json.RawMessage
-		Relayer   sdk.AccAddress
-		Sequence  uint64
+		IBCPacket   json.RawMessage
+		Proof       *merkle.Proof
+		ProofHeight int64
+		Relayer     sdk.AccAddress
+	}{
+		IBCPacket:   json.RawMessage(msg.IBCPacket.GetSignBytes()),
+		Proof:       msg.Proof,
+		ProofHeight: msg.ProofHeight,
+		Relayer:     msg.Relayer,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ----------------------------------
+// MsgIBCAcknowledgement
+
+// MsgIBCAcknowledgement defines the message that a relayer uses to post the
+// acknowledgement of an IBCPacket back to the source chain. Proof must show
+// that the destination chain wrote the acknowledgement at ProofHeight.
+type MsgIBCAcknowledgement struct {
+	IBCPacket
+	Acknowledgement IBCAcknowledgement `json:"acknowledgement"`
+	Proof           *merkle.Proof      `json:"proof"`
+	ProofHeight     int64              `json:"proof_height"`
+	Relayer         sdk.AccAddress     `json:"relayer"`
+}
+
+// nolint
+func (msg MsgIBCAcknowledgement) Route() string { return RouterKey }
+func (msg MsgIBCAcknowledgement) Type() string  { return "acknowledgement" }
+
+// x/bank/tx.go MsgSend.GetSigners()
+func (msg MsgIBCAcknowledgement) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Relayer} }
+
+// validate ibc acknowledgement message
+func (msg MsgIBCAcknowledgement) ValidateBasic() sdk.Error {
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Relayer.Empty() {
+		return sdk.ErrInvalidAddress("missing relayer address")
+	}
+	return msg.IBCPacket.ValidateBasic()
+}
+
+// get the sign bytes for ibc acknowledgement message
+func (msg MsgIBCAcknowledgement) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(struct {
+		IBCPacket       json.RawMessage
+		Acknowledgement IBCAcknowledgement
+		Proof           *merkle.Proof
+		ProofHeight     int64
+		Relayer         sdk.AccAddress
+	}{
+		IBCPacket:       json.RawMessage(msg.IBCPacket.GetSignBytes()),
+		Acknowledgement: msg.Acknowledgement,
+		Proof:           msg.Proof,
+		ProofHeight:     msg.ProofHeight,
+		Relayer:         msg.Relayer,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ----------------------------------
+// MsgIBCTimeout
+
+// MsgIBCTimeout refunds an IBCPacket that the destination chain did not
+// receive in time. Proof must show that there is no acknowledgement of the
+// packet at a ProofHeight no lower than the packet's timeout height.
+type MsgIBCTimeout struct {
+	IBCPacket
+	Proof       *merkle.Proof  `json:"proof"`
+	ProofHeight int64          `json:"proof_height"`
+	Relayer     sdk.AccAddress `json:"relayer"`
+}
+
+// nolint
+func (msg MsgIBCTimeout) Route() string { return RouterKey }
+func (msg MsgIBCTimeout) Type() string  { return "timeout" }
+
+// x/bank/tx.go MsgSend.GetSigners()
+func (msg MsgIBCTimeout) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Relayer} }
+
+// validate ibc timeout message
+func (msg MsgIBCTimeout) ValidateBasic() sdk.Error {
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	if msg.Relayer.Empty() {
+		return sdk.ErrInvalidAddress("missing relayer address")
+	}
+	return msg.IBCPacket.ValidateBasic()
+}
+
+// get the sign bytes for ibc timeout message
+func (msg MsgIBCTimeout) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(struct {
+		IBCPacket   json.RawMessage
+		Proof       *merkle.Proof
+		ProofHeight int64
+		Relayer     sdk.AccAddress
 	}{
-		IBCPacket: json.RawMessage(msg.IBCPacket.GetSignBytes()),
-		Relayer:   msg.Relayer,
-		Sequence:  msg.Sequence,
+		IBCPacket:   json.RawMessage(msg.IBCPacket.GetSignBytes()),
+		Proof:       msg.Proof,
+		ProofHeight: msg.ProofHeight,
+		Relayer:     msg.Relayer,
 	})
 	if err != nil {
 		panic(err)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
 
 	sdk "my-cosmos/cosmos-sdk/types"
 )
@@ -18,6 +19,10 @@ func TestIBCPacketValidation(t *testing.T) {
 	}{
 		{true, constructIBCPacket(true)},
 		{false, constructIBCPacket(false)},
+		{false, NewIBCPacket(0, "", "channelb", sdk.AccAddress([]byte("source")), sdk.AccAddress([]byte("destination")), sdk.Coins{sdk.NewInt64Coin("atom", 10)}, 100)},
+		{false, NewIBCPacket(0, "channela", "channel/b", sdk.AccAddress([]byte("source")), sdk.AccAddress([]byte("destination")), sdk.Coins{sdk.NewInt64Coin("atom", 10)}, 100)},
+		{false, NewIBCPacket(0, "channela", "channelb", sdk.AccAddress([]byte("source")), nil, sdk.Coins{sdk.NewInt64Coin("atom", 10)}, 100)},
+		{false, NewIBCPacket(0, "channela", "channelb", sdk.AccAddress([]byte("source")), sdk.AccAddress([]byte("destination")), sdk.Coins{sdk.NewInt64Coin("atom", 10)}, 0)},
 	}
 
 	for i, tc := range cases {
@@ -34,22 +39,29 @@ func TestIBCPacketValidation(t *testing.T) {
 // MsgIBCTransfer Tests
 
 func TestIBCTransferMsg(t *testing.T) {
-	packet := constructIBCPacket(true)
-	msg := MsgIBCTransfer{packet}
+	msg := NewMsgIBCTransfer("channela", sdk.AccAddress([]byte("source")), sdk.AccAddress([]byte("destination")), sdk.Coins{sdk.NewInt64Coin("atom", 10)}, 100)
 
 	require.Equal(t, msg.Route(), "ibc")
+	require.Equal(t, msg.Type(), "transfer")
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress([]byte("source"))}, msg.GetSigners())
 }
 
 func TestIBCTransferMsgValidation(t *testing.T) {
-	validPacket := constructIBCPacket(true)
-	invalidPacket := constructIBCPacket(false)
+	src := sdk.AccAddress([]byte("source"))
+	dest := sdk.AccAddress([]byte("destination"))
+	coins := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
 
 	cases := []struct {
 		valid bool
 		msg   MsgIBCTransfer
 	}{
-		{true, MsgIBCTransfer{validPacket}},
-		{false, MsgIBCTransfer{invalidPacket}},
+		{true, NewMsgIBCTransfer("channela", src, dest, coins, 100)},
+		{false, NewMsgIBCTransfer("", src, dest, coins, 100)},
+		{false, NewMsgIBCTransfer("channela", nil, dest, coins, 100)},
+		{false, NewMsgIBCTransfer("channela", src, nil, coins, 100)},
+		{false, NewMsgIBCTransfer("channela", src, dest, sdk.Coins{}, 100)},
+		{false, NewMsgIBCTransfer("channela", src, dest, sdk.Coins{sdk.NewInt64Coin("atom", 0)}, 100)},
+		{false, NewMsgIBCTransfer("channela", src, dest, coins, 0)},
 	}
 
 	for i, tc := range cases {
@@ -67,21 +79,124 @@ func TestIBCTransferMsgValidation(t *testing.T) {
 
 func TestIBCReceiveMsg(t *testing.T) {
 	packet := constructIBCPacket(true)
-	msg := MsgIBCReceive{packet, sdk.AccAddress([]byte("relayer")), 0}
+	msg := MsgIBCReceive{packet, constructProof(), 1, sdk.AccAddress([]byte("relayer"))}
 
 	require.Equal(t, msg.Route(), "ibc")
+	require.Equal(t, msg.Type(), "receive")
 }
 
 func TestIBCReceiveMsgValidation(t *testing.T) {
 	validPacket := constructIBCPacket(true)
 	invalidPacket := constructIBCPacket(false)
+	relayer := sdk.AccAddress([]byte("relayer"))
 
 	cases := []struct {
 		valid bool
 		msg   MsgIBCReceive
 	}{
-		{true, MsgIBCReceive{validPacket, sdk.AccAddress([]byte("relayer")), 0}},
-		{false, MsgIBCReceive{invalidPacket, sdk.AccAddress([]byte("relayer")), 0}},
+		{true, MsgIBCReceive{validPacket, constructProof(), 1, relayer}},
+		{false, MsgIBCReceive{invalidPacket, constructProof(), 1, relayer}},
+		{false, MsgIBCReceive{validPacket, nil, 1, relayer}},
+		{false, MsgIBCReceive{validPacket, &merkle.Proof{}, 1, relayer}},
+		{false, MsgIBCReceive{validPacket, constructProof(), 0, relayer}},
+		{false, MsgIBCReceive{validPacket, constructProof(), 1, nil}},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+// -------------------------------
+// MsgIBCAcknowledgement and MsgIBCTimeout Tests
+
+func TestIBCAcknowledgementMsgValidation(t *testing.T) {
+	validPacket := constructIBCPacket(true)
+	invalidPacket := constructIBCPacket(false)
+	relayer := sdk.AccAddress([]byte("relayer"))
+	ack := NewSuccessAcknowledgement()
+
+	cases := []struct {
+		valid bool
+		msg   MsgIBCAcknowledgement
+	}{
+		{true, MsgIBCAcknowledgement{validPacket, ack, constructProof(), 1, relayer}},
+		{true, MsgIBCAcknowledgement{validPacket, NewFailureAcknowledgement("failed"), constructProof(), 1, relayer}},
+		{false, MsgIBCAcknowledgement{invalidPacket, ack, constructProof(), 1, relayer}},
+		{false, MsgIBCAcknowledgement{validPacket, ack, nil, 1, relayer}},
+		{false, MsgIBCAcknowledgement{validPacket, ack, constructProof(), 0, relayer}},
+		{false, MsgIBCAcknowledgement{validPacket, ack, constructProof(), 1, nil}},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+func TestIBCTimeoutMsgValidation(t *testing.T) {
+	validPacket := constructIBCPacket(true)
+	invalidPacket := constructIBCPacket(false)
+	relayer := sdk.AccAddress([]byte("relayer"))
+
+	cases := []struct {
+		valid bool
+		msg   MsgIBCTimeout
+	}{
+		{true, MsgIBCTimeout{validPacket, constructProof(), 100, relayer}},
+		{false, MsgIBCTimeout{invalidPacket, constructProof(), 100, relayer}},
+		{false, MsgIBCTimeout{validPacket, &merkle.Proof{}, 100, relayer}},
+		{false, MsgIBCTimeout{validPacket, constructProof(), -1, relayer}},
+		{false, MsgIBCTimeout{validPacket, constructProof(), 100, nil}},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+// -------------------------------
+// Handshake message Tests
+
+func TestHandshakeMsgValidation(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer"))
+
+	cases := []struct {
+		valid bool
+		msg   sdk.Msg
+	}{
+		{true, MsgConnOpenInit{"conn-a", "client-b", "conn-b", "client-a", signer}},
+		{false, MsgConnOpenInit{"conn-a", "", "conn-b", "client-a", signer}},
+		{false, MsgConnOpenInit{"conn-a", "client-b", "conn-b", "client-a", nil}},
+		{true, MsgConnOpenTry{"conn-b", "client-a", "conn-a", "client-b", constructProof(), 2, signer}},
+		{false, MsgConnOpenTry{"conn-b", "client-a", "conn-a", "client-b", nil, 2, signer}},
+		{true, MsgConnOpenAck{"conn-a", constructProof(), 2, signer}},
+		{false, MsgConnOpenAck{"conn-a", constructProof(), -1, signer}},
+		{true, MsgConnOpenConfirm{"conn-b", constructProof(), 2, signer}},
+		{false, MsgConnOpenConfirm{"", constructProof(), 2, signer}},
+		{true, MsgChanOpenInit{"chana", "conn-a", "chanb", signer}},
+		{false, MsgChanOpenInit{"chan-a", "conn-a", "chanb", signer}},
+		{false, MsgChanOpenInit{"chana", "conn-a", "a-channel-identifier-that-is-far-too-long", signer}},
+		{true, MsgChanOpenTry{"chanb", "conn-b", "chana", constructProof(), 2, signer}},
+		{false, MsgChanOpenTry{"chanb", "conn-b", "chana", constructProof(), 2, nil}},
+		{true, MsgChanOpenAck{"chana", constructProof(), 2, signer}},
+		{false, MsgChanOpenAck{"chana", nil, 2, signer}},
+		{true, MsgChanOpenConfirm{"chanb", constructProof(), 2, signer}},
+		{false, MsgChanOpenConfirm{"chan b", constructProof(), 2, signer}},
 	}
 
 	for i, tc := range cases {
@@ -101,11 +216,13 @@ func constructIBCPacket(valid bool) IBCPacket {
 	srcAddr := sdk.AccAddress([]byte("source"))
 	destAddr := sdk.AccAddress([]byte("destination"))
 	coins := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
-	srcChain := "source-chain"
-	destChain := "dest-chain"
 
 	if valid {
-		return NewIBCPacket(srcAddr, destAddr, coins, srcChain, destChain)
+		return NewIBCPacket(0, "channela", "channelb", srcAddr, destAddr, coins, 100)
 	}
-	return NewIBCPacket(srcAddr, destAddr, coins, srcChain, srcChain)
+	return NewIBCPacket(0, "channela", "channelb", srcAddr, destAddr, sdk.Coins{sdk.NewInt64Coin("atom", 0)}, 100)
+}
+
+func constructProof() *merkle.Proof {
+	return &merkle.Proof{Ops: []merkle.ProofOp{{Type: "test", Key: []byte("key"), Data: []byte("data")}}}
 }
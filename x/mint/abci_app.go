@@ -2,6 +2,7 @@ package mint
 
 import (
 	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
 )
 
 // Inflate every block, update inflation parameters once per hour
@@ -20,7 +21,17 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 
 	// mint coins, add to collected fees, update supply
 	mintedCoin := minter.BlockProvision(params)
-	k.fck.AddCollectedFees(ctx, sdk.Coins{mintedCoin})
+	if mintedCoin.IsZero() {
+		return
+	}
+
+	mintedCoins := sdk.Coins{mintedCoin}
+	if err := k.supplyKeeper.MintCoins(ctx, ModuleName, mintedCoins); err != nil {
+		panic(err)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, ModuleName, auth.FeeCollectorName, mintedCoins); err != nil {
+		panic(err)
+	}
 	k.sk.InflateSupply(ctx, mintedCoin.Amount)
 
 }
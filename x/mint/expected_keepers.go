@@ -11,7 +11,8 @@ type StakingKeeper interface {
 	InflateSupply(ctx sdk.Context, newTokens sdk.Int)
 }
 
-// expected fee collection keeper interface
-type FeeCollectionKeeper interface {
-	AddCollectedFees(sdk.Context, sdk.Coins) sdk.Coins
+// expected supply keeper
+type SupplyKeeper interface {
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
 }
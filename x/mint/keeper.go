@@ -8,22 +8,22 @@ import (
 
 // keeper of the staking store
 type Keeper struct {
-	storeKey   sdk.StoreKey
-	cdc        *codec.Codec
-	paramSpace params.Subspace
-	sk         StakingKeeper
-	fck        FeeCollectionKeeper
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	sk           StakingKeeper
+	supplyKeeper SupplyKeeper
 }
 
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey,
-	paramSpace params.Subspace, sk StakingKeeper, fck FeeCollectionKeeper) Keeper {
+	paramSpace params.Subspace, sk StakingKeeper, supplyKeeper SupplyKeeper) Keeper {
 
 	keeper := Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
-		sk:         sk,
-		fck:        fck,
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   paramSpace.WithKeyTable(ParamKeyTable()),
+		sk:           sk,
+		supplyKeeper: supplyKeeper,
 	}
 	return keeper
 }
@@ -46,6 +46,10 @@ func ParamKeyTable() params.KeyTable {
 }
 
 const (
+	// ModuleName is the name of the module, which owns the account the
+	// inflation is minted into
+	ModuleName = "mint"
+
 	// default paramspace for params keeper
 	DefaultParamspace = "mint"
 
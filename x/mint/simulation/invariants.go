@@ -0,0 +1,26 @@
+package simulation
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/mint"
+	"my-cosmos/cosmos-sdk/x/supply"
+)
+
+// SupplyInvariant checks that the supply of the minted denomination is the
+// total of the staking pool, which inflation adds to and slashing burns from
+func SupplyInvariant(k mint.Keeper, sk mint.StakingKeeper, supplyKeeper supply.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		denom := k.GetParams(ctx).MintDenom
+		supply := supplyKeeper.GetSupply(ctx, denom)
+		pool := sk.TotalTokens(ctx)
+
+		if !supply.Equal(pool) {
+			return fmt.Errorf("minted supply invariance:\n"+
+				"\tsupply of %s: %v\n"+
+				"\tstaking pool total tokens: %v", denom, supply, pool)
+		}
+		return nil
+	}
+}
@@ -28,12 +28,11 @@ const chainID = ""
 // capabilities aren't needed for testing.
 type App struct {
 	*bam.BaseApp
-	Cdc              *codec.Codec // Cdc is public since the codec is passed into the module anyways
-	KeyMain          *sdk.KVStoreKey
-	KeyAccount       *sdk.KVStoreKey
-	KeyFeeCollection *sdk.KVStoreKey
-	KeyParams        *sdk.KVStoreKey
-	TKeyParams       *sdk.TransientStoreKey
+	Cdc        *codec.Codec // Cdc is public since the codec is passed into the module anyways
+	KeyMain    *sdk.KVStoreKey
+	KeyAccount *sdk.KVStoreKey
+	KeyParams  *sdk.KVStoreKey
+	TKeyParams *sdk.TransientStoreKey
 
 	// TODO: Abstract this out from not needing to be auth specifically
 	AccountKeeper       auth.AccountKeeper
@@ -59,7 +58,6 @@ func NewApp() *App {
 		Cdc:              cdc,
 		KeyMain:          sdk.NewKVStoreKey(bam.MainStoreKey),
 		KeyAccount:       sdk.NewKVStoreKey(auth.StoreKey),
-		KeyFeeCollection: sdk.NewKVStoreKey("fee"),
 		KeyParams:        sdk.NewKVStoreKey("params"),
 		TKeyParams:       sdk.NewTransientStoreKey("transient_params"),
 		TotalCoinsSupply: sdk.Coins{},
@@ -74,10 +72,7 @@ func NewApp() *App {
 		app.ParamsKeeper.Subspace(auth.DefaultParamspace),
 		auth.ProtoBaseAccount,
 	)
-	app.FeeCollectionKeeper = auth.NewFeeCollectionKeeper(
-		app.Cdc,
-		app.KeyFeeCollection,
-	)
+	app.FeeCollectionKeeper = auth.NewFeeCollectionKeeper(app.AccountKeeper)
 
 	// Initialize the app. The chainers and blockers can be overwritten before
 	// calling complete setup.
@@ -94,7 +89,7 @@ func NewApp() *App {
 func (app *App) CompleteSetup(newKeys ...sdk.StoreKey) error {
 	newKeys = append(
 		newKeys,
-		app.KeyMain, app.KeyAccount, app.KeyParams, app.TKeyParams, app.KeyFeeCollection,
+		app.KeyMain, app.KeyAccount, app.KeyParams, app.TKeyParams,
 	)
 
 	for _, key := range newKeys {
@@ -123,7 +118,7 @@ func (app *App) InitChainer(ctx sdk.Context, _ abci.RequestInitChain) abci.Respo
 		app.AccountKeeper.SetAccount(ctx, acc)
 	}
 
-	auth.InitGenesis(ctx, app.AccountKeeper, app.FeeCollectionKeeper, auth.DefaultGenesisState())
+	auth.InitGenesis(ctx, app.AccountKeeper, auth.DefaultGenesisState())
 
 	return abci.ResponseInitChain{}
 }
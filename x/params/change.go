@@ -0,0 +1,66 @@
+package params
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// ParamChange describes a single parameter update targeting a subspace. Value
+// holds the JSON encoding of the new parameter, the same encoding the Subspace
+// uses in the store. Subkey is optional and only used for parameters stored
+// with SetWithSubkey.
+type ParamChange struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+	Subkey   string `json:"subkey,omitempty"`
+	Value    string `json:"value"`
+}
+
+func NewParamChange(subspace, key, value string) ParamChange {
+	return ParamChange{subspace, key, "", value}
+}
+
+func NewParamChangeWithSubkey(subspace, key, subkey, value string) ParamChange {
+	return ParamChange{subspace, key, subkey, value}
+}
+
+func (pc ParamChange) String() string {
+	if len(pc.Subkey) == 0 {
+		return fmt.Sprintf("%s/%s: %s", pc.Subspace, pc.Key, pc.Value)
+	}
+	return fmt.Sprintf("%s/%s/%s: %s", pc.Subspace, pc.Key, pc.Subkey, pc.Value)
+}
+
+// ParamChanges is a list of parameter updates
+type ParamChanges []ParamChange
+
+func (pcs ParamChanges) String() string {
+	out := ""
+	for _, pc := range pcs {
+		out += pc.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// ValidateBasic performs stateless checks on a list of parameter changes
+func (pcs ParamChanges) ValidateBasic() sdk.Error {
+	if len(pcs) == 0 {
+		return ErrEmptyChanges(DefaultCodespace)
+	}
+
+	for _, pc := range pcs {
+		if len(pc.Subspace) == 0 {
+			return ErrInvalidChange(DefaultCodespace, "parameter change has empty subspace")
+		}
+		if len(pc.Key) == 0 {
+			return ErrInvalidChange(DefaultCodespace, "parameter change has empty key")
+		}
+		if len(pc.Value) == 0 {
+			return ErrInvalidChange(DefaultCodespace, "parameter change has empty value")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"my-cosmos/cosmos-sdk/x/params"
+)
+
+// paramChangeProposal defines a parameter change proposal read from a file
+type paramChangeProposal struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Changes     params.ParamChanges `json:"changes"`
+	Deposit     string              `json:"deposit"`
+}
+
+func parseParamChangeProposal(proposalFile string) (*paramChangeProposal, error) {
+	proposal := &paramChangeProposal{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, proposal); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
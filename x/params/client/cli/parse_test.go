@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"my-cosmos/cosmos-sdk/x/params"
+)
+
+func TestParseParamChangeProposal(t *testing.T) {
+	okJSON, err := ioutil.TempFile("", "proposal")
+	require.Nil(t, err, "unexpected error")
+	okJSON.WriteString(`
+{
+  "title": "Lower Voting Period",
+  "description": "Reduce the voting period to one day",
+  "changes": [
+    {
+      "subspace": "gov",
+      "key": "votingparams",
+      "value": "{\"voting_period\":\"86400000000000\"}"
+    }
+  ],
+  "deposit": "10test"
+}
+`)
+
+	// nonexistent json
+	_, err = parseParamChangeProposal("fileDoesNotExist")
+	require.Error(t, err)
+
+	// ok json
+	proposal, err := parseParamChangeProposal(okJSON.Name())
+	require.Nil(t, err, "unexpected error")
+	require.Equal(t, "Lower Voting Period", proposal.Title)
+	require.Equal(t, "Reduce the voting period to one day", proposal.Description)
+	require.Equal(t, params.ParamChanges{
+		params.NewParamChange("gov", "votingparams", `{"voting_period":"86400000000000"}`),
+	}, proposal.Changes)
+	require.Equal(t, "10test", proposal.Deposit)
+
+	err = okJSON.Close()
+	require.Nil(t, err, "unexpected error")
+}
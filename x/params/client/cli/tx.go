@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/gov"
+	"my-cosmos/cosmos-sdk/x/params"
+)
+
+// GetCmdSubmitProposal implements a command handler for submitting a parameter
+// change proposal transaction.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a parameter change proposal",
+		Long: strings.TrimSpace(`
+Submit a parameter proposal along with an initial deposit. The proposal details must be supplied via a JSON file. The new values are given in the JSON encoding the subspace stores them with. The changes are checked against the subspaces on submission, and applied all at once when the proposal passes.
+
+$ gaiacli tx gov submit-proposal param-change <path/to/proposal.json> --from mykey
+
+where proposal.json contains:
+
+{
+  "title": "Lower Voting Period",
+  "description": "Reduce the voting period to one day",
+  "changes": [
+    {
+      "subspace": "gov",
+      "key": "votingparams",
+      "value": "{\"voting_period\":\"86400000000000\"}"
+    }
+  ],
+  "deposit": "10stake"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := parseParamChangeProposal(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := params.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes)
+
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"net/http"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	clientrest "my-cosmos/cosmos-sdk/client/rest"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/types/rest"
+	"my-cosmos/cosmos-sdk/x/gov"
+	govrest "my-cosmos/cosmos-sdk/x/gov/client/rest"
+	"my-cosmos/cosmos-sdk/x/params"
+)
+
+// ParamChangeProposalReq defines the properties of a parameter change
+// proposal request's body.
+type ParamChangeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string              `json:"title"`       // Title of the proposal
+	Description string              `json:"description"` // Description of the proposal
+	Changes     params.ParamChanges `json:"changes"`     // Parameter changes applied when the proposal passes
+	Proposer    sdk.AccAddress      `json:"proposer"`    // Address of the proposer
+	Deposit     sdk.Coins           `json:"deposit"`     // Coins to add to the proposal's deposit
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the param
+// change REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "param_change",
+		Handler:  postProposalHandlerFn(cdc, cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ParamChangeProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := params.NewParameterChangeProposal(req.Title, req.Description, req.Changes)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.Deposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,11 @@
+package params
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the governance proposal contents of the params
+// module on the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(ParameterChangeProposal{}, "params/ParameterChangeProposal", nil)
+}
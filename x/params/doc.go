@@ -115,4 +115,28 @@ params.Keeper itself to access all subspace(using GetSubspace)
 		}
 		space.Set(ctx, key, param)
 	}
+
+Governance Usage:
+
+Parameters can also be changed by a passed governance proposal. A ParamChange
+names the subspace, the key, an optional subkey and the JSON encoded value.
+ApplyParamChanges decodes each value into the type registered in the KeyTable
+of its subspace and writes it, failing on the first change which does not fit.
+It should be given a cached context which is only written on success.
+
+	changes := params.ParamChanges{
+		params.NewParamChange("mymodule", "myparameter1", `{"Parameter1":"10"}`),
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.pk.ApplyParamChanges(cacheCtx, changes); err != nil {
+		return err
+	}
+	write()
+
+The changes are submitted to governance as a ParameterChangeProposal, which is
+applied by the handler returned by NewParamChangeProposalHandler. It must be
+registered on the governance router under RouterKey:
+
+	govRouter.AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(paramsKeeper))
 */
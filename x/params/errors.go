@@ -0,0 +1,30 @@
+// nolint
+package params
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = "params"
+
+	CodeUnknownSubspace sdk.CodeType = 1
+	CodeInvalidChange   sdk.CodeType = 2
+	CodeEmptyChanges    sdk.CodeType = 3
+)
+
+// Error constructors
+
+func ErrUnknownSubspace(codespace sdk.CodespaceType, space string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownSubspace, fmt.Sprintf("unknown subspace %s", space))
+}
+
+func ErrInvalidChange(codespace sdk.CodespaceType, errorMsg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChange, errorMsg)
+}
+
+func ErrEmptyChanges(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEmptyChanges, "no parameter changes provided")
+}
@@ -1,6 +1,8 @@
 package params
 
 import (
+	"fmt"
+
 	"my-cosmos/cosmos-sdk/codec"
 	sdk "my-cosmos/cosmos-sdk/types"
 
@@ -63,3 +65,31 @@ func (k Keeper) GetSubspace(storename string) (Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// ApplyParamChanges writes the changes through the targeted subspaces. It
+// stops at the first failing change, so callers wanting all-or-nothing
+// semantics should pass a cached context and only write it on success.
+func (k Keeper) ApplyParamChanges(ctx sdk.Context, changes ParamChanges) sdk.Error {
+	for _, pc := range changes {
+		space, ok := k.GetSubspace(pc.Subspace)
+		if !ok {
+			return ErrUnknownSubspace(DefaultCodespace, pc.Subspace)
+		}
+
+		var err error
+		if len(pc.Subkey) == 0 {
+			err = space.Update(ctx, []byte(pc.Key), []byte(pc.Value))
+		} else {
+			err = space.UpdateWithSubkey(ctx, []byte(pc.Key), []byte(pc.Subkey), []byte(pc.Value))
+		}
+		if err != nil {
+			return ErrInvalidChange(DefaultCodespace, err.Error())
+		}
+
+		ctx.Logger().With("module", "x/params").Info(
+			fmt.Sprintf("updated parameter %s", pc),
+		)
+	}
+
+	return nil
+}
@@ -216,3 +216,92 @@ func TestSubspace(t *testing.T) {
 		require.Equal(t, kv.param, indirect(kv.ptr), "stored param not equal, tc #%d", i)
 	}
 }
+
+func TestParamChanges(t *testing.T) {
+	cdc := createTestCodec()
+	key := sdk.NewKVStoreKey("test")
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := defaultContext(key, tkey)
+	keeper := NewKeeper(cdc, key, tkey)
+
+	table := NewKeyTable(
+		[]byte("int64"), int64(0),
+		[]byte("dec"), sdk.Dec{},
+		[]byte("struct"), s{},
+	)
+	space := keeper.Subspace("test").WithKeyTable(table)
+
+	tests := []struct {
+		changes    ParamChanges
+		expectPass bool
+	}{
+		{ParamChanges{NewParamChange("test", "int64", `"10"`)}, true},
+		{ParamChanges{NewParamChange("test", "dec", `"0.500000000000000000"`)}, true},
+		{ParamChanges{NewParamChange("test", "struct", `{"type":"test/s","value":{"I":"3"}}`)}, true},
+		{ParamChanges{NewParamChangeWithSubkey("test", "int64", "sub", `"7"`)}, true},
+		{ParamChanges{}, false},
+		{ParamChanges{NewParamChange("unknown", "int64", `"10"`)}, false},
+		{ParamChanges{NewParamChange("test", "unknown", `"10"`)}, false},
+		{ParamChanges{NewParamChange("test", "int64", `true`)}, false},
+		{ParamChanges{NewParamChange("test", "int64", "")}, false},
+		{ParamChanges{NewParamChange("test", "int64", `"1"`), NewParamChange("test", "dec", `"x"`)}, false},
+	}
+
+	// the changes are applied the way the proposal handler is run, on a
+	// cached context which is only written on success
+	for i, tc := range tests {
+		err := tc.changes.ValidateBasic()
+		if err == nil {
+			cacheCtx, write := ctx.CacheContext()
+			if err = keeper.ApplyParamChanges(cacheCtx, tc.changes); err == nil {
+				write()
+			}
+		}
+		if tc.expectPass {
+			require.NoError(t, err, "tc #%d", i)
+		} else {
+			require.Error(t, err, "tc #%d", i)
+		}
+	}
+
+	var i64 int64
+	space.Get(ctx, []byte("int64"), &i64)
+	require.Equal(t, int64(10), i64)
+
+	var dec sdk.Dec
+	space.Get(ctx, []byte("dec"), &dec)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), dec)
+
+	var st s
+	space.Get(ctx, []byte("struct"), &st)
+	require.Equal(t, s{3}, st)
+
+	space.GetWithSubkey(ctx, []byte("int64"), []byte("sub"), &i64)
+	require.Equal(t, int64(7), i64)
+	require.True(t, space.Modified(ctx, []byte("int64")))
+}
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	cdc := createTestCodec()
+	key := sdk.NewKVStoreKey("test")
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := defaultContext(key, tkey)
+	keeper := NewKeeper(cdc, key, tkey)
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable([]byte("int64"), int64(0)))
+	handler := NewParamChangeProposalHandler(keeper)
+
+	proposal := NewParameterChangeProposal("Test", "description", ParamChanges{NewParamChange("test", "int64", `"10"`)})
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, handler(ctx, proposal))
+
+	var i64 int64
+	space.Get(ctx, []byte("int64"), &i64)
+	require.Equal(t, int64(10), i64)
+
+	proposal = NewParameterChangeProposal("Test", "description", ParamChanges{NewParamChange("unknown", "int64", `"10"`)})
+	require.Error(t, handler(ctx, proposal))
+
+	proposal = NewParameterChangeProposal("", "description", ParamChanges{NewParamChange("test", "int64", `"10"`)})
+	require.Error(t, proposal.ValidateBasic())
+}
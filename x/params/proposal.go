@@ -0,0 +1,63 @@
+package params
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// RouterKey is the governance route of parameter change proposals
+	RouterKey = "params"
+
+	// ProposalTypeChange is the type of a ParameterChangeProposal
+	ProposalTypeChange = "ParameterChange"
+)
+
+// Assert ParameterChangeProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = ParameterChangeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeChange)
+	govtypes.RegisterProposalTypeCodec(ParameterChangeProposal{}, "params/ParameterChangeProposal")
+}
+
+// ParameterChangeProposal is a governance proposal content which, once the
+// proposal passes, applies a list of parameter changes through the subspaces.
+type ParameterChangeProposal struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Changes     ParamChanges `json:"changes"`
+}
+
+// NewParameterChangeProposal creates a ParameterChangeProposal
+func NewParameterChangeProposal(title, description string, changes ParamChanges) ParameterChangeProposal {
+	return ParameterChangeProposal{title, description, changes}
+}
+
+// nolint
+func (pcp ParameterChangeProposal) GetTitle() string       { return pcp.Title }
+func (pcp ParameterChangeProposal) GetDescription() string { return pcp.Description }
+func (pcp ParameterChangeProposal) ProposalRoute() string  { return RouterKey }
+func (pcp ParameterChangeProposal) ProposalType() string   { return ProposalTypeChange }
+
+// ValidateBasic validates the title and description of the proposal along with
+// the format of its changes
+func (pcp ParameterChangeProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(DefaultCodespace, pcp); err != nil {
+		return err
+	}
+
+	return pcp.Changes.ValidateBasic()
+}
+
+func (pcp ParameterChangeProposal) String() string {
+	return fmt.Sprintf(`Parameter Change Proposal:
+  Title:       %s
+  Description: %s
+  Changes:
+    %s
+`, pcp.Title, pcp.Description, strings.Replace(pcp.Changes.String(), "\n", "\n    ", -1))
+}
@@ -0,0 +1,23 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewParamChangeProposalHandler returns the governance handler applying the
+// changes of passed ParameterChangeProposals
+func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case ParameterChangeProposal:
+			return k.ApplyParamChanges(ctx, c.Changes)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized param proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
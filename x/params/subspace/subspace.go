@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"fmt"
 	"reflect"
 
 	"my-cosmos/cosmos-sdk/codec"
@@ -210,6 +211,46 @@ func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, para
 	tstore.Set(newkey, []byte{})
 }
 
+// decodeRaw decodes a JSON encoded parameter into a new instance of the type
+// registered for the key. The returned value is a pointer to that instance.
+func (s Subspace) decodeRaw(key []byte, value []byte) (interface{}, error) {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return nil, fmt.Errorf("parameter %s not registered in subspace %s", key, s.name)
+	}
+
+	ptr := reflect.New(attr.ty).Interface()
+	if err := s.cdc.UnmarshalJSON(value, ptr); err != nil {
+		return nil, fmt.Errorf("invalid value for parameter %s: %v", key, err)
+	}
+
+	return ptr, nil
+}
+
+// Update stores a JSON encoded parameter after decoding it into the registered
+// type. Unlike Set it returns an error instead of panicking on a bad input.
+func (s Subspace) Update(ctx sdk.Context, key []byte, value []byte) error {
+	ptr, err := s.decodeRaw(key, value)
+	if err != nil {
+		return err
+	}
+
+	s.Set(ctx, key, ptr)
+	return nil
+}
+
+// UpdateWithSubkey stores a JSON encoded parameter with a key and subkey.
+// The value is checked against the type registered for the key.
+func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, value []byte) error {
+	ptr, err := s.decodeRaw(key, value)
+	if err != nil {
+		return err
+	}
+
+	s.SetWithSubkey(ctx, key, subkey, ptr)
+	return nil
+}
+
 // Get to ParamSet
 func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
@@ -0,0 +1,24 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/evidence"
+)
+
+// NewEquivocationHandler returns the evidence handler slashing and jailing a
+// validator that signed two blocks at the same height. It is registered for
+// evidence.RouteEquivocation on the evidence router.
+func NewEquivocationHandler(k Keeper) evidence.Handler {
+	return func(ctx sdk.Context, ev evidence.Evidence) sdk.Error {
+		switch ev := ev.(type) {
+		case evidence.Equivocation:
+			k.handleDoubleSign(ctx, ev.ConsensusAddress.Bytes(), ev.Height, ev.Time, ev.Power)
+			return nil
+		default:
+			errMsg := fmt.Sprintf("Unrecognized equivocation evidence type: %T", ev)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/evidence"
+	"my-cosmos/cosmos-sdk/x/staking"
+)
+
+// Test that equivocation evidence routed to the handler slashes and jails
+// the validator
+func TestEquivocationHandler(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	ctx = ctx.WithBlockHeight(-1)
+	power := int64(100)
+	amt := sdk.TokensFromTendermintPower(power)
+	operatorAddr, val := addrs[0], pks[0]
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+	keeper.handleValidatorSignature(ctx, val.Address(), amt.Int64(), true)
+
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	ctx = ctx.WithBlockHeight(1)
+	handler := NewEquivocationHandler(keeper)
+	equivocation := evidence.NewEquivocation(1, time.Unix(0, 0), power, sdk.ConsAddress(val.Address()))
+	require.NoError(t, handler(ctx, equivocation))
+
+	require.True(t, sk.Validator(ctx, operatorAddr).GetJailed())
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
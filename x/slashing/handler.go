@@ -68,7 +68,7 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 	// cannot be unjailed if tombstoned
 	// 如果该验证人已经销毁了，则不能解除 监禁
 	if info.Tombstoned {
-		return ErrValidatorJailed(k.codespace).Result()
+		return ErrValidatorTombstoned(k.codespace).Result()
 	}
 
 	// cannot be unjailed until out of jail
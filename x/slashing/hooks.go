@@ -25,10 +25,18 @@ func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _
 }
 
 // When a validator is created, add the address-pubkey relation.
+// A validator reusing the consensus key of a tombstoned validator is jailed
+// right away, so that it never joins the validator set.
 func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	validator := k.validatorSet.Validator(ctx, valAddr)
 	// 设置 addr -> pubkey
 	k.addPubkey(ctx, validator.GetConsPubKey())
+
+	// 使用已被墓碑化的共识公钥的验证人，直接监禁
+	consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
+	if k.IsTombstoned(ctx, consAddr) {
+		k.validatorSet.Jail(ctx, consAddr)
+	}
 }
 
 // When a validator is removed, delete the address-pubkey relation.
@@ -67,9 +67,11 @@ func TestHandleDoubleSign(t *testing.T) {
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(sk.GetParams(ctx).UnbondingTime)})
 
 	// Still shouldn't be able to unjail
+	require.True(t, keeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 	msgUnjail := NewMsgUnjail(operatorAddr)
 	res := handleMsgUnjail(ctx, msgUnjail, keeper)
 	require.False(t, res.IsOK())
+	require.EqualValues(t, CodeValidatorTombstoned, res.Code)
 
 	// Should be able to unbond now
 	del, _ := sk.GetDelegation(ctx, sdk.AccAddress(operatorAddr), operatorAddr)
@@ -78,6 +80,34 @@ func TestHandleDoubleSign(t *testing.T) {
 	require.True(t, res.IsOK())
 }
 
+// Test that a validator created with the consensus key of a tombstoned
+// validator is jailed and never bonded
+func TestTombstonedConsensusKeyJailed(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	amt := sdk.TokensFromTendermintPower(100)
+	operatorAddr, val := addrs[0], pks[0]
+
+	// the consensus key was tombstoned by an earlier validator
+	consAddr := sdk.ConsAddress(val.Address())
+	keeper.SetValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(0, 0, DoubleSignJailEndTime, true, 0))
+
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// should be jailed and not bonded
+	validator := sk.Validator(ctx, operatorAddr)
+	require.True(t, validator.GetJailed())
+	require.Equal(t, sdk.Unbonded, validator.GetStatus())
+	require.Equal(t, amt, validator.GetTokens())
+
+	// should never be able to unjail
+	res := handleMsgUnjail(ctx, NewMsgUnjail(operatorAddr), keeper)
+	require.EqualValues(t, CodeValidatorTombstoned, res.Code)
+}
+
 // ______________________________________________________________
 
 // Test that a validator is slashed correctly
@@ -21,6 +21,13 @@ func (k Keeper) getValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	return
 }
 
+// IsTombstoned returns whether the validator with the given consensus address
+// was tombstoned for double signing. A tombstoned validator can never unjail.
+func (k Keeper) IsTombstoned(ctx sdk.Context, address sdk.ConsAddress) bool {
+	info, found := k.getValidatorSigningInfo(ctx, address)
+	return found && info.Tombstoned
+}
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context, handler func(address sdk.ConsAddress, info ValidatorSigningInfo) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
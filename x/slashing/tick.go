@@ -1,10 +1,7 @@
 package slashing
 
 import (
-	"fmt"
-
 	abci "github.com/tendermint/tendermint/abci/types"
-	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "my-cosmos/cosmos-sdk/types"
 )
@@ -29,27 +26,8 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) sdk.Ta
 		sk.handleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
 	}
 
-	// Iterate through any newly discovered evidence of infraction
-	// Slash any validators (and since-unbonded stake within the unbonding period)
-	// who contributed to valid infractions
-	/**
-	迭代任何关于所有验证人的新发现的违规证据（以及在解绑期间的无staking股权）
-
-	说白了这个就是 双签的证据
-	 */
-	for _, evidence := range req.ByzantineValidators {
-		switch evidence.Type {
-		// 目前值惩罚这些 双签名的验证人
-		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			/**
-			TODO
-			处理验证器在同一高度签名两个块
-			 */
-			sk.handleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power)
-		default:
-			ctx.Logger().With("module", "x/slashing").Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
-		}
-	}
+	// Evidence of double signing is handled by x/evidence, which routes the
+	// Equivocation evidence to the handler returned by NewEquivocationHandler.
 
 	return sdk.EmptyTags()
 }
@@ -9,33 +9,43 @@ import (
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	FeeCollectionKeeper     = types.FeeCollectionKeeper
-	BankKeeper              = types.BankKeeper
-	DistributionKeeper      = types.DistributionKeeper
-	Validator               = types.Validator
-	Validators              = types.Validators
-	Description             = types.Description
-	Commission              = types.Commission
-	CommissionMsg           = types.CommissionMsg
-	Delegation              = types.Delegation
-	Delegations             = types.Delegations
-	UnbondingDelegation     = types.UnbondingDelegation
-	UnbondingDelegations    = types.UnbondingDelegations
-	Redelegation            = types.Redelegation
-	Redelegations           = types.Redelegations
-	Params                  = types.Params
-	Pool                    = types.Pool
-	MsgCreateValidator      = types.MsgCreateValidator
-	MsgEditValidator        = types.MsgEditValidator
-	MsgDelegate             = types.MsgDelegate
-	MsgUndelegate           = types.MsgUndelegate
-	MsgBeginRedelegate      = types.MsgBeginRedelegate
-	GenesisState            = types.GenesisState
-	QueryDelegatorParams    = querier.QueryDelegatorParams
-	QueryValidatorParams    = querier.QueryValidatorParams
-	QueryBondsParams        = querier.QueryBondsParams
-	QueryRedelegationParams = querier.QueryRedelegationParams
+	Keeper                     = keeper.Keeper
+	FeeCollectionKeeper        = types.FeeCollectionKeeper
+	BankKeeper                 = types.BankKeeper
+	SupplyKeeper               = types.SupplyKeeper
+	DistributionKeeper         = types.DistributionKeeper
+	Validator                  = types.Validator
+	Validators                 = types.Validators
+	Description                = types.Description
+	Commission                 = types.Commission
+	CommissionMsg              = types.CommissionMsg
+	Delegation                 = types.Delegation
+	Delegations                = types.Delegations
+	UnbondingDelegation        = types.UnbondingDelegation
+	UnbondingDelegations       = types.UnbondingDelegations
+	Redelegation               = types.Redelegation
+	Redelegations              = types.Redelegations
+	ValidatorChange            = types.ValidatorChange
+	ValidatorChanges           = types.ValidatorChanges
+	TokenizeShareRecord        = types.TokenizeShareRecord
+	TokenizeShareRecords       = types.TokenizeShareRecords
+	Params                     = types.Params
+	Pool                       = types.Pool
+	MsgCreateValidator         = types.MsgCreateValidator
+	MsgEditValidator           = types.MsgEditValidator
+	MsgScheduleValidatorChange = types.MsgScheduleValidatorChange
+	MsgDelegate                = types.MsgDelegate
+	MsgUndelegate              = types.MsgUndelegate
+	MsgBeginRedelegate         = types.MsgBeginRedelegate
+	MsgTokenizeShares          = types.MsgTokenizeShares
+	MsgRedeemTokensForShares   = types.MsgRedeemTokensForShares
+	GenesisState               = types.GenesisState
+	QueryDelegatorParams       = querier.QueryDelegatorParams
+	QueryValidatorParams       = querier.QueryValidatorParams
+	QueryBondsParams           = querier.QueryBondsParams
+	QueryRedelegationParams    = querier.QueryRedelegationParams
+
+	QueryTokenizeShareRecordParams = querier.QueryTokenizeShareRecordParams
 )
 
 var (
@@ -71,6 +81,11 @@ var (
 	UnbondingQueueKey            = keeper.UnbondingQueueKey
 	RedelegationQueueKey         = keeper.RedelegationQueueKey
 	ValidatorQueueKey            = keeper.ValidatorQueueKey
+	ValidatorChangeKey           = keeper.ValidatorChangeKey
+	ValidatorChangeQueueKey      = keeper.ValidatorChangeQueueKey
+	GetValidatorChangeKey        = keeper.GetValidatorChangeKey
+	TokenizeShareRecordKey       = keeper.TokenizeShareRecordKey
+	GetTokenizeShareRecordKey    = keeper.GetTokenizeShareRecordKey
 
 	DefaultParamspace = keeper.DefaultParamspace
 	KeyUnbondingTime  = types.KeyUnbondingTime
@@ -87,20 +102,28 @@ var (
 	NewCommission         = types.NewCommission
 	NewCommissionMsg      = types.NewCommissionMsg
 	NewCommissionWithTime = types.NewCommissionWithTime
+	NewValidatorChange    = types.NewValidatorChange
 	NewGenesisState       = types.NewGenesisState
 	DefaultGenesisState   = types.DefaultGenesisState
 	RegisterCodec         = types.RegisterCodec
 
-	NewMsgCreateValidator = types.NewMsgCreateValidator
-	NewMsgEditValidator   = types.NewMsgEditValidator
-	NewMsgDelegate        = types.NewMsgDelegate
-	NewMsgUndelegate      = types.NewMsgUndelegate
-	NewMsgBeginRedelegate = types.NewMsgBeginRedelegate
+	NewTokenizeShareRecord = types.NewTokenizeShareRecord
+
+	NewMsgCreateValidator         = types.NewMsgCreateValidator
+	NewMsgEditValidator           = types.NewMsgEditValidator
+	NewMsgScheduleValidatorChange = types.NewMsgScheduleValidatorChange
+	NewMsgDelegate                = types.NewMsgDelegate
+	NewMsgUndelegate              = types.NewMsgUndelegate
+	NewMsgBeginRedelegate         = types.NewMsgBeginRedelegate
+	NewMsgTokenizeShares          = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares   = types.NewMsgRedeemTokensForShares
 
 	NewQuerier              = querier.NewQuerier
 	NewQueryDelegatorParams = querier.NewQueryDelegatorParams
 	NewQueryValidatorParams = querier.NewQueryValidatorParams
 	NewQueryBondsParams     = querier.NewQueryBondsParams
+
+	NewQueryTokenizeShareRecordParams = querier.NewQueryTokenizeShareRecordParams
 )
 
 const (
@@ -118,9 +141,14 @@ const (
 	QueryDelegatorValidator            = querier.QueryDelegatorValidator
 	QueryPool                          = querier.QueryPool
 	QueryParameters                    = querier.QueryParameters
+	QueryValidatorChange               = querier.QueryValidatorChange
+	QueryValidatorChanges              = querier.QueryValidatorChanges
+	QueryTokenizeShareRecord           = querier.QueryTokenizeShareRecord
+	QueryTokenizeShareRecords          = querier.QueryTokenizeShareRecords
 )
 
 const (
+	ModuleName            = types.ModuleName
 	StoreKey              = types.StoreKey
 	TStoreKey             = types.TStoreKey
 	QuerierRoute          = types.QuerierRoute
@@ -168,14 +196,23 @@ var (
 	ErrNeitherShareMsgsGiven = types.ErrNeitherShareMsgsGiven
 	ErrMissingSignature      = types.ErrMissingSignature
 
-	ErrMinSelfDelegationInvalid   = types.ErrMinSelfDelegationInvalid
-	ErrMinSelfDelegationDecreased = types.ErrMinSelfDelegationDecreased
-	ErrSelfDelegationBelowMinimum = types.ErrSelfDelegationBelowMinimum
+	ErrMinSelfDelegationInvalid     = types.ErrMinSelfDelegationInvalid
+	ErrMinSelfDelegationDecreased   = types.ErrMinSelfDelegationDecreased
+	ErrSelfDelegationBelowMinimum   = types.ErrSelfDelegationBelowMinimum
+	ErrValidatorChangeNotInFuture   = types.ErrValidatorChangeNotInFuture
+	ErrNoValidatorChange            = types.ErrNoValidatorChange
+	ErrCommissionChangeNotScheduled = types.ErrCommissionChangeNotScheduled
+
+	ErrTokenizeSelfDelegation         = types.ErrTokenizeSelfDelegation
+	ErrTokenizeRedelegationInProgress = types.ErrTokenizeRedelegationInProgress
+	ErrTokenizeAmountTooSmall         = types.ErrTokenizeAmountTooSmall
+	ErrNoTokenizeShareRecord          = types.ErrNoTokenizeShareRecord
 )
 
 var (
-	ActionCompleteUnbonding    = tags.ActionCompleteUnbonding
-	ActionCompleteRedelegation = tags.ActionCompleteRedelegation
+	ActionCompleteUnbonding       = tags.ActionCompleteUnbonding
+	ActionCompleteRedelegation    = tags.ActionCompleteRedelegation
+	ActionCompleteValidatorChange = tags.ActionCompleteValidatorChange
 
 	TagAction       = tags.Action
 	TagSrcValidator = tags.SrcValidator
@@ -183,4 +220,5 @@ var (
 	TagDelegator    = tags.Delegator
 	TagMoniker      = tags.Moniker
 	TagIdentity     = tags.Identity
+	TagShareDenom   = tags.ShareDenom
 )
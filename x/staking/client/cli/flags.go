@@ -27,6 +27,10 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagEffectiveTime = "effective-time"
+
+	FlagRewardOwner = "reward-owner"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
@@ -39,7 +43,7 @@ var (
 	fsShares            = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionCreate = flag.NewFlagSet("", flag.ContinueOnError)
 	FsCommissionCreate  = flag.NewFlagSet("", flag.ContinueOnError)
-	fsCommissionUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
+	fsValidatorChange   = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMinSelfDelegation = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionEdit   = flag.NewFlagSet("", flag.ContinueOnError)
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
@@ -56,11 +60,13 @@ func init() {
 	fsDescriptionCreate.String(FlagIdentity, "", "The optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionCreate.String(FlagWebsite, "", "The validator's (optional) website")
 	fsDescriptionCreate.String(FlagDetails, "", "The validator's (optional) details")
-	fsCommissionUpdate.String(FlagCommissionRate, "", "The new commission rate percentage")
 	FsCommissionCreate.String(FlagCommissionRate, "", "The initial commission rate percentage")
 	FsCommissionCreate.String(FlagCommissionMaxRate, "", "The maximum commission rate percentage")
 	FsCommissionCreate.String(FlagCommissionMaxChangeRate, "", "The maximum commission change rate percentage (per day)")
 	FsMinSelfDelegation.String(FlagMinSelfDelegation, "", "The minimum self delegation required on the validator")
+	fsValidatorChange.String(FlagCommissionRate, "", "The new commission rate percentage")
+	fsValidatorChange.String(FlagMinSelfDelegation, "", "The new minimum self delegation required on the validator")
+	fsValidatorChange.String(FlagEffectiveTime, "", "The time the change takes effect, in RFC3339 format")
 	fsDescriptionEdit.String(FlagMoniker, types.DoNotModifyDesc, "The validator's name")
 	fsDescriptionEdit.String(FlagIdentity, types.DoNotModifyDesc, "The (optional) identity signature (ex. UPort or Keybase)")
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
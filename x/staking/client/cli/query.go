@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -444,3 +445,134 @@ $ gaiacli query staking params
 		},
 	}
 }
+
+// GetCmdQueryValidatorChange implements the query of the change scheduled for
+// a validator.
+func GetCmdQueryValidatorChange(storeKey string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-change [validator-addr]",
+		Short: "Query the change scheduled for a validator",
+		Long: strings.TrimSpace(`Query the commission rate or minimum self delegation change a validator
+announced, and the time it takes effect:
+
+$ gaiacli query staking validator-change cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(staking.NewQueryValidatorParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeKey, staking.QueryValidatorChange)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var change staking.ValidatorChange
+			cdc.MustUnmarshalJSON(res, &change)
+			return cliCtx.PrintOutput(change)
+		},
+	}
+}
+
+// GetCmdQueryValidatorChanges implements the query of all the scheduled
+// validator changes.
+func GetCmdQueryValidatorChanges(storeKey string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-changes",
+		Args:  cobra.NoArgs,
+		Short: "Query all the scheduled validator changes",
+		Long: strings.TrimSpace(`Query the commission rate and minimum self delegation changes all the
+validators announced:
+
+$ gaiacli query staking validator-changes
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeKey, staking.QueryValidatorChanges)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var changes staking.ValidatorChanges
+			cdc.MustUnmarshalJSON(res, &changes)
+			return cliCtx.PrintOutput(changes)
+		},
+	}
+}
+
+// GetCmdQueryTokenizeShareRecord implements the query of a tokenize share
+// record.
+func GetCmdQueryTokenizeShareRecord(storeKey string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-record [id]",
+		Short: "Query a tokenize share record",
+		Long: strings.TrimSpace(`Query a tokenize share record by its ID, which is the end of the denomination
+of its share tokens:
+
+$ gaiacli query staking tokenize-share-record 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("tokenize share record ID %s is not a valid uint", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(staking.NewQueryTokenizeShareRecordParams(id))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeKey, staking.QueryTokenizeShareRecord)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var record staking.TokenizeShareRecord
+			cdc.MustUnmarshalJSON(res, &record)
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
+
+// GetCmdQueryTokenizeShareRecords implements the query of all the tokenize
+// share records.
+func GetCmdQueryTokenizeShareRecords(storeKey string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-records",
+		Args:  cobra.NoArgs,
+		Short: "Query all the tokenize share records",
+		Long: strings.TrimSpace(`Query all the tokenize share records:
+
+$ gaiacli query staking tokenize-share-records
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeKey, staking.QueryTokenizeShareRecords)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var records staking.TokenizeShareRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
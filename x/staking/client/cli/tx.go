@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"my-cosmos/cosmos-sdk/x/auth"
 
@@ -94,6 +95,54 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 				Details:  viper.GetString(FlagDetails),
 			}
 
+			var newMinSelfDelegation *sdk.Int
+
+			minSelfDelegationString := viper.GetString(FlagMinSelfDelegation)
+			if minSelfDelegationString != "" {
+				msb, ok := sdk.NewIntFromString(minSelfDelegationString)
+				if !ok {
+					return fmt.Errorf(staking.ErrMinSelfDelegationInvalid(staking.DefaultCodespace).Error())
+				}
+				newMinSelfDelegation = &msb
+			}
+
+			// the commission rate is changed with schedule-validator-change
+			msg := staking.NewMsgEditValidator(sdk.ValAddress(valAddr), description, nil, newMinSelfDelegation)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			/**
+			TODO 广播当前交易给其他tendermint节点
+			*/
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDescriptionEdit)
+	cmd.Flags().AddFlagSet(FsMinSelfDelegation)
+
+	return cmd
+}
+
+// GetCmdScheduleValidatorChange implements the schedule validator change
+// command.
+func GetCmdScheduleValidatorChange(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-validator-change",
+		Short: "announce a change of the commission rate or minimum self delegation of your validator",
+		Long: strings.TrimSpace(`Announce a change of the commission rate or of the minimum self delegation of
+your validator, which takes effect at the given time. It replaces the change
+already scheduled for the validator:
+
+$ gaiacli tx staking schedule-validator-change --commission-rate 0.12 --effective-time 2019-06-01T00:00:00Z --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			valAddr := cliCtx.GetFromAddress()
+
 			var newRate *sdk.Dec
 
 			commissionRate := viper.GetString(FlagCommissionRate)
@@ -117,18 +166,21 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 				newMinSelfDelegation = &msb
 			}
 
-			msg := staking.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate, newMinSelfDelegation)
+			effectiveTime, err := time.Parse(time.RFC3339, viper.GetString(FlagEffectiveTime))
+			if err != nil {
+				return fmt.Errorf("invalid effective time: %v", err)
+			}
 
-			// build and sign the transaction, then broadcast to Tendermint
-			/**
-			TODO 广播当前交易给其他tendermint节点
-			*/
+			msg := staking.NewMsgScheduleValidatorChange(sdk.ValAddress(valAddr), newRate, newMinSelfDelegation, effectiveTime)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
 		},
 	}
 
-	cmd.Flags().AddFlagSet(fsDescriptionEdit)
-	cmd.Flags().AddFlagSet(fsCommissionUpdate)
+	cmd.Flags().AddFlagSet(fsValidatorChange)
+	cmd.MarkFlagRequired(FlagEffectiveTime)
 
 	return cmd
 }
@@ -262,6 +314,82 @@ $ gaiacli tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	}
 }
 
+// GetCmdTokenizeShare implements the tokenize-share command.
+func GetCmdTokenizeShare(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount]",
+		Short: "tokenize shares of a delegation into transferable share tokens",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(`Tokenize an amount of shares of a delegation into share tokens of the validator,
+which can be sent to other accounts and redeemed back into a delegation by their
+holder. The rewards of the tokenized shares are paid to the reward owner, the
+delegator by default:
+
+$ gaiacli tx staking tokenize-share cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			sharesAmount, err := getShares(args[1], delAddr, valAddr)
+			if err != nil {
+				return err
+			}
+
+			rewardOwner := delAddr
+			if owner := viper.GetString(FlagRewardOwner); owner != "" {
+				rewardOwner, err = sdk.AccAddressFromBech32(owner)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := staking.NewMsgTokenizeShares(delAddr, valAddr, sharesAmount, rewardOwner)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(FlagRewardOwner, "", "The Bech32 address of the account the rewards of the tokenized shares are paid to")
+
+	return cmd
+}
+
+// GetCmdRedeemTokens implements the redeem-tokens command.
+func GetCmdRedeemTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "redeem share tokens for a delegation to their validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Redeem share tokens for the shares of the tokenized delegation they represent,
+which are added to your delegation to the validator of the tokens:
+
+$ gaiacli tx staking redeem-tokens 100cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := staking.NewMsgRedeemTokensForShares(cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
 // BuildCreateValidatorMsg makes a new MsgCreateValidator.
 /**
 BuildCreateValidatorMsg创建一个新的MsgCreateValidator。
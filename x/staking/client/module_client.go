@@ -37,6 +37,10 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		cli.GetCmdQueryValidatorDelegations(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidatorUnbondingDelegations(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidatorRedelegations(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryValidatorChange(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryValidatorChanges(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryTokenizeShareRecord(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryTokenizeShareRecords(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryParams(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryPool(mc.storeKey, mc.cdc))...)
 
@@ -57,12 +61,18 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 		cli.GetCmdCreateValidator(mc.cdc),
 		// 修改验证人
 		cli.GetCmdEditValidator(mc.cdc),
+		// 预告验证人的变更
+		cli.GetCmdScheduleValidatorChange(mc.cdc),
 		// 委托
 		cli.GetCmdDelegate(mc.cdc),
 		// 重置委托
 		cli.GetCmdRedelegate(mc.storeKey, mc.cdc),
 		// 解除委托
 		cli.GetCmdUnbond(mc.storeKey, mc.cdc),
+		// 委托代币化
+		cli.GetCmdTokenizeShare(mc.cdc),
+		// 赎回份额代币
+		cli.GetCmdRedeemTokens(mc.cdc),
 	)...)
 
 	return stakingTxCmd
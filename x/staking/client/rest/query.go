@@ -88,6 +88,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		validatorUnbondingDelegationsHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// Get the change scheduled for a validator
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/change",
+		validatorChangeHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get all the scheduled validator changes
+	r.HandleFunc(
+		"/staking/validator_changes",
+		validatorChangesHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get all the tokenize share records
+	r.HandleFunc(
+		"/staking/tokenize_share_records",
+		tokenizeShareRecordsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get a tokenize share record
+	r.HandleFunc(
+		"/staking/tokenize_share_records/{recordID}",
+		tokenizeShareRecordHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// Get the current state of the staking pool
 	r.HandleFunc(
 		"/staking/pool",
@@ -281,6 +305,58 @@ func validatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *code
 	return queryValidator(cliCtx, cdc, "custom/staking/validatorUnbondingDelegations")
 }
 
+// HTTP request handler to query the change scheduled for a validator
+func validatorChangeHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryValidator(cliCtx, cdc, "custom/staking/validatorChange")
+}
+
+// HTTP request handler to query all the scheduled validator changes
+func validatorChangesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData("custom/staking/validatorChanges", nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// HTTP request handler to query all the tokenize share records
+func tokenizeShareRecordsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData("custom/staking/tokenizeShareRecords", nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// HTTP request handler to query a tokenize share record
+func tokenizeShareRecordHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["recordID"])
+		if !ok {
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(staking.NewQueryTokenizeShareRecordParams(id))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData("custom/staking/tokenizeShareRecord", bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 // HTTP request handler to query the pool information
 func poolHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
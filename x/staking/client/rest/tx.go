@@ -28,6 +28,14 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cdc, kb, cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_shares",
+		postTokenizeSharesHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redeem_tokens",
+		postRedeemTokensHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -55,6 +63,22 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address"` // in bech32
 		SharesAmount     sdk.Dec        `json:"shares"`
 	}
+
+	// MsgTokenizeSharesInput defines the properties of a tokenize shares request's body.
+	MsgTokenizeSharesInput struct {
+		BaseReq          rest.BaseReq   `json:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address"` // in bech32
+		SharesAmount     sdk.Dec        `json:"shares"`
+		RewardOwner      sdk.AccAddress `json:"reward_owner"` // in bech32
+	}
+
+	// MsgRedeemTokensInput defines the properties of a redeem tokens request's body.
+	MsgRedeemTokensInput struct {
+		BaseReq          rest.BaseReq   `json:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount"`
+	}
 )
 
 func postDelegationsHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
@@ -158,3 +182,71 @@ func postUnbondingDelegationsHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postTokenizeSharesHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MsgTokenizeSharesInput
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := staking.NewMsgTokenizeShares(req.DelegatorAddress, req.ValidatorAddress, req.SharesAmount, req.RewardOwner)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRedeemTokensHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MsgRedeemTokensInput
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := staking.NewMsgRedeemTokensForShares(req.DelegatorAddress, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -72,6 +72,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 		}
 	}
 
+	for _, change := range data.ValidatorChanges {
+		keeper.SetValidatorChange(ctx, change)
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+	}
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordID)
+
 	// don't need to run Tendermint updates if we exported
 	if data.Exported {
 		for _, lv := range data.LastValidatorPowers {
@@ -110,6 +119,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		redelegations = append(redelegations, red)
 		return false
 	})
+	validatorChanges := keeper.GetAllValidatorChanges(ctx)
 	var lastValidatorPowers []types.LastValidatorPower
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
 		lastValidatorPowers = append(lastValidatorPowers, types.LastValidatorPower{addr, power})
@@ -125,7 +135,12 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		Delegations:          delegations,
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
-		Exported:             true,
+		ValidatorChanges:     validatorChanges,
+
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordID: keeper.GetLastTokenizeShareRecordID(ctx),
+
+		Exported: true,
 	}
 }
 
@@ -155,6 +170,14 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateValidatorChanges(data.Validators, data.ValidatorChanges)
+	if err != nil {
+		return err
+	}
+	err = validateGenesisStateTokenizeShareRecords(data.Validators, data.TokenizeShareRecords, data.LastTokenizeShareRecordID)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -177,3 +200,53 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	}
 	return
 }
+
+func validateGenesisStateValidatorChanges(validators []types.Validator, changes []types.ValidatorChange) error {
+	operators := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		operators[val.OperatorAddress.String()] = true
+	}
+
+	scheduled := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if err := change.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid change of validator %s in genesis state: %s", change.ValidatorAddress, err.Error())
+		}
+		operator := change.ValidatorAddress.String()
+		if !operators[operator] {
+			return fmt.Errorf("change of unknown validator %s in genesis state", operator)
+		}
+		if scheduled[operator] {
+			return fmt.Errorf("duplicate change of validator %s in genesis state", operator)
+		}
+		scheduled[operator] = true
+	}
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(validators []types.Validator, records []types.TokenizeShareRecord,
+	lastID uint64) error {
+
+	operators := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		operators[val.OperatorAddress.String()] = true
+	}
+
+	ids := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if record.ID == 0 || record.ID > lastID {
+			return fmt.Errorf("invalid tokenize share record ID %d in genesis state, the last ID is %d", record.ID, lastID)
+		}
+		if ids[record.ID] {
+			return fmt.Errorf("duplicate tokenize share record %d in genesis state", record.ID)
+		}
+		if record.Owner.Empty() {
+			return fmt.Errorf("tokenize share record %d in genesis state has no owner", record.ID)
+		}
+		if !operators[record.Validator.String()] {
+			return fmt.Errorf("tokenize share record %d of unknown validator %s in genesis state", record.ID, record.Validator)
+		}
+		ids[record.ID] = true
+	}
+	return nil
+}
@@ -48,6 +48,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgEditValidator:
 			return handleMsgEditValidator(ctx, msg, k)
 
+		/**
+		预告 验证人佣金比或最小自委托的变更，到生效时间时由 EndBlocker 执行
+		 */
+		case types.MsgScheduleValidatorChange:
+			return handleMsgScheduleValidatorChange(ctx, msg, k)
+
 		/**
 		发起 委托
 		 */
@@ -65,6 +71,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		 */
 		case types.MsgUndelegate:
 			return handleMsgUndelegate(ctx, msg, k)
+
+		/**
+		将委托份额代币化为可转让的份额代币
+		 */
+		case types.MsgTokenizeShares:
+			return handleMsgTokenizeShares(ctx, msg, k)
+
+		/**
+		用份额代币赎回委托
+		 */
+		case types.MsgRedeemTokensForShares:
+			return handleMsgRedeemTokensForShares(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -120,7 +138,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, sdk.T
 			continue
 		}
 
-		resTags.AppendTags(sdk.NewTags(
+		resTags = resTags.AppendTags(sdk.NewTags(
 			tags.Action, ActionCompleteUnbonding,
 			tags.Delegator, dvPair.DelegatorAddress.String(),
 			tags.SrcValidator, dvPair.ValidatorAddress.String(),
@@ -139,7 +157,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, sdk.T
 			continue
 		}
 
-		resTags.AppendTags(sdk.NewTags(
+		resTags = resTags.AppendTags(sdk.NewTags(
 			tags.Action, tags.ActionCompleteRedelegation,
 			tags.Delegator, dvvTriplet.DelegatorAddress.String(),
 			tags.SrcValidator, dvvTriplet.ValidatorSrcAddress.String(),
@@ -147,6 +165,15 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, sdk.T
 		))
 	}
 
+	// Apply the validator changes that took effect.
+	// 执行到了生效时间的验证人变更
+	for _, change := range k.ApplyAllMatureValidatorChanges(ctx) {
+		resTags = resTags.AppendTags(sdk.NewTags(
+			tags.Action, tags.ActionCompleteValidatorChange,
+			tags.DstValidator, change.ValidatorAddress.String(),
+		))
+	}
+
 	return validatorUpdates, resTags
 }
 
@@ -251,6 +278,9 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	// 设置 addr -> pubkey
 	k.AfterValidatorCreated(ctx, validator.OperatorAddress)
 
+	// the hooks may have modified the validator, e.g. jailed it
+	validator, _ = k.GetValidator(ctx, validator.OperatorAddress)
+
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
 	/**
@@ -299,18 +329,11 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	// 更新
 	validator.Description = description
 
-	// 入参的佣金比率 不为空，则更新佣金比
+	// commission changes must be announced ahead with a MsgScheduleValidatorChange,
+	// so that delegators can react before they take effect
+	// 佣金比率的变更必须通过 MsgScheduleValidatorChange 提前公告
 	if msg.CommissionRate != nil {
-		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
-		if err != nil {
-			return err.Result()
-		}
-
-		// call the before-modification hook since we're about to update the commission
-		// 因为我们更新了佣金，所以必须做一些前置处理 [该方法目前还未实现]
-		k.BeforeValidatorModified(ctx, msg.ValidatorAddress)
-
-		validator.Commission = commission
+		return ErrCommissionChangeNotScheduled(k.Codespace()).Result()
 	}
 
 	// 如果新入参的 最小自委托 不为nil
@@ -342,6 +365,21 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	}
 }
 
+func handleMsgScheduleValidatorChange(ctx sdk.Context, msg types.MsgScheduleValidatorChange, k keeper.Keeper) sdk.Result {
+	if err := k.ScheduleValidatorChange(ctx, msg.Change()); err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.DstValidator, msg.ValidatorAddress.String(),
+		tags.EndTime, msg.EffectiveTime.Format(time.RFC3339),
+	)
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
 
 /**
 ###########
@@ -427,3 +465,33 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 
 	return sdk.Result{Data: finishTime, Tags: resTags}
 }
+
+func handleMsgTokenizeShares(ctx sdk.Context, msg types.MsgTokenizeShares, k keeper.Keeper) sdk.Result {
+	shareToken, err := k.TokenizeShares(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.SharesAmount, msg.RewardOwner)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Delegator, msg.DelegatorAddress.String(),
+		tags.SrcValidator, msg.ValidatorAddress.String(),
+		tags.ShareDenom, shareToken.Denom,
+	)
+
+	return sdk.Result{Data: types.MsgCdc.MustMarshalBinaryLengthPrefixed(shareToken), Tags: resTags}
+}
+
+func handleMsgRedeemTokensForShares(ctx sdk.Context, msg types.MsgRedeemTokensForShares, k keeper.Keeper) sdk.Result {
+	record, shares, err := k.RedeemTokensForShares(ctx, msg.DelegatorAddress, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Delegator, msg.DelegatorAddress.String(),
+		tags.DstValidator, record.Validator.String(),
+		tags.ShareDenom, msg.Amount.Denom,
+	)
+
+	return sdk.Result{Data: types.MsgCdc.MustMarshalBinaryLengthPrefixed(shares), Tags: resTags}
+}
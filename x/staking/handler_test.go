@@ -402,6 +402,47 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.False(t, got.IsOK(), "should not be able to increase minSelfDelegation above current self delegation")
 }
 
+func TestEditValidatorCommissionMustBeScheduled(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+
+	// create validator
+	commission := NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator := types.NewMsgCreateValidator(validatorAddr, keep.PKs[0],
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(10)), Description{}, commission, sdk.OneInt())
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	// the commission can not be changed right away
+	newRate := sdk.NewDecWithPrec(15, 2)
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "should not be able to edit the commission rate")
+	require.Equal(t, ErrCommissionChangeNotScheduled(keeper.Codespace()).Code(), got.Code)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	// but it can be scheduled
+	effectiveTime := ctx.BlockHeader().Time.Add(25 * time.Hour)
+	msgScheduleChange := NewMsgScheduleValidatorChange(validatorAddr, &newRate, nil, effectiveTime)
+	got = handleMsgScheduleValidatorChange(ctx, msgScheduleChange, keeper)
+	require.True(t, got.IsOK(), "expected schedule-validator-change to be ok, got %v", got)
+
+	// and is applied by the end blocker at its effective time
+	ctx = ctx.WithBlockTime(effectiveTime)
+	_, endBlockerTags := EndBlocker(ctx, keeper)
+	require.Equal(t, []byte(ActionCompleteValidatorChange), endBlockerTags[0].Value)
+	require.Equal(t, []byte(validatorAddr.String()), endBlockerTags[1].Value)
+
+	validator, found = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, validator.Commission.Rate)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromTendermintPower(initPower)
@@ -590,6 +590,18 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.In
 	return newShares, nil
 }
 
+// DelegateTokens delegates bond tokens of an account to a validator. It is
+// used by modules which only know the address of the validator.
+func (k Keeper) DelegateTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondAmt sdk.Int) sdk.Error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	_, err := k.Delegate(ctx, delAddr, bondAmt, validator, true)
+	return err
+}
+
 // unbond a particular delegation and perform associated store operations
 /*
 TODO 减持委托
@@ -28,6 +28,9 @@ type Keeper struct {
 	// 这个是管理账户的资产转移的管理器 ??
 	bankKeeper         types.BankKeeper
 
+	// 记录各币种总供应量的管理器，削减销毁的钱要从中扣除
+	supplyKeeper       types.SupplyKeeper
+
 	// 钩子的定义 (AOP一样的存在
 	hooks              sdk.StakingHooks
 
@@ -47,13 +50,14 @@ type Keeper struct {
 }
 
 func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey, bk types.BankKeeper,
-	paramstore params.Subspace, codespace sdk.CodespaceType) Keeper {
+	supplyKeeper types.SupplyKeeper, paramstore params.Subspace, codespace sdk.CodespaceType) Keeper {
 
 	keeper := Keeper{
 		storeKey:           key,
 		storeTKey:          tkey,
 		cdc:                cdc,
 		bankKeeper:         bk,
+		supplyKeeper:       supplyKeeper,
 		paramstore:         paramstore.WithKeyTable(ParamKeyTable()),
 		hooks:              nil,
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
@@ -46,6 +46,7 @@ var (
 	验证人的权重key前缀
 	 */
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorChangeKey        = []byte{0x24} // prefix for each key to a scheduled validator change
 
 
 	/**
@@ -75,6 +76,14 @@ var (
 	验证器队列中时间戳的前缀
 	 */
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	ValidatorChangeQueueKey = []byte{0x44} // prefix for the timestamps in validator change queue
+
+	TokenizeShareRecordKey          = []byte{0x51} // prefix for each key to a tokenize share record
+	TokenizeShareRecordByAddressKey = []byte{0x52} // prefix for each key to a tokenize share record index, by account address
+	TokenizeShareRecordByDenomKey   = []byte{0x53} // prefix for each key to a tokenize share record index, by share token denomination
+	TokenizeShareRecordByOwnerKey   = []byte{0x54} // prefix for each key to a tokenize share record index, by owner
+	LastTokenizeShareRecordIDKey    = []byte{0x55} // key for the ID of the last tokenize share record
 )
 
 // gets the key for the validator with address
@@ -207,6 +216,18 @@ func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	return append(ValidatorQueueKey, bz...)
 }
 
+// gets the key for the change scheduled for the validator with address
+// VALUE: staking/types.ValidatorChange
+func GetValidatorChangeKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorChangeKey, operatorAddr.Bytes()...)
+}
+
+// gets the prefix for all the validator changes taking effect at a timestamp
+func GetValidatorChangeQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ValidatorChangeQueueKey, bz...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for delegator bond with validator
@@ -377,6 +398,37 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		delAddr.Bytes()...)
 }
 
+//______________________________________________________________________________
+
+// gets the key for the tokenize share record with ID
+// VALUE: staking/types.TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the key for the tokenize share record index of an account address
+// VALUE: tokenize share record ID (uint64)
+func GetTokenizeShareRecordByAddressKey(addr sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByAddressKey, addr.Bytes()...)
+}
+
+// gets the key for the tokenize share record index of a share token denomination
+// VALUE: tokenize share record ID (uint64)
+func GetTokenizeShareRecordByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordByDenomKey, []byte(denom)...)
+}
+
+// gets the prefix for the tokenize share records of an owner
+func GetTokenizeShareRecordsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByOwnerKey, owner.Bytes()...)
+}
+
+// gets the key for the tokenize share record index of an owner
+// VALUE: tokenize share record ID (uint64)
+func GetTokenizeShareRecordByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
 //-------------------------------------------------
 
 func cp(bz []byte) (ret []byte) {
@@ -194,10 +194,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	验证人把剩余的需要 扣减的钱  补完
 	*/
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
-	pool := k.GetPool(ctx)
 	// Burn the slashed tokens, which are now loose.
-	pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn) // 销毁掉 所有撤销的总额
-	k.SetPool(ctx, pool)
+	k.burnNotBondedTokens(ctx, tokensToBurn) // 销毁掉 所有撤销的总额
 
 	// Log that a slash occurred!
 	logger.Info(fmt.Sprintf(
@@ -310,12 +308,10 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		entry.Balance = entry.Balance.Sub(unbondingSlashAmount)
 		unbondingDelegation.Entries[i] = entry
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
-		pool := k.GetPool(ctx)
 
 		// Burn not-bonded tokens
 		// Ref https://my-cosmos/cosmos-sdk/pull/1278#discussion_r198657760
-		pool.NotBondedTokens = pool.NotBondedTokens.Sub(unbondingSlashAmount) // 将总的减持的计数去除掉 被惩罚削减 这部分
-		k.SetPool(ctx, pool)
+		k.burnNotBondedTokens(ctx, unbondingSlashAmount) // 将总的减持的计数去除掉 被惩罚削减 这部分
 	}
 
 	// TODO 可以看出来，减掉的钱是直接被销毁掉的
@@ -390,11 +386,19 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		}
 
 		// Burn not-bonded tokens
-		pool := k.GetPool(ctx)
-		pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn) // 减持的总数中 销毁掉被削减的这部分钱
-		k.SetPool(ctx, pool)
+		k.burnNotBondedTokens(ctx, tokensToBurn) // 减持的总数中 销毁掉被削减的这部分钱
 	}
 
 	// 返回 总共被削减的钱
 	return totalSlashAmount
 }
+
+// burn slashed tokens, which are not bonded any more, removing them from the
+// supply of the bond denomination
+func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt sdk.Int) {
+	pool := k.GetPool(ctx)
+	pool.NotBondedTokens = pool.NotBondedTokens.Sub(amt)
+	k.SetPool(ctx, pool)
+
+	k.supplyKeeper.Deflate(ctx, sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), amt)})
+}
@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/staking/types"
+)
+
+// get a tokenize share record by ID
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// get the tokenize share record of a share token denomination
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetTokenizeShareRecordByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+	return k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// get the tokenize share record whose account has the given address
+func (k Keeper) GetTokenizeShareRecordByAddress(ctx sdk.Context, addr sdk.AccAddress) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetTokenizeShareRecordByAddressKey(addr))
+	if bz == nil {
+		return record, false
+	}
+	return k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// get the tokenize share records of an owner
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetTokenizeShareRecordsByOwnerKey(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if !found {
+			panic("tokenize share record index points to a missing record")
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// set a tokenize share record and its indexes
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	id := sdk.Uint64ToBigEndian(record.ID)
+	store.Set(GetTokenizeShareRecordKey(record.ID), bz)
+	store.Set(GetTokenizeShareRecordByAddressKey(record.GetAddress()), id)
+	store.Set(GetTokenizeShareRecordByDenomKey(record.GetShareTokenDenom()), id)
+	store.Set(GetTokenizeShareRecordByOwnerKey(record.Owner, record.ID), id)
+}
+
+// delete a tokenize share record and its indexes
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetTokenizeShareRecordKey(record.ID))
+	store.Delete(GetTokenizeShareRecordByAddressKey(record.GetAddress()))
+	store.Delete(GetTokenizeShareRecordByDenomKey(record.GetShareTokenDenom()))
+	store.Delete(GetTokenizeShareRecordByOwnerKey(record.Owner, record.ID))
+}
+
+// iterate through the tokenize share records
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, fn func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// get all the tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// get the ID of the last tokenize share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// set the ID of the last tokenize share record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// TokenizeShares moves shares of a delegation to the account of a new
+// tokenize share record and mints share tokens of the record to the
+// delegator, one for each bond token the shares are worth. The rewards of the
+// tokenized shares are paid to the owner of the record.
+func (k Keeper) TokenizeShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	shares sdk.Dec, owner sdk.AccAddress) (shareToken sdk.Coin, err sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shareToken, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the self delegation of the operator backs its minimum self delegation
+	if bytes.Equal(delAddr, validator.OperatorAddress) {
+		return shareToken, types.ErrTokenizeSelfDelegation(k.Codespace())
+	}
+
+	// the shares of an incoming redelegation must stay in the delegation so
+	// that they can be slashed for an infraction of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return shareToken, types.ErrTokenizeRedelegationInProgress(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return shareToken, types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+	if delegation.Shares.LT(shares) {
+		return shareToken, types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
+	}
+
+	tokens := validator.ShareTokensTruncated(shares).TruncateInt()
+	if !tokens.IsPositive() {
+		return shareToken, types.ErrTokenizeAmountTooSmall(k.Codespace())
+	}
+
+	record := types.NewTokenizeShareRecord(k.GetLastTokenizeShareRecordID(ctx)+1, owner, valAddr)
+	k.SetLastTokenizeShareRecordID(ctx, record.ID)
+	k.SetTokenizeShareRecord(ctx, record)
+
+	bondCoins := sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), tokens)}
+	if err := k.moveDelegatedCoins(ctx, delAddr, record.GetAddress(), bondCoins); err != nil {
+		return shareToken, err
+	}
+	k.moveDelegationShares(ctx, delAddr, record.GetAddress(), valAddr, shares)
+
+	shareToken = sdk.NewCoin(record.GetShareTokenDenom(), tokens)
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{shareToken}); err != nil {
+		return shareToken, err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.Coins{shareToken}); err != nil {
+		return shareToken, err
+	}
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens held by the delegator and moves
+// the matching part of the tokenized delegation to the delegator. The record
+// is deleted once all its share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress,
+	shareToken sdk.Coin) (record types.TokenizeShareRecord, shares sdk.Dec, err sdk.Error) {
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if !found {
+		return record, shares, types.ErrNoTokenizeShareRecord(k.Codespace())
+	}
+
+	validator, found := k.GetValidator(ctx, record.Validator)
+	if !found {
+		return record, shares, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetAddress(), record.Validator)
+	if !found {
+		return record, shares, types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+
+	// the shares are split among the share tokens in circulation, so the
+	// supply has to be read before burning the redeemed ones
+	supply := k.supplyKeeper.GetSupply(ctx, shareToken.Denom)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.Coins{shareToken}); err != nil {
+		return record, shares, err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{shareToken}); err != nil {
+		return record, shares, err
+	}
+
+	shares = delegation.Shares
+	if shareToken.Amount.LT(supply) {
+		shares = delegation.Shares.MulInt(shareToken.Amount).QuoInt(supply)
+	}
+
+	tokens := validator.ShareTokensTruncated(shares).TruncateInt()
+	if tokens.IsPositive() {
+		bondCoins := sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), tokens)}
+		if err := k.moveDelegatedCoins(ctx, record.GetAddress(), delAddr, bondCoins); err != nil {
+			return record, shares, err
+		}
+	}
+	k.moveDelegationShares(ctx, record.GetAddress(), delAddr, record.Validator, shares)
+
+	if shares.Equal(delegation.Shares) {
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+	return record, shares, nil
+}
+
+// move the accounting of delegated coins from an account to another, as if
+// the first one undelegated and sent them to the second one which delegated
+// them. This keeps the delegated vesting coins of vesting accounts locked.
+func (k Keeper) moveDelegatedCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if _, err := k.bankKeeper.UndelegateCoins(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	if _, err := k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	_, err := k.bankKeeper.DelegateCoins(ctx, toAddr, amt)
+	return err
+}
+
+// move shares from a delegation to the delegation of another account to the
+// same validator. The tokens of the validator do not change, and the hooks
+// let the distribution module settle the rewards of both delegations.
+func (k Keeper) moveDelegationShares(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, shares sdk.Dec) {
+
+	delegation, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		panic("moving shares of a delegation that does not exist")
+	}
+
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	recipient, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		recipient = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	recipient.Shares = recipient.Shares.Add(shares)
+	k.SetDelegation(ctx, recipient)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+}
+
+// TokenizeShareRecordOwner returns the owner of the tokenize share record
+// whose account has the given address, to which the rewards of the tokenized
+// delegation are paid
+func (k Keeper) TokenizeShareRecordOwner(ctx sdk.Context, addr sdk.AccAddress) (owner sdk.AccAddress, found bool) {
+	record, found := k.GetTokenizeShareRecordByAddress(ctx, addr)
+	if !found {
+		return owner, false
+	}
+	return record.Owner, true
+}
+
+// TokenizeShareRecordDelegations returns the tokenized delegations of the
+// records of an owner
+func (k Keeper) TokenizeShareRecordDelegations(ctx sdk.Context, owner sdk.AccAddress) (delegations []sdk.Delegation) {
+	for _, record := range k.GetTokenizeShareRecordsByOwner(ctx, owner) {
+		delegation, found := k.GetDelegation(ctx, record.GetAddress(), record.Validator)
+		if found {
+			delegations = append(delegations, delegation)
+		}
+	}
+	return delegations
+}
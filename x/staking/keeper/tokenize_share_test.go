@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupTokenizeShareValidator(t *testing.T, ctx sdk.Context, keeper Keeper) types.Validator {
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+
+	delTokens := sdk.TokensFromTendermintPower(10)
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, validator, true)
+	require.Nil(t, err)
+
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	return validator
+}
+
+func TestTokenizeShares(t *testing.T) {
+	ctx, ak, keeper := CreateTestInput(t, false, 100)
+	validator := setupTokenizeShareValidator(t, ctx, keeper)
+	bondDenom := keeper.BondDenom(ctx)
+	bondedBefore := ak.GetAccount(ctx, addrDels[0]).GetCoins().AmountOf(bondDenom)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	shares := delegation.Shares.QuoInt64(2)
+
+	shareToken, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], shares, addrDels[0])
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("%s/1", addrVals[0]), shareToken.Denom)
+	require.Equal(t, validator.ShareTokensTruncated(shares).TruncateInt(), shareToken.Amount)
+	require.Equal(t, uint64(1), keeper.GetLastTokenizeShareRecordID(ctx))
+
+	// the share tokens are minted to the delegator
+	coins := ak.GetAccount(ctx, addrDels[0]).GetCoins()
+	require.Equal(t, shareToken.Amount, coins.AmountOf(shareToken.Denom))
+	require.Equal(t, bondedBefore, coins.AmountOf(bondDenom))
+
+	// the shares are moved to the record account
+	record, found := keeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.True(t, found)
+	require.Equal(t, addrDels[0], record.Owner)
+	require.Equal(t, addrVals[0], record.Validator)
+
+	recordDelegation, found := keeper.GetDelegation(ctx, record.GetAddress(), addrVals[0])
+	require.True(t, found)
+	require.Equal(t, shares, recordDelegation.Shares)
+
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, shares, delegation.Shares)
+
+	// the validator is not affected
+	updated, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, updated.Tokens)
+	require.Equal(t, validator.DelegatorShares, updated.DelegatorShares)
+
+	// the reward owner is resolved from the record account
+	owner, found := keeper.TokenizeShareRecordOwner(ctx, record.GetAddress())
+	require.True(t, found)
+	require.Equal(t, addrDels[0], owner)
+	require.Len(t, keeper.TokenizeShareRecordDelegations(ctx, addrDels[0]), 1)
+}
+
+func TestTokenizeSharesInvalid(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100)
+	validator := setupTokenizeShareValidator(t, ctx, keeper)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+
+	// more shares than delegated
+	_, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], delegation.Shares.MulInt64(2), addrDels[0])
+	require.NotNil(t, err)
+
+	// no delegation
+	_, err = keeper.TokenizeShares(ctx, addrDels[1], addrVals[0], delegation.Shares, addrDels[1])
+	require.NotNil(t, err)
+
+	// no validator
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[1], delegation.Shares, addrDels[0])
+	require.NotNil(t, err)
+
+	// self delegation
+	selfDelAddr := sdk.AccAddress(addrVals[0])
+	_, err = keeper.Delegate(ctx, selfDelAddr, sdk.TokensFromTendermintPower(1), validator, true)
+	require.Nil(t, err)
+	selfDelegation, found := keeper.GetDelegation(ctx, selfDelAddr, addrVals[0])
+	require.True(t, found)
+	_, err = keeper.TokenizeShares(ctx, selfDelAddr, addrVals[0], selfDelegation.Shares, selfDelAddr)
+	require.Equal(t, types.ErrTokenizeSelfDelegation(types.DefaultCodespace).Code(), err.Code())
+
+	// nothing was tokenized
+	require.Equal(t, uint64(0), keeper.GetLastTokenizeShareRecordID(ctx))
+	require.Empty(t, keeper.GetAllTokenizeShareRecords(ctx))
+}
+
+func TestRedeemTokensForShares(t *testing.T) {
+	ctx, ak, keeper := CreateTestInput(t, false, 100)
+	setupTokenizeShareValidator(t, ctx, keeper)
+	bondDenom := keeper.BondDenom(ctx)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	shareToken, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], delegation.Shares, addrDels[0])
+	require.Nil(t, err)
+
+	// the whole delegation is tokenized
+	_, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	// transfer half of the share tokens to another account
+	half := sdk.NewCoin(shareToken.Denom, shareToken.Amount.QuoRaw(2))
+	_, err = keeper.bankKeeper.SendCoins(ctx, addrDels[0], addrDels[1], sdk.Coins{half})
+	require.Nil(t, err)
+
+	// unknown share token
+	_, _, err = keeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(fmt.Sprintf("%s/2", addrVals[0]), half.Amount))
+	require.NotNil(t, err)
+
+	// more share tokens than held
+	_, _, err = keeper.RedeemTokensForShares(ctx, addrDels[1], shareToken)
+	require.NotNil(t, err)
+
+	bondedBefore := ak.GetAccount(ctx, addrDels[1]).GetCoins().AmountOf(bondDenom)
+	record, shares, err := keeper.RedeemTokensForShares(ctx, addrDels[1], half)
+	require.Nil(t, err)
+	require.Equal(t, delegation.Shares.QuoInt64(2), shares)
+	require.Equal(t, bondedBefore, ak.GetAccount(ctx, addrDels[1]).GetCoins().AmountOf(bondDenom))
+	require.True(t, ak.GetAccount(ctx, addrDels[1]).GetCoins().AmountOf(shareToken.Denom).IsZero())
+
+	redeemed, found := keeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, shares, redeemed.Shares)
+
+	// the record stays while share tokens are in circulation
+	_, found = keeper.GetTokenizeShareRecord(ctx, record.ID)
+	require.True(t, found)
+
+	// redeeming the remaining share tokens deletes the record
+	_, shares, err = keeper.RedeemTokensForShares(ctx, addrDels[0], half)
+	require.Nil(t, err)
+	require.Equal(t, delegation.Shares.QuoInt64(2), shares)
+
+	_, found = keeper.GetTokenizeShareRecord(ctx, record.ID)
+	require.False(t, found)
+	_, found = keeper.GetDelegation(ctx, record.GetAddress(), addrVals[0])
+	require.False(t, found)
+	require.Empty(t, keeper.TokenizeShareRecordDelegations(ctx, addrDels[0]))
+}
+
+func TestRedeemTokensForSharesAfterSlash(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100)
+	validator := setupTokenizeShareValidator(t, ctx, keeper)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	shareToken, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], delegation.Shares, addrDels[0])
+	require.Nil(t, err)
+
+	// the tokenized delegation is slashed along with the validator
+	validator = keeper.RemoveValidatorTokens(ctx, validator, validator.Tokens.QuoRaw(2))
+
+	_, shares, err := keeper.RedeemTokensForShares(ctx, addrDels[0], shareToken)
+	require.Nil(t, err)
+	require.Equal(t, delegation.Shares, shares)
+	require.Equal(t, shareToken.Amount.QuoRaw(2), validator.ShareTokens(shares).TruncateInt())
+}
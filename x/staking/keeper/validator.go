@@ -263,6 +263,11 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(GetValidatorByConsAddrKey(sdk.ConsAddress(validator.ConsPubKey.Address())))
 	store.Delete(GetValidatorsByPowerIndexKey(validator))
 
+	// drop the change scheduled for the validator
+	if change, found := k.GetValidatorChange(ctx, address); found {
+		k.RemoveValidatorChange(ctx, change)
+	}
+
 	// call hooks
 	k.AfterValidatorRemoved(ctx, validator.ConsAddress(), validator.OperatorAddress)
 }
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/staking/types"
+)
+
+// get the change scheduled for a validator
+func (k Keeper) GetValidatorChange(ctx sdk.Context, addr sdk.ValAddress) (change types.ValidatorChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorChangeKey(addr))
+	if bz == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &change)
+	return change, true
+}
+
+// set the change scheduled for a validator and insert it in the validator
+// change queue
+func (k Keeper) SetValidatorChange(ctx sdk.Context, change types.ValidatorChange) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(change)
+	store.Set(GetValidatorChangeKey(change.ValidatorAddress), bz)
+	k.InsertValidatorChangeQueue(ctx, change)
+}
+
+// remove the change scheduled for a validator from the store and from the
+// validator change queue
+func (k Keeper) RemoveValidatorChange(ctx sdk.Context, change types.ValidatorChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorChangeKey(change.ValidatorAddress))
+	k.DeleteValidatorChangeQueue(ctx, change)
+}
+
+// iterate through the scheduled validator changes
+func (k Keeper) IterateValidatorChanges(ctx sdk.Context, fn func(change types.ValidatorChange) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ValidatorChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &change)
+		if fn(change) {
+			break
+		}
+	}
+}
+
+// get all the scheduled validator changes
+func (k Keeper) GetAllValidatorChanges(ctx sdk.Context) (changes []types.ValidatorChange) {
+	k.IterateValidatorChanges(ctx, func(change types.ValidatorChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+	return changes
+}
+
+// ScheduleValidatorChange checks a change of the commission rate or of the
+// minimum self delegation of a validator against the validator as it is now,
+// and schedules it, replacing the change already scheduled for the validator.
+// The commission rate must respect the maximum rate and the maximum change
+// rate, and the minimum self delegation can only be increased.
+func (k Keeper) ScheduleValidatorChange(ctx sdk.Context, change types.ValidatorChange) sdk.Error {
+	validator, found := k.GetValidator(ctx, change.ValidatorAddress)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+
+	if !change.EffectiveTime.After(ctx.BlockHeader().Time) {
+		return types.ErrValidatorChangeNotInFuture(k.codespace)
+	}
+
+	if change.CommissionRate != nil {
+		if err := validator.Commission.ValidateNewRate(*change.CommissionRate, change.EffectiveTime); err != nil {
+			return err
+		}
+	}
+
+	if change.MinSelfDelegation != nil && !(*change.MinSelfDelegation).GT(validator.MinSelfDelegation) {
+		return types.ErrMinSelfDelegationDecreased(k.codespace)
+	}
+
+	if old, found := k.GetValidatorChange(ctx, change.ValidatorAddress); found {
+		k.RemoveValidatorChange(ctx, old)
+	}
+	k.SetValidatorChange(ctx, change)
+	return nil
+}
+
+// ApplyAllMatureValidatorChanges applies the validator changes that took
+// effect by the current block time and removes them from the queue. A change
+// that is no longer valid, because the validator changed in the meantime, is
+// dropped as a whole. It returns the applied changes.
+func (k Keeper) ApplyAllMatureValidatorChanges(ctx sdk.Context) (applied []types.ValidatorChange) {
+	logger := ctx.Logger().With("module", "x/staking")
+	store := ctx.KVStore(k.storeKey)
+
+	changeTimesliceIterator := k.ValidatorChangeQueueIterator(ctx, ctx.BlockHeader().Time)
+	defer changeTimesliceIterator.Close()
+
+	for ; changeTimesliceIterator.Valid(); changeTimesliceIterator.Next() {
+		timeslice := []sdk.ValAddress{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(changeTimesliceIterator.Value(), &timeslice)
+		for _, valAddr := range timeslice {
+			change, found := k.GetValidatorChange(ctx, valAddr)
+			if !found {
+				panic("validator in the validator change queue has no scheduled change")
+			}
+			store.Delete(GetValidatorChangeKey(valAddr))
+
+			if err := k.applyValidatorChange(ctx, change); err != nil {
+				logger.Info(fmt.Sprintf("dropped change of validator %s: %s", valAddr, err.Error()))
+				continue
+			}
+			applied = append(applied, change)
+		}
+		store.Delete(changeTimesliceIterator.Key())
+	}
+	return applied
+}
+
+// apply a scheduled validator change, rechecking it against the validator as
+// it is at the effective time
+func (k Keeper) applyValidatorChange(ctx sdk.Context, change types.ValidatorChange) sdk.Error {
+	validator, found := k.GetValidator(ctx, change.ValidatorAddress)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+
+	if change.CommissionRate != nil {
+		commission, err := k.UpdateValidatorCommission(ctx, validator, *change.CommissionRate)
+		if err != nil {
+			return err
+		}
+		validator.Commission = commission
+	}
+
+	if change.MinSelfDelegation != nil {
+		if !(*change.MinSelfDelegation).GT(validator.MinSelfDelegation) {
+			return types.ErrMinSelfDelegationDecreased(k.codespace)
+		}
+		if (*change.MinSelfDelegation).GT(validator.Tokens) {
+			return types.ErrSelfDelegationBelowMinimum(k.codespace)
+		}
+		validator.MinSelfDelegation = *change.MinSelfDelegation
+	}
+
+	// call the before-modification hook since we're about to update the commission
+	if change.CommissionRate != nil {
+		k.BeforeValidatorModified(ctx, change.ValidatorAddress)
+	}
+
+	k.SetValidator(ctx, validator)
+	return nil
+}
+
+//_______________________________________________________________________
+// Validator Change Queue
+
+// gets a specific validator change queue timeslice. A timeslice is a slice of
+// ValAddresses corresponding to the validators whose change takes effect at a
+// certain time.
+func (k Keeper) GetValidatorChangeQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorChangeQueueTimeKey(timestamp))
+	if bz == nil {
+		return []sdk.ValAddress{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &valAddrs)
+	return valAddrs
+}
+
+// Sets a specific validator change queue timeslice.
+func (k Keeper) SetValidatorChangeQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	store.Set(GetValidatorChangeQueueTimeKey(timestamp), bz)
+}
+
+// Deletes a specific validator change queue timeslice.
+func (k Keeper) DeleteValidatorChangeQueueTimeSlice(ctx sdk.Context, timestamp time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorChangeQueueTimeKey(timestamp))
+}
+
+// Insert a validator address to the appropriate timeslice in the validator
+// change queue
+func (k Keeper) InsertValidatorChangeQueue(ctx sdk.Context, change types.ValidatorChange) {
+	timeSlice := k.GetValidatorChangeQueueTimeSlice(ctx, change.EffectiveTime)
+	keys := append(timeSlice, change.ValidatorAddress)
+	k.SetValidatorChangeQueueTimeSlice(ctx, change.EffectiveTime, keys)
+}
+
+// Delete a validator address from the validator change queue
+func (k Keeper) DeleteValidatorChangeQueue(ctx sdk.Context, change types.ValidatorChange) {
+	timeSlice := k.GetValidatorChangeQueueTimeSlice(ctx, change.EffectiveTime)
+	newTimeSlice := []sdk.ValAddress{}
+	for _, addr := range timeSlice {
+		if !bytes.Equal(addr, change.ValidatorAddress) {
+			newTimeSlice = append(newTimeSlice, addr)
+		}
+	}
+	if len(newTimeSlice) == 0 {
+		k.DeleteValidatorChangeQueueTimeSlice(ctx, change.EffectiveTime)
+	} else {
+		k.SetValidatorChangeQueueTimeSlice(ctx, change.EffectiveTime, newTimeSlice)
+	}
+}
+
+// Returns all the validator change queue timeslices from time 0 until endTime
+func (k Keeper) ValidatorChangeQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(ValidatorChangeQueueKey,
+		sdk.InclusiveEndBytes(GetValidatorChangeQueueTimeKey(endTime)))
+}
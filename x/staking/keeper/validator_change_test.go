@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleValidatorChange(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	commission := types.NewCommissionWithTime(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(1, 1), now.Add(-time.Hour),
+	)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, _ = validator.SetInitialCommission(commission)
+	keeper.SetValidator(ctx, validator)
+
+	rate := sdk.NewDecWithPrec(2, 1)
+	tooHighRate := sdk.NewDecWithPrec(4, 1)
+	minSelf := sdk.NewInt(2)
+	lowerMinSelf := sdk.ZeroInt()
+	later := now.Add(48 * time.Hour)
+
+	testCases := []struct {
+		change      types.ValidatorChange
+		expectedErr bool
+	}{
+		{types.NewValidatorChange(addrVals[1], &rate, nil, later), true},
+		{types.NewValidatorChange(addrVals[0], &rate, nil, now), true},
+		{types.NewValidatorChange(addrVals[0], &rate, nil, now.Add(time.Hour)), true},
+		{types.NewValidatorChange(addrVals[0], &tooHighRate, nil, later), true},
+		{types.NewValidatorChange(addrVals[0], nil, &lowerMinSelf, later), true},
+		{types.NewValidatorChange(addrVals[0], &rate, &minSelf, later), false},
+	}
+
+	for i, tc := range testCases {
+		err := keeper.ScheduleValidatorChange(ctx, tc.change)
+		if tc.expectedErr {
+			require.NotNil(t, err, "expected error for test case #%d", i)
+			continue
+		}
+		require.Nil(t, err, "unexpected error for test case #%d", i)
+
+		change, found := keeper.GetValidatorChange(ctx, addrVals[0])
+		require.True(t, found)
+		require.Equal(t, tc.change, change)
+		require.Equal(t, []sdk.ValAddress{addrVals[0]}, keeper.GetValidatorChangeQueueTimeSlice(ctx, later))
+	}
+
+	// a new change replaces the scheduled one
+	evenLater := later.Add(time.Hour)
+	err := keeper.ScheduleValidatorChange(ctx, types.NewValidatorChange(addrVals[0], &rate, nil, evenLater))
+	require.Nil(t, err)
+	require.Len(t, keeper.GetAllValidatorChanges(ctx), 1)
+	require.Empty(t, keeper.GetValidatorChangeQueueTimeSlice(ctx, later))
+	require.Equal(t, []sdk.ValAddress{addrVals[0]}, keeper.GetValidatorChangeQueueTimeSlice(ctx, evenLater))
+}
+
+func TestApplyAllMatureValidatorChanges(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	commission := types.NewCommissionWithTime(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(1, 1), now.Add(-time.Hour),
+	)
+	pool := keeper.GetPool(ctx)
+	for i := 0; i < 2; i++ {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator, _ = validator.SetInitialCommission(commission)
+		validator, pool, _ = validator.AddTokensFromDel(pool, sdk.NewInt(10))
+		keeper.SetValidator(ctx, validator)
+	}
+	keeper.SetPool(ctx, pool)
+
+	rate := sdk.NewDecWithPrec(2, 1)
+	minSelf := sdk.NewInt(5)
+	tooHighMinSelf := sdk.NewInt(100)
+	effective := now.Add(48 * time.Hour)
+
+	require.Nil(t, keeper.ScheduleValidatorChange(ctx,
+		types.NewValidatorChange(addrVals[0], &rate, &minSelf, effective)))
+	require.Nil(t, keeper.ScheduleValidatorChange(ctx,
+		types.NewValidatorChange(addrVals[1], &rate, &tooHighMinSelf, effective)))
+
+	// nothing is applied before the effective time
+	ctx = ctx.WithBlockHeader(abci.Header{Time: effective.Add(-time.Second)})
+	require.Empty(t, keeper.ApplyAllMatureValidatorChanges(ctx))
+	require.Len(t, keeper.GetAllValidatorChanges(ctx), 2)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: effective})
+	applied := keeper.ApplyAllMatureValidatorChanges(ctx)
+	require.Len(t, applied, 1)
+	require.Equal(t, addrVals[0], applied[0].ValidatorAddress)
+	require.Empty(t, keeper.GetAllValidatorChanges(ctx))
+	require.Empty(t, keeper.GetValidatorChangeQueueTimeSlice(ctx, effective))
+
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, rate, validator.Commission.Rate)
+	require.Equal(t, effective, validator.Commission.UpdateTime)
+	require.Equal(t, minSelf, validator.MinSelfDelegation)
+
+	// the change exceeding the validator tokens is dropped as a whole
+	validator, found = keeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, commission.Rate, validator.Commission.Rate)
+	require.Equal(t, sdk.OneInt(), validator.MinSelfDelegation)
+}
@@ -28,6 +28,10 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryValidatorChange               = "validatorChange"
+	QueryValidatorChanges              = "validatorChanges"
+	QueryTokenizeShareRecord           = "tokenizeShareRecord"
+	QueryTokenizeShareRecords          = "tokenizeShareRecords"
 )
 
 // creates a querier for staking REST endpoints
@@ -68,6 +72,16 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 			return queryPool(ctx, cdc, k)
 		case QueryParameters:
 			return queryParameters(ctx, cdc, k)
+		case QueryValidatorChange:
+			// 查询验证人预告的变更
+			return queryValidatorChange(ctx, cdc, req, k)
+		case QueryValidatorChanges:
+			return queryValidatorChanges(ctx, cdc, k)
+		case QueryTokenizeShareRecord:
+			// 查询委托代币化的记录
+			return queryTokenizeShareRecord(ctx, cdc, req, k)
+		case QueryTokenizeShareRecords:
+			return queryTokenizeShareRecords(ctx, cdc, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -94,6 +108,7 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+// - 'custom/staking/validatorChange'
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
 }
@@ -136,6 +151,18 @@ func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress, srcValidatorAddr s
 	}
 }
 
+// defines the params for the following queries:
+// - 'custom/staking/tokenizeShareRecord'
+type QueryTokenizeShareRecordParams struct {
+	ID uint64
+}
+
+func NewQueryTokenizeShareRecordParams(id uint64) QueryTokenizeShareRecordParams {
+	return QueryTokenizeShareRecordParams{
+		ID: id,
+	}
+}
+
 func queryValidators(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
 	stakingParams := k.GetParams(ctx)
 	validators := k.GetValidators(ctx, stakingParams.MaxValidators)
@@ -365,3 +392,69 @@ func queryParameters(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []by
 	}
 	return res, nil
 }
+
+func queryValidatorChange(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryValidatorParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+	}
+
+	change, found := k.GetValidatorChange(ctx, params.ValidatorAddr)
+	if !found {
+		return []byte{}, types.ErrNoValidatorChange(types.DefaultCodespace)
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, change)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryValidatorChanges(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
+	changes := k.GetAllValidatorChanges(ctx)
+	if changes == nil {
+		changes = []types.ValidatorChange{}
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, changes)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryTokenizeShareRecord(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryTokenizeShareRecordParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, params.ID)
+	if !found {
+		return []byte{}, types.ErrNoTokenizeShareRecord(types.DefaultCodespace)
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, record)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryTokenizeShareRecords(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
+	records := k.GetAllTokenizeShareRecords(ctx)
+	if records == nil {
+		records = []types.TokenizeShareRecord{}
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, records)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
@@ -12,12 +12,10 @@ import (
 
 // AllInvariants runs all invariants of the staking module.
 // Currently: total supply, positive power
-func AllInvariants(k staking.Keeper,
-	f staking.FeeCollectionKeeper, d staking.DistributionKeeper,
-	am auth.AccountKeeper) sdk.Invariant {
+func AllInvariants(k staking.Keeper, am auth.AccountKeeper) sdk.Invariant {
 
 	return func(ctx sdk.Context) error {
-		err := SupplyInvariants(k, f, d, am)(ctx)
+		err := SupplyInvariants(k, am)(ctx)
 		if err != nil {
 			return err
 		}
@@ -41,10 +39,9 @@ func AllInvariants(k staking.Keeper,
 	}
 }
 
-// SupplyInvariants checks that the total supply reflects all held not-bonded tokens, bonded tokens, and unbonding delegations
-// nolint: unparam
-func SupplyInvariants(k staking.Keeper,
-	f staking.FeeCollectionKeeper, d staking.DistributionKeeper, am auth.AccountKeeper) sdk.Invariant {
+// SupplyInvariants checks that the total supply reflects all held not-bonded tokens, bonded tokens, and unbonding delegations.
+// Collected fees, outstanding rewards and the community pool are held by module accounts.
+func SupplyInvariants(k staking.Keeper, am auth.AccountKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 		pool := k.GetPool(ctx)
 
@@ -67,17 +64,9 @@ func SupplyInvariants(k staking.Keeper,
 			case sdk.Unbonding, sdk.Unbonded:
 				loose = loose.Add(validator.GetTokens().ToDec())
 			}
-			// add yet-to-be-withdrawn
-			loose = loose.Add(d.GetValidatorOutstandingRewardsCoins(ctx, validator.GetOperator()).AmountOf(k.BondDenom(ctx)))
 			return false
 		})
 
-		// add outstanding fees
-		loose = loose.Add(f.GetCollectedFees(ctx).AmountOf(k.BondDenom(ctx)).ToDec())
-
-		// add community pool
-		loose = loose.Add(d.GetFeePoolCommunityCoins(ctx).AmountOf(k.BondDenom(ctx)))
-
 		// Not-bonded tokens should equal coin supply plus unbonding delegations
 		// plus tokens on unbonded validators
 		if !pool.NotBondedTokens.ToDec().Equal(loose) {
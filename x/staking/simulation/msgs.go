@@ -87,9 +87,8 @@ func SimulateMsgEditValidator(k staking.Keeper) simulation.Operation {
 		}
 		val := keeper.RandomValidator(r, k, ctx)
 		address := val.GetOperator()
-		newCommissionRate := simulation.RandomDecAmount(r, val.Commission.MaxRate)
 
-		msg := staking.NewMsgEditValidator(address, description, &newCommissionRate, nil)
+		msg := staking.NewMsgEditValidator(address, description, nil, nil)
 
 		if msg.ValidateBasic() != nil {
 			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
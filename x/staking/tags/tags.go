@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	ActionCompleteUnbonding    = "complete-unbonding"
-	ActionCompleteRedelegation = "complete-redelegation"
+	ActionCompleteUnbonding       = "complete-unbonding"
+	ActionCompleteRedelegation    = "complete-redelegation"
+	ActionCompleteValidatorChange = "complete-validator-change"
 
 	Action       = sdk.TagAction
 	SrcValidator = sdk.TagSrcValidator
@@ -16,4 +17,5 @@ var (
 	Moniker      = "moniker"
 	Identity     = "identity"
 	EndTime      = "end-time"
+	ShareDenom   = "share-denom"
 )
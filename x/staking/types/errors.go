@@ -91,6 +91,10 @@ func ErrCommissionGTMaxChangeRate(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be changed more than max change rate")
 }
 
+func ErrCommissionChangeNotScheduled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "commission can only be changed by scheduling a validator change")
+}
+
 func ErrSelfDelegationBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "validator's self delegation must be greater than their minimum self delegation")
 }
@@ -103,6 +107,14 @@ func ErrMinSelfDelegationDecreased(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "minimum self delegation cannot be decrease")
 }
 
+func ErrValidatorChangeNotInFuture(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "validator change must take effect after the current block time")
+}
+
+func ErrNoValidatorChange(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "no validator change scheduled for that address")
+}
+
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "delegator address is nil")
 }
@@ -196,6 +208,23 @@ func ErrMaxRedelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 		"too many redelegation entries in this delegator/src-validator/dst-validator trio, please wait for some entries to mature")
 }
 
+func ErrTokenizeSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "validator operators cannot tokenize their self delegation")
+}
+
+func ErrTokenizeRedelegationInProgress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"cannot tokenize a delegation receiving a redelegation, please wait for the redelegation to mature")
+}
+
+func ErrTokenizeAmountTooSmall(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "shares are worth less than one token and cannot be tokenized")
+}
+
+func ErrNoTokenizeShareRecord(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "tokenize share record does not exist")
+}
+
 func ErrDelegatorShareExRateInvalid(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
 		"cannot delegate to validators with invalid (zero) ex-rate")
@@ -14,7 +14,12 @@ type GenesisState struct {
 	Delegations          Delegations           `json:"delegations"`
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations"`
-	Exported             bool                  `json:"exported"`
+	ValidatorChanges     []ValidatorChange     `json:"validator_changes"`
+
+	TokenizeShareRecords      []TokenizeShareRecord `json:"tokenize_share_records"`
+	LastTokenizeShareRecordID uint64                `json:"last_tokenize_share_record_id"`
+
+	Exported bool `json:"exported"`
 }
 
 // Last validator power, needed for validator set update logic
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -13,9 +14,12 @@ import (
 var (
 	_ sdk.Msg = &MsgCreateValidator{}
 	_ sdk.Msg = &MsgEditValidator{}
+	_ sdk.Msg = &MsgScheduleValidatorChange{}
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgTokenizeShares{}
+	_ sdk.Msg = &MsgRedeemTokensForShares{}
 )
 
 //______________________________________________________________________
@@ -230,6 +234,51 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// MsgScheduleValidatorChange - struct for announcing a change of the
+// commission rate or of the minimum self delegation of a validator, which
+// takes effect at EffectiveTime. It replaces any change already scheduled for
+// the validator.
+type MsgScheduleValidatorChange struct {
+	ValidatorAddress  sdk.ValAddress `json:"address"`
+	CommissionRate    *sdk.Dec       `json:"commission_rate"`
+	MinSelfDelegation *sdk.Int       `json:"min_self_delegation"`
+	EffectiveTime     time.Time      `json:"effective_time"`
+}
+
+func NewMsgScheduleValidatorChange(valAddr sdk.ValAddress, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int,
+	effectiveTime time.Time) MsgScheduleValidatorChange {
+
+	return MsgScheduleValidatorChange{
+		ValidatorAddress:  valAddr,
+		CommissionRate:    newRate,
+		MinSelfDelegation: newMinSelfDelegation,
+		EffectiveTime:     effectiveTime,
+	}
+}
+
+//nolint
+func (msg MsgScheduleValidatorChange) Route() string { return RouterKey }
+func (msg MsgScheduleValidatorChange) Type() string  { return "schedule_validator_change" }
+func (msg MsgScheduleValidatorChange) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgScheduleValidatorChange) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgScheduleValidatorChange) ValidateBasic() sdk.Error {
+	return msg.Change().ValidateBasic()
+}
+
+// Change returns the validator change scheduled by the message
+func (msg MsgScheduleValidatorChange) Change() ValidatorChange {
+	return NewValidatorChange(msg.ValidatorAddress, msg.CommissionRate, msg.MinSelfDelegation, msg.EffectiveTime)
+}
+
 // MsgDelegate - struct for bonding transactions
 /**
 委托交易的请求入参
@@ -367,3 +416,94 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgTokenizeShares - struct for tokenizing shares of a delegation into share
+// tokens of the validator, which can be transferred and redeemed back into a
+// delegation. The rewards of the tokenized shares are paid to RewardOwner.
+type MsgTokenizeShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	SharesAmount     sdk.Dec        `json:"shares_amount"`
+	RewardOwner      sdk.AccAddress `json:"reward_owner"`
+}
+
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+	rewardOwner sdk.AccAddress) MsgTokenizeShares {
+
+	return MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		SharesAmount:     sharesAmount,
+		RewardOwner:      rewardOwner,
+	}
+}
+
+//nolint
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+func (msg MsgTokenizeShares) Type() string  { return "tokenize_shares" }
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTokenizeShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.SharesAmount.LTE(sdk.ZeroDec()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	if msg.RewardOwner.Empty() {
+		return sdk.ErrInvalidAddress("reward owner address is nil")
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares - struct for redeeming share tokens back into a
+// delegation to their validator
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	Amount           sdk.Coin       `json:"amount"`
+}
+
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemTokensForShares {
+	return MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+func (msg MsgRedeemTokensForShares) Type() string  { return "redeem_tokens_for_shares" }
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRedeemTokensForShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if !(sdk.Coins{msg.Amount}).IsValid() {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
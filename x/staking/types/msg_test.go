@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
@@ -77,6 +78,42 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgScheduleValidatorChange
+func TestMsgScheduleValidatorChange(t *testing.T) {
+	rate := sdk.NewDecWithPrec(1, 1)
+	negativeRate := sdk.NewDecWithPrec(-1, 1)
+	minSelf := sdk.OneInt()
+	zeroMinSelf := sdk.ZeroInt()
+	effectiveTime := time.Unix(1000, 0).UTC()
+
+	tests := []struct {
+		name              string
+		validatorAddr     sdk.ValAddress
+		newRate           *sdk.Dec
+		minSelfDelegation *sdk.Int
+		effectiveTime     time.Time
+		expectPass        bool
+	}{
+		{"basic good", addr1, &rate, &minSelf, effectiveTime, true},
+		{"only rate", addr1, &rate, nil, effectiveTime, true},
+		{"only min self delegation", addr1, nil, &minSelf, effectiveTime, true},
+		{"empty address", emptyAddr, &rate, &minSelf, effectiveTime, false},
+		{"no change", addr1, nil, nil, effectiveTime, false},
+		{"negative rate", addr1, &negativeRate, nil, effectiveTime, false},
+		{"zero min self delegation", addr1, nil, &zeroMinSelf, effectiveTime, false},
+		{"no effective time", addr1, &rate, nil, time.Time{}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgScheduleValidatorChange(tc.validatorAddr, tc.newRate, tc.minSelfDelegation, tc.effectiveTime)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDelegate
 func TestMsgDelegate(t *testing.T) {
 	tests := []struct {
@@ -156,3 +193,57 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgTokenizeShares
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		sharesAmount  sdk.Dec
+		rewardOwner   sdk.AccAddress
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(addr1), addr2, sdk.NewDecWithPrec(1, 1), sdk.AccAddress(addr1), true},
+		{"other reward owner", sdk.AccAddress(addr1), addr2, sdk.NewDecWithPrec(1, 1), sdk.AccAddress(addr3), true},
+		{"negative decimal", sdk.AccAddress(addr1), addr2, sdk.NewDecWithPrec(-1, 1), sdk.AccAddress(addr1), false},
+		{"zero amount", sdk.AccAddress(addr1), addr2, sdk.ZeroDec(), sdk.AccAddress(addr1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), addr1, sdk.NewDecWithPrec(1, 1), sdk.AccAddress(addr1), false},
+		{"empty validator", sdk.AccAddress(addr1), emptyAddr, sdk.NewDecWithPrec(1, 1), sdk.AccAddress(addr1), false},
+		{"empty reward owner", sdk.AccAddress(addr1), addr2, sdk.NewDecWithPrec(1, 1), sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.sharesAmount, tc.rewardOwner)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRedeemTokensForShares
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	shareToken := sdk.NewInt64Coin(addr2.String()+"/1", 1000)
+
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(addr1), shareToken, true},
+		{"zero amount", sdk.AccAddress(addr1), sdk.NewInt64Coin(shareToken.Denom, 0), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), shareToken, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
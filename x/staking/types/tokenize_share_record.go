@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// TokenizeShareRecord records a delegation that was tokenized. The delegated
+// shares are held by the account of the record, and the holders of the share
+// tokens of the record can redeem them back into a delegation. The rewards
+// of the tokenized delegation are paid to the owner of the record.
+type TokenizeShareRecord struct {
+	ID        uint64         `json:"id"`
+	Owner     sdk.AccAddress `json:"owner"`
+	Validator sdk.ValAddress `json:"validator"`
+}
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		ID:        id,
+		Owner:     owner,
+		Validator: valAddr,
+	}
+}
+
+// GetAddress returns the address of the account holding the tokenized
+// delegation. No key controls it.
+func (r TokenizeShareRecord) GetAddress() sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("tokenizeshare_%d", r.ID))))
+}
+
+// GetShareTokenDenom returns the denomination of the share tokens of the
+// record, which is the validator address followed by the record ID
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator.String(), r.ID)
+}
+
+func (r TokenizeShareRecord) String() string {
+	return fmt.Sprintf(`Tokenize Share Record %d:
+  Owner:     %s
+  Validator: %s
+  Account:   %s
+  Denom:     %s`, r.ID, r.Owner, r.Validator, r.GetAddress(), r.GetShareTokenDenom())
+}
+
+// TokenizeShareRecords is a collection of TokenizeShareRecord
+type TokenizeShareRecords []TokenizeShareRecord
+
+func (rs TokenizeShareRecords) String() (out string) {
+	for _, r := range rs {
+		out += r.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
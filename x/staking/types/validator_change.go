@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// ValidatorChange is a change of the commission rate or of the minimum self
+// delegation of a validator, announced by its operator ahead of the time it
+// takes effect. The fields left nil are not changed.
+type ValidatorChange struct {
+	ValidatorAddress  sdk.ValAddress `json:"validator_address"`
+	CommissionRate    *sdk.Dec       `json:"commission_rate"`
+	MinSelfDelegation *sdk.Int       `json:"min_self_delegation"`
+	EffectiveTime     time.Time      `json:"effective_time"`
+}
+
+// NewValidatorChange creates a new ValidatorChange
+func NewValidatorChange(valAddr sdk.ValAddress, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int,
+	effectiveTime time.Time) ValidatorChange {
+
+	return ValidatorChange{
+		ValidatorAddress:  valAddr,
+		CommissionRate:    newRate,
+		MinSelfDelegation: newMinSelfDelegation,
+		EffectiveTime:     effectiveTime,
+	}
+}
+
+// ValidateBasic performs the stateless checks of a validator change
+func (c ValidatorChange) ValidateBasic() sdk.Error {
+	if c.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+
+	if c.CommissionRate == nil && c.MinSelfDelegation == nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "validator change must include a commission rate or a minimum self delegation")
+	}
+
+	if c.MinSelfDelegation != nil && !(*c.MinSelfDelegation).GT(sdk.ZeroInt()) {
+		return ErrMinSelfDelegationInvalid(DefaultCodespace)
+	}
+
+	if c.CommissionRate != nil {
+		if c.CommissionRate.GT(sdk.OneDec()) || c.CommissionRate.LT(sdk.ZeroDec()) {
+			return sdk.NewError(DefaultCodespace, CodeInvalidInput, "commission rate must be between 0 and 1, inclusive")
+		}
+	}
+
+	if c.EffectiveTime.IsZero() {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "validator change must include an effective time")
+	}
+
+	return nil
+}
+
+func (c ValidatorChange) String() string {
+	out := fmt.Sprintf(`Validator Change:
+  Validator:           %s
+  Effective Time:      %v`, c.ValidatorAddress, c.EffectiveTime)
+	if c.CommissionRate != nil {
+		out += fmt.Sprintf(`
+  Commission Rate:     %s`, c.CommissionRate)
+	}
+	if c.MinSelfDelegation != nil {
+		out += fmt.Sprintf(`
+  Min Self Delegation: %s`, c.MinSelfDelegation)
+	}
+	return out
+}
+
+// ValidatorChanges is a collection of ValidatorChange
+type ValidatorChanges []ValidatorChange
+
+func (cs ValidatorChanges) String() (out string) {
+	for _, c := range cs {
+		out += c.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/supply"
+)
+
+// GetCmdQueryTotalSupply implements the query total supply command.
+func GetCmdQueryTotalSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "total [denom]",
+		Short: "Query the total supply of coins of the chain",
+		Long: strings.TrimSpace(`
+Query the total supply of coins in circulation on the chain, or only that of
+the given denomination:
+
+$ gaiacli query supply total
+$ gaiacli query supply total stake
+`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, supply.QueryTotalSupply), nil)
+				if err != nil {
+					return err
+				}
+
+				var total sdk.Coins
+				cdc.MustUnmarshalJSON(res, &total)
+				return cliCtx.PrintOutput(total)
+			}
+
+			bz, err := cdc.MarshalJSON(supply.NewQuerySupplyOfParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, supply.QuerySupplyOf), bz)
+			if err != nil {
+				return err
+			}
+
+			var amount sdk.Int
+			cdc.MustUnmarshalJSON(res, &amount)
+			return cliCtx.PrintOutput(sdk.Coin{Denom: args[0], Amount: amount})
+		},
+	}
+}
+
+// GetCmdQueryModuleAccounts implements the query module accounts command.
+func GetCmdQueryModuleAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-accounts",
+		Short: "Query the accounts of the modules holding coins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, supply.QueryModuleAccounts), nil)
+			if err != nil {
+				return err
+			}
+
+			var accounts supply.ModuleAccounts
+			cdc.MustUnmarshalJSON(res, &accounts)
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+}
+
+// DONTCOVER
@@ -0,0 +1,14 @@
+/*
+Package supply tracks the total supply of every denomination on the chain.
+
+The supply only changes when coins are created or destroyed. Modules that do
+so report it to the keeper:
+
+	supplyKeeper.Inflate(ctx, minted)  // mint inflation, incoming IBC vouchers
+	supplyKeeper.Deflate(ctx, burned)  // slashed stake, returning IBC vouchers
+
+Transfers between accounts and modules leave the supply untouched. The
+invariants of the modules holding coins check that together they account
+for exactly the recorded supply.
+*/
+package supply
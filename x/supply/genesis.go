@@ -0,0 +1,45 @@
+package supply
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the supply at genesis. A genesis file without a supply
+// leaves it to the application to compute from the coins it holds.
+type GenesisState struct {
+	Supply sdk.Coins `json:"supply"` // total supply of each denomination
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(supply sdk.Coins) GenesisState {
+	return GenesisState{Supply: supply}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(sdk.Coins{})
+}
+
+// InitGenesis sets the supply from genesis and creates the accounts of the
+// registered modules that the genesis accounts do not include
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetTotalSupply(ctx, data.Supply)
+	for _, name := range keeper.GetModuleAccountNames() {
+		keeper.GetModuleAccount(ctx, name)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetTotalSupply(ctx))
+}
+
+// ValidateGenesis checks that the supply is a valid set of coins
+func ValidateGenesis(data GenesisState) error {
+	if !data.Supply.IsValid() {
+		return fmt.Errorf("invalid supply: %s", data.Supply)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package supply
+
+import (
+	"fmt"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "supply"
+
+	// StoreKey is the store key string for supply
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for supply
+	QuerierRoute = ModuleName
+)
+
+// SupplyKeyPrefix is the prefix for the total supply of each denomination
+var SupplyKeyPrefix = []byte{0x00}
+
+// SupplyKey returns the key under which the total supply of a denomination is
+// stored
+func SupplyKey(denom string) []byte {
+	return append(SupplyKeyPrefix, []byte(denom)...)
+}
+
+// Keeper of the supply store
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         *codec.Codec
+	ak          AccountKeeper
+	bk          BankKeeper
+	permissions map[string][]string // permissions of the module accounts by module name
+}
+
+// NewKeeper returns a supply keeper. The permissions map registers the modules
+// that own an account and the permissions their account is granted.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ak AccountKeeper, bk BankKeeper,
+	permissions map[string][]string) Keeper {
+
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		ak:          ak,
+		bk:          bk,
+		permissions: permissions,
+	}
+}
+
+// GetSupply returns the total supply of a denomination
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(SupplyKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	return amount
+}
+
+func (k Keeper) setSupply(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(SupplyKey(denom))
+		return
+	}
+	store.Set(SupplyKey(denom), k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+}
+
+// GetTotalSupply returns the total supply of all denominations
+func (k Keeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SupplyKeyPrefix)
+	defer iterator.Close()
+
+	var supply sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		denom := string(iterator.Key()[len(SupplyKeyPrefix):])
+		supply = append(supply, sdk.Coin{Denom: denom, Amount: amount})
+	}
+	return supply
+}
+
+// SetTotalSupply replaces the total supply of all denominations
+func (k Keeper) SetTotalSupply(ctx sdk.Context, supply sdk.Coins) {
+	for _, coin := range k.GetTotalSupply(ctx) {
+		k.setSupply(ctx, coin.Denom, sdk.ZeroInt())
+	}
+	for _, coin := range supply {
+		k.setSupply(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+// Inflate adds newly created coins to the supply
+func (k Keeper) Inflate(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.setSupply(ctx, coin.Denom, k.GetSupply(ctx, coin.Denom).Add(coin.Amount))
+	}
+}
+
+// Deflate removes destroyed coins from the supply. Destroying more coins than
+// exist is a bug in the calling module, so it panics.
+func (k Keeper) Deflate(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		supply := k.GetSupply(ctx, coin.Denom)
+		if supply.LT(coin.Amount) {
+			panic(fmt.Sprintf("cannot destroy %s, the supply of %s is %s", coin, coin.Denom, supply))
+		}
+		k.setSupply(ctx, coin.Denom, supply.Sub(coin.Amount))
+	}
+}
@@ -0,0 +1,147 @@
+package supply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/store"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+	"my-cosmos/cosmos-sdk/x/bank"
+	"my-cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	minterName = "minter"
+	burnerName = "burner"
+	holderName = "holder"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+
+	permissions := map[string][]string{
+		minterName: {auth.Minter},
+		burnerName: {auth.Burner},
+		holderName: nil,
+	}
+	return ctx, ak, NewKeeper(cdc, key, ak, bk, permissions)
+}
+
+func TestInflateDeflate(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	require.True(t, keeper.GetTotalSupply(ctx).Empty())
+
+	keeper.Inflate(ctx, sdk.Coins{sdk.NewInt64Coin("bar", 5), sdk.NewInt64Coin("foo", 10)})
+	keeper.Inflate(ctx, sdk.Coins{sdk.NewInt64Coin("foo", 3)})
+	require.Equal(t, sdk.NewInt(13), keeper.GetSupply(ctx, "foo"))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("bar", 5), sdk.NewInt64Coin("foo", 13)}, keeper.GetTotalSupply(ctx))
+
+	// a denomination that is entirely destroyed is dropped
+	keeper.Deflate(ctx, sdk.Coins{sdk.NewInt64Coin("bar", 5), sdk.NewInt64Coin("foo", 1)})
+	require.True(t, keeper.GetSupply(ctx, "bar").IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foo", 12)}, keeper.GetTotalSupply(ctx))
+
+	require.Panics(t, func() { keeper.Deflate(ctx, sdk.Coins{sdk.NewInt64Coin("foo", 13)}) })
+	require.Panics(t, func() { keeper.Deflate(ctx, sdk.Coins{sdk.NewInt64Coin("baz", 1)}) })
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, ak, keeper := createTestInput(t)
+	keeper.Inflate(ctx, sdk.Coins{sdk.NewInt64Coin("baz", 1)})
+
+	// genesis replaces whatever supply there was
+	genesis := NewGenesisState(sdk.Coins{sdk.NewInt64Coin("bar", 5), sdk.NewInt64Coin("foo", 10)})
+	require.NoError(t, ValidateGenesis(genesis))
+	InitGenesis(ctx, keeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(ctx, keeper))
+
+	// the accounts of all registered modules exist after genesis
+	for _, name := range []string{burnerName, holderName, minterName} {
+		require.NotNil(t, ak.GetModuleAccount(ctx, name))
+	}
+
+	require.Error(t, ValidateGenesis(NewGenesisState(sdk.Coins{sdk.NewInt64Coin("foo", 1), sdk.NewInt64Coin("bar", 1)})))
+}
+
+func TestQuerier(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	keeper.Inflate(ctx, sdk.Coins{sdk.NewInt64Coin("foo", 10)})
+	querier := NewQuerier(keeper)
+
+	res, err := querier(ctx, []string{QueryTotalSupply}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var total sdk.Coins
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &total))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, total)
+
+	bz, _ := keeper.cdc.MarshalJSON(NewQuerySupplyOfParams("foo"))
+	res, err = querier(ctx, []string{QuerySupplyOf}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var amount sdk.Int
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &amount))
+	require.Equal(t, sdk.NewInt(10), amount)
+
+	_, err = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
+
+func TestMintBurnCoins(t *testing.T) {
+	ctx, ak, keeper := createTestInput(t)
+	coins := sdk.Coins{sdk.NewInt64Coin("foo", 10)}
+
+	// only the minter mints and only the burner burns
+	require.Panics(t, func() { keeper.MintCoins(ctx, holderName, coins) })
+	require.Panics(t, func() { keeper.MintCoins(ctx, "unregistered", coins) })
+	require.Nil(t, keeper.MintCoins(ctx, minterName, coins))
+	require.Equal(t, coins, ak.GetModuleAccount(ctx, minterName).GetCoins())
+	require.Equal(t, coins, keeper.GetTotalSupply(ctx))
+
+	require.Nil(t, keeper.SendCoinsFromModuleToModule(ctx, minterName, burnerName, coins))
+	require.True(t, ak.GetModuleAccount(ctx, minterName).GetCoins().Empty())
+
+	require.Panics(t, func() { keeper.BurnCoins(ctx, minterName, coins) })
+	require.NotNil(t, keeper.BurnCoins(ctx, burnerName, coins.Add(coins)))
+	require.Nil(t, keeper.BurnCoins(ctx, burnerName, coins))
+	require.True(t, ak.GetModuleAccount(ctx, burnerName).GetCoins().Empty())
+	require.True(t, keeper.GetTotalSupply(ctx).Empty())
+}
+
+func TestQueryModuleAccounts(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	require.Nil(t, keeper.MintCoins(ctx, minterName, sdk.Coins{sdk.NewInt64Coin("foo", 10)}))
+	querier := NewQuerier(keeper)
+
+	res, err := querier(ctx, []string{QueryModuleAccounts}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var accounts []*auth.ModuleAccount
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &accounts))
+	require.Len(t, accounts, 1)
+	require.Equal(t, minterName, accounts[0].GetName())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, accounts[0].GetCoins())
+}
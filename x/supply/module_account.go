@@ -0,0 +1,126 @@
+package supply
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+)
+
+// GetModuleAccount returns the account of a registered module, creating it
+// with its registered permissions if it does not exist yet. Modules that are
+// not registered have no account, so asking for one is a wiring bug.
+func (k Keeper) GetModuleAccount(ctx sdk.Context, name string) *auth.ModuleAccount {
+	permissions, ok := k.permissions[name]
+	if !ok {
+		panic(fmt.Sprintf("module %s has no registered account", name))
+	}
+
+	macc := k.ak.GetModuleAccount(ctx, name)
+	if macc != nil {
+		return macc
+	}
+
+	macc = k.ak.NewAccount(ctx, auth.NewEmptyModuleAccount(name, permissions...)).(*auth.ModuleAccount)
+	k.ak.SetAccount(ctx, macc)
+	return macc
+}
+
+// GetModuleAddress returns the address of the account of a module
+func (k Keeper) GetModuleAddress(name string) sdk.AccAddress {
+	return auth.NewModuleAddress(name)
+}
+
+// GetModuleAccountNames returns the names of the registered modules in a
+// deterministic order
+func (k Keeper) GetModuleAccountNames() []string {
+	names := make([]string, 0, len(k.permissions))
+	for name := range k.permissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MintCoins creates new coins in the account of a module, which must have
+// the minter permission, and adds them to the supply.
+func (k Keeper) MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error {
+	macc := k.GetModuleAccount(ctx, name)
+	if !macc.HasPermission(auth.Minter) {
+		panic(fmt.Sprintf("module account %s does not have permission to mint coins", name))
+	}
+
+	if _, _, err := k.bk.AddCoins(ctx, macc.GetAddress(), amt); err != nil {
+		return err
+	}
+
+	k.Inflate(ctx, amt)
+	return nil
+}
+
+// BurnCoins destroys coins held by the account of a module, which must have
+// the burner permission, and removes them from the supply.
+func (k Keeper) BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error {
+	macc := k.GetModuleAccount(ctx, name)
+	if !macc.HasPermission(auth.Burner) {
+		panic(fmt.Sprintf("module account %s does not have permission to burn coins", name))
+	}
+
+	if _, _, err := k.bk.SubtractCoins(ctx, macc.GetAddress(), amt); err != nil {
+		return err
+	}
+
+	k.Deflate(ctx, amt)
+	return nil
+}
+
+// SendCoinsFromModuleToAccount moves coins from the account of a module to
+// another account
+func (k Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string,
+	recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+
+	k.GetModuleAccount(ctx, senderModule)
+	_, err := k.bk.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	return err
+}
+
+// SendCoinsFromAccountToModule moves coins from an account to the account of a
+// module
+func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress,
+	recipientModule string, amt sdk.Coins) sdk.Error {
+
+	k.GetModuleAccount(ctx, recipientModule)
+	_, err := k.bk.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	return err
+}
+
+// SendCoinsFromModuleToModule moves coins between the accounts of two modules
+func (k Keeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule,
+	recipientModule string, amt sdk.Coins) sdk.Error {
+
+	k.GetModuleAccount(ctx, senderModule)
+	k.GetModuleAccount(ctx, recipientModule)
+	_, err := k.bk.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	return err
+}
+
+// DelegateCoinsFromAccountToModule delegates coins of an account to the
+// account of a module with the staking permission
+func (k Keeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress,
+	recipientModule string, amt sdk.Coins) sdk.Error {
+
+	k.GetModuleAccount(ctx, recipientModule)
+	_, err := k.bk.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	return err
+}
+
+// UndelegateCoinsFromModuleToAccount returns delegated coins from the account
+// of a module with the staking permission to the delegator
+func (k Keeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string,
+	recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+
+	k.GetModuleAccount(ctx, senderModule)
+	_, err := k.bk.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	return err
+}
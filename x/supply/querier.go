@@ -0,0 +1,94 @@
+package supply
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	"my-cosmos/cosmos-sdk/x/auth"
+)
+
+// query endpoints supported by the supply Querier
+const (
+	QueryTotalSupply    = "total"
+	QuerySupplyOf       = "supply_of"
+	QueryModuleAccounts = "module_accounts"
+)
+
+// QuerySupplyOfParams are the params for the supply of a denomination query
+type QuerySupplyOfParams struct {
+	Denom string `json:"denom"`
+}
+
+// NewQuerySupplyOfParams creates a new instance of QuerySupplyOfParams
+func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
+	return QuerySupplyOfParams{Denom: denom}
+}
+
+// ModuleAccounts is the list of module accounts returned by the module
+// accounts query
+type ModuleAccounts []*auth.ModuleAccount
+
+// String implements fmt.Stringer
+func (mas ModuleAccounts) String() string {
+	out := make([]string, len(mas))
+	for i, macc := range mas {
+		out[i] = macc.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// NewQuerier creates a querier for the supply module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryTotalSupply:
+			return queryTotalSupply(ctx, k)
+		case QuerySupplyOf:
+			return querySupplyOf(ctx, req, k)
+		case QueryModuleAccounts:
+			return queryModuleAccounts(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown supply query endpoint")
+		}
+	}
+}
+
+func queryTotalSupply(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetTotalSupply(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySupplyOfParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetSupply(ctx, params.Denom))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryModuleAccounts(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	accounts := []*auth.ModuleAccount{}
+	for _, name := range k.GetModuleAccountNames() {
+		if macc := k.ak.GetModuleAccount(ctx, name); macc != nil {
+			accounts = append(accounts, macc)
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, accounts)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package upgrade
+
+import (
+	"fmt"
+	"time"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker performs the scheduled upgrade once its height is reached. It
+// must be called before any other module's BeginBlocker so that the state
+// migrations run before the new code touches the store.
+//
+// A node whose binary has no handler for the upgrade halts after committing
+// the block preceding the upgrade height. Should it be restarted with the same
+// binary, it panics at the upgrade height rather than process the block.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	logger := ctx.Logger().With("module", "x/upgrade")
+
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	// time based plans are pinned to the block following the first block at
+	// or past the upgrade time, so every node agrees on the upgrade height
+	if plan.Height == 0 {
+		if ctx.BlockHeader().Time.Before(plan.Time) {
+			return
+		}
+		plan.Height = ctx.BlockHeight() + 1
+		plan.Time = time.Time{}
+		k.setUpgradePlan(ctx, plan)
+	}
+
+	switch {
+	case plan.ShouldExecute(ctx):
+		handler, ok := k.handlers[plan.Name]
+		if !ok {
+			panic(fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info))
+		}
+
+		logger.Info(fmt.Sprintf("applying upgrade \"%s\" at height %d", plan.Name, ctx.BlockHeight()))
+		handler(ctx, plan)
+
+		k.ClearUpgradePlan(ctx)
+		k.setDone(ctx, plan.Name)
+
+	case plan.Height == ctx.BlockHeight()+1 && !k.HasUpgradeHandler(plan.Name):
+		logger.Error(fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d, halting after this block: %s",
+			plan.Name, plan.Height, plan.Info))
+
+		if k.halt != nil {
+			k.halt(ctx.BlockHeight())
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/upgrade"
+)
+
+// softwareUpgradeProposal defines a software upgrade proposal read from a file
+type softwareUpgradeProposal struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Plan        upgrade.Plan `json:"plan"`
+	Deposit     string       `json:"deposit"`
+}
+
+// the plan is decoded with the codec so that its height uses the same JSON
+// encoding as the query output
+func parseSoftwareUpgradeProposal(cdc *codec.Codec, proposalFile string) (*softwareUpgradeProposal, error) {
+	proposal := &softwareUpgradeProposal{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, proposal); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/upgrade"
+)
+
+func TestParseSoftwareUpgradeProposal(t *testing.T) {
+	okJSON, err := ioutil.TempFile("", "proposal")
+	require.Nil(t, err, "unexpected error")
+	okJSON.WriteString(`
+{
+  "title": "Upgrade to v2",
+  "description": "Switch to the v2 binary",
+  "plan": {
+    "name": "v2",
+    "height": "100000",
+    "info": "release notes"
+  },
+  "deposit": "10test"
+}
+`)
+
+	cdc := codec.New()
+
+	// nonexistent json
+	_, err = parseSoftwareUpgradeProposal(cdc, "fileDoesNotExist")
+	require.Error(t, err)
+
+	// ok json
+	proposal, err := parseSoftwareUpgradeProposal(cdc, okJSON.Name())
+	require.Nil(t, err, "unexpected error")
+	require.Equal(t, "Upgrade to v2", proposal.Title)
+	require.Equal(t, "Switch to the v2 binary", proposal.Description)
+	require.Equal(t, upgrade.Plan{Name: "v2", Height: 100000, Info: "release notes"}, proposal.Plan)
+	require.Equal(t, "10test", proposal.Deposit)
+
+	err = okJSON.Close()
+	require.Nil(t, err, "unexpected error")
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/x/upgrade"
+)
+
+// GetCmdQueryPlan implements the query upgrade plan command.
+func GetCmdQueryPlan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Query the currently scheduled upgrade plan",
+		Long: strings.TrimSpace(`
+Query the upgrade plan scheduled by governance, if any:
+
+$ gaiacli query upgrade plan
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryCurrent), nil)
+			if err != nil {
+				return err
+			}
+
+			var plan upgrade.Plan
+			cdc.MustUnmarshalJSON(res, &plan)
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the query applied upgrade command.
+func GetCmdQueryApplied(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Short: "Query the height at which an upgrade was applied",
+		Long: strings.TrimSpace(`
+Query the height at which a past upgrade was applied:
+
+$ gaiacli query upgrade applied v0.34
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(upgrade.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryApplied), bz)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			cdc.MustUnmarshalJSON(res, &height)
+			if height == 0 {
+				return fmt.Errorf("upgrade %s has not been applied", args[0])
+			}
+
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
+
+// DONTCOVER
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"my-cosmos/cosmos-sdk/client/context"
+	"my-cosmos/cosmos-sdk/client/utils"
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+	authtxb "my-cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"my-cosmos/cosmos-sdk/x/gov"
+	"my-cosmos/cosmos-sdk/x/upgrade"
+)
+
+// GetCmdSubmitProposal implements a command handler for submitting a software
+// upgrade proposal transaction.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "software-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(`
+Submit a software upgrade proposal along with an initial deposit. The proposal details must be supplied via a JSON file. The plan sets either a height or a time at which the upgrade takes place:
+
+$ gaiacli tx gov submit-proposal software-upgrade <path/to/proposal.json> --from mykey
+
+where proposal.json contains:
+
+{
+  "title": "Upgrade to v2",
+  "description": "Switch to the v2 binary",
+  "plan": {
+    "name": "v2",
+    "height": "100000",
+    "info": "https://example.com/v2/release-notes"
+  },
+  "deposit": "10stake"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := parseSoftwareUpgradeProposal(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := upgrade.NewSoftwareUpgradeProposal(proposal.Title, proposal.Description, proposal.Plan)
+
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
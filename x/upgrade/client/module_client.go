@@ -0,0 +1,46 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"my-cosmos/cosmos-sdk/client"
+	"my-cosmos/cosmos-sdk/x/upgrade"
+	"my-cosmos/cosmos-sdk/x/upgrade/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:   upgrade.ModuleName,
+		Short: "Querying commands for the upgrade module",
+	}
+
+	upgradeQueryCmd.AddCommand(
+		client.GetCommands(
+			cli.GetCmdQueryPlan(mc.storeKey, mc.cdc),
+			cli.GetCmdQueryApplied(mc.storeKey, mc.cdc),
+		)...,
+	)
+
+	return upgradeQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module. Upgrades are
+// scheduled through governance, so there are none.
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   upgrade.ModuleName,
+		Short: "Upgrade transactions subcommands",
+	}
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "my-cosmos/cosmos-sdk/x/gov/client"
+	"my-cosmos/cosmos-sdk/x/upgrade/client/cli"
+	"my-cosmos/cosmos-sdk/x/upgrade/client/rest"
+)
+
+// ProposalHandler is the software upgrade proposal handler of the gov client
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
@@ -0,0 +1,11 @@
+package upgrade
+
+import (
+	"my-cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the governance proposal contents of the upgrade
+// module on the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "upgrade/SoftwareUpgradeProposal", nil)
+}
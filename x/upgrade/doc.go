@@ -0,0 +1,24 @@
+/*
+Package upgrade coordinates software upgrades of a running chain.
+
+An upgrade is described by a Plan, usually scheduled by a passed
+SoftwareUpgrade governance proposal. A plan names the upgrade and gives either
+the height or the time at which it takes effect.
+
+Binaries that know about an upgrade register a Handler under the plan name.
+The handler runs the required state migrations in BeginBlock of the upgrade
+height, exactly once:
+
+	app.upgradeKeeper.SetUpgradeHandler("v0.34", func(ctx sdk.Context, plan upgrade.Plan) {
+		// migrate state
+	})
+
+Binaries without a handler for the plan stop after committing the block
+preceding the upgrade height, through the HaltFunc set on the keeper. The
+operator then restarts the node with the new binary, which performs the
+migration and carries on from there. This replaces the export/import genesis
+cycle otherwise needed to upgrade a chain.
+
+BeginBlocker must be called before the BeginBlocker of any other module.
+*/
+package upgrade
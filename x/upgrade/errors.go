@@ -0,0 +1,28 @@
+// nolint
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan    sdk.CodeType = 1
+	CodeUpgradeApplied sdk.CodeType = 2
+	CodeNoUpgradePlan  sdk.CodeType = 3
+)
+
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+func ErrUpgradeApplied(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeApplied, fmt.Sprintf("upgrade %s has already been applied", name))
+}
+
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradePlan, "no upgrade is scheduled")
+}
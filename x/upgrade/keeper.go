@@ -0,0 +1,157 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "upgrade"
+
+	// StoreKey is the store key string for upgrade
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+// Keys for upgrade store
+var (
+	// PlanKey is the key under which the current plan is stored
+	PlanKey = []byte{0x00}
+
+	// DoneKeyPrefix is the prefix for the heights at which upgrades were applied
+	DoneKeyPrefix = []byte{0x01}
+)
+
+// DoneKey returns the key under which the height of an applied upgrade is stored
+func DoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
+
+// Handler performs the state migrations of a named upgrade. It is run in
+// BeginBlock of the upgrade height, exactly once.
+type Handler func(ctx sdk.Context, plan Plan)
+
+// HaltFunc is called when the running binary has no handler for the upcoming
+// upgrade. It receives the last height the node should commit.
+type HaltFunc func(height int64)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	handlers map[string]Handler
+	halt     HaltFunc
+}
+
+// NewKeeper returns an upgrade keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+		handlers: make(map[string]Handler),
+	}
+}
+
+// SetHaltFunc sets the function used to stop the node before an upgrade it
+// cannot perform. Without it the node panics at the upgrade height instead.
+func (k *Keeper) SetHaltFunc(halt HaltFunc) *Keeper {
+	if k.halt != nil {
+		panic("cannot set upgrade halt function twice")
+	}
+	k.halt = halt
+	return k
+}
+
+// SetUpgradeHandler registers the handler run when the upgrade with the
+// given name is reached. New binaries register the handlers of the upgrades
+// they know about.
+func (k Keeper) SetUpgradeHandler(name string, handler Handler) {
+	k.handlers[name] = handler
+}
+
+// HasUpgradeHandler returns true if the binary knows how to perform the upgrade
+func (k Keeper) HasUpgradeHandler(name string) bool {
+	_, ok := k.handlers[name]
+	return ok
+}
+
+// ValidatePlan checks that the plan can be scheduled at the current block
+func (k Keeper) ValidatePlan(ctx sdk.Context, plan Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height == 0 && !plan.Time.After(ctx.BlockHeader().Time) {
+		return ErrInvalidPlan(DefaultCodespace, "upgrade cannot be scheduled in the past")
+	}
+
+	// the node must see the plan in the block before the upgrade to be able
+	// to halt cleanly, hence the plan must be at least two blocks ahead
+	if plan.Height != 0 && plan.Height <= ctx.BlockHeight()+1 {
+		return ErrInvalidPlan(DefaultCodespace, fmt.Sprintf(
+			"upgrade height must be greater than %d", ctx.BlockHeight()+1))
+	}
+
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return ErrUpgradeApplied(DefaultCodespace, plan.Name)
+	}
+
+	return nil
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan. If there
+// is another plan already scheduled, it is overwritten.
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) sdk.Error {
+	if err := k.ValidatePlan(ctx, plan); err != nil {
+		return err
+	}
+
+	k.setUpgradePlan(ctx, plan)
+	return nil
+}
+
+// GetUpgradePlan returns the currently scheduled plan, if any
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+func (k Keeper) setUpgradePlan(ctx sdk.Context, plan Plan) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(plan)
+	store.Set(PlanKey, bz)
+}
+
+// ClearUpgradePlan removes the currently scheduled plan
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PlanKey)
+}
+
+// GetDoneHeight returns the height at which the named upgrade was applied,
+// or 0 if it has never been applied
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) (height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(DoneKey(name))
+	if bz == nil {
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &height)
+	return height
+}
+
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(ctx.BlockHeight())
+	store.Set(DoneKey(name), bz)
+}
@@ -0,0 +1,156 @@
+package upgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"my-cosmos/cosmos-sdk/codec"
+	"my-cosmos/cosmos-sdk/store"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	header := abci.Header{Height: 10, Time: time.Unix(1000, 0).UTC()}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+
+	return ctx, NewKeeper(codec.New(), key)
+}
+
+func nextBlock(ctx sdk.Context, d time.Duration) sdk.Context {
+	header := ctx.BlockHeader()
+	header.Height++
+	header.Time = header.Time.Add(d)
+	return ctx.WithBlockHeader(header).WithBlockHeight(header.Height)
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	now := ctx.BlockHeader().Time
+
+	tests := []struct {
+		plan       Plan
+		expectPass bool
+	}{
+		{Plan{Name: "test", Height: 12}, true},
+		{Plan{Name: "test", Time: now.Add(time.Hour)}, true},
+		{Plan{Name: "", Height: 12}, false},
+		{Plan{Name: "test"}, false},
+		{Plan{Name: "test", Height: 12, Time: now.Add(time.Hour)}, false},
+		{Plan{Name: "test", Height: -1}, false},
+		{Plan{Name: "test", Height: 11}, false},
+		{Plan{Name: "test", Time: now}, false},
+	}
+
+	for i, tc := range tests {
+		err := keeper.ScheduleUpgrade(ctx, tc.plan)
+		if tc.expectPass {
+			require.Nil(t, err, "tc #%d", i)
+			plan, found := keeper.GetUpgradePlan(ctx)
+			require.True(t, found, "tc #%d", i)
+			require.Equal(t, tc.plan, plan, "tc #%d", i)
+		} else {
+			require.NotNil(t, err, "tc #%d", i)
+		}
+	}
+
+	keeper.ClearUpgradePlan(ctx)
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestBeginBlockerRunsHandlerOnce(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+
+	calls := 0
+	keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan Plan) { calls++ })
+	require.Nil(t, keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Height: 12}))
+
+	ctx = nextBlock(ctx, time.Second)
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, 0, calls)
+
+	ctx = nextBlock(ctx, time.Second)
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, 1, calls)
+	require.Equal(t, int64(12), keeper.GetDoneHeight(ctx, "test"))
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	ctx = nextBlock(ctx, time.Second)
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, 1, calls)
+
+	// an applied upgrade cannot be scheduled again
+	require.NotNil(t, keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Height: 20}))
+}
+
+func TestBeginBlockerHaltsWithoutHandler(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+
+	haltHeight := int64(0)
+	keeper.SetHaltFunc(func(height int64) { haltHeight = height })
+	require.Nil(t, keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Height: 12}))
+
+	ctx = nextBlock(ctx, time.Second)
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, int64(11), haltHeight)
+
+	// restarting with the old binary must not process the upgrade height
+	ctx = nextBlock(ctx, time.Second)
+	require.Panics(t, func() { BeginBlocker(ctx, keeper) })
+}
+
+func TestBeginBlockerTimeBasedPlan(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+
+	haltHeight := int64(0)
+	keeper.SetHaltFunc(func(height int64) { haltHeight = height })
+	upgradeTime := ctx.BlockHeader().Time.Add(time.Minute)
+	require.Nil(t, keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Time: upgradeTime}))
+
+	ctx = nextBlock(ctx, time.Second)
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, int64(0), haltHeight)
+
+	ctx = nextBlock(ctx, time.Minute)
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, ctx.BlockHeight(), haltHeight)
+
+	plan, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+1, plan.Height)
+	require.True(t, plan.Time.IsZero())
+}
+
+func TestSoftwareUpgradeProposalHandler(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	handler := NewSoftwareUpgradeProposalHandler(keeper)
+
+	proposal := NewSoftwareUpgradeProposal("Test", "description", Plan{Name: "test", Height: 11})
+	require.Nil(t, proposal.ValidateBasic())
+	require.NotNil(t, handler(ctx, proposal))
+
+	proposal = NewSoftwareUpgradeProposal("Test", "description", Plan{Name: "test", Height: 12})
+	require.Nil(t, handler(ctx, proposal))
+
+	plan, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, proposal.Plan, plan)
+
+	proposal = NewSoftwareUpgradeProposal("Test", "", Plan{Name: "test", Height: 12})
+	require.NotNil(t, proposal.ValidateBasic())
+}
@@ -0,0 +1,61 @@
+package upgrade
+
+import (
+	"fmt"
+	"time"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// Plan specifies information about a planned upgrade and when it should occur
+type Plan struct {
+	// Name of the upgrade. Binaries register the handler performing the
+	// state migration under this name.
+	Name string `json:"name"`
+
+	// Time after which the upgrade is performed. The upgrade happens in the
+	// block following the first block with a time at or past it.
+	// Exactly one of Time and Height must be set.
+	Time time.Time `json:"time"`
+
+	// Height at which the upgrade is performed. Nodes running a binary that
+	// does not know the upgrade halt after committing the previous block.
+	Height int64 `json:"height"`
+
+	// Any application specific upgrade info, e.g. where to fetch the new binary
+	Info string `json:"info"`
+}
+
+// ValidateBasic performs stateless checks on the plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(p.Name) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+	if p.Height < 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height cannot be negative")
+	}
+	if p.Height == 0 && p.Time.IsZero() {
+		return ErrInvalidPlan(DefaultCodespace, "must set either time or height")
+	}
+	if p.Height != 0 && !p.Time.IsZero() {
+		return ErrInvalidPlan(DefaultCodespace, "cannot set both time and height")
+	}
+	return nil
+}
+
+// ShouldExecute returns true if the upgrade must be performed in the block of
+// the given context
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && p.Height <= ctx.BlockHeight()
+}
+
+func (p Plan) String() string {
+	due := fmt.Sprintf("Height: %d", p.Height)
+	if p.Height == 0 {
+		due = fmt.Sprintf("Time:   %s", p.Time.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  %s
+  Info:   %s`, p.Name, due, p.Info)
+}
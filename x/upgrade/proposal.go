@@ -0,0 +1,61 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// RouterKey is the governance route of software upgrade proposals
+	RouterKey = ModuleName
+
+	// ProposalTypeSoftwareUpgrade is the type of a SoftwareUpgradeProposal
+	ProposalTypeSoftwareUpgrade = "SoftwareUpgrade"
+)
+
+// Assert SoftwareUpgradeProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = SoftwareUpgradeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "upgrade/SoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal is a governance proposal content which, once the
+// proposal passes, schedules its upgrade plan
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Plan        Plan   `json:"plan"`
+}
+
+// NewSoftwareUpgradeProposal creates a SoftwareUpgradeProposal
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{title, description, plan}
+}
+
+// nolint
+func (sup SoftwareUpgradeProposal) GetTitle() string       { return sup.Title }
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+func (sup SoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
+func (sup SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic validates the title and description of the proposal along with
+// its plan
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(DefaultCodespace, sup); err != nil {
+		return err
+	}
+
+	return sup.Plan.ValidateBasic()
+}
+
+func (sup SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  %s
+`, sup.Title, sup.Description, sup.Plan)
+}
@@ -0,0 +1,23 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "my-cosmos/cosmos-sdk/types"
+	govtypes "my-cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewSoftwareUpgradeProposalHandler returns the governance handler scheduling
+// the plans of passed SoftwareUpgradeProposals
+func NewSoftwareUpgradeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case SoftwareUpgradeProposal:
+			return k.ScheduleUpgrade(ctx, c.Plan)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
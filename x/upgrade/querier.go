@@ -0,0 +1,67 @@
+package upgrade
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"my-cosmos/cosmos-sdk/codec"
+	sdk "my-cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+)
+
+// QueryAppliedParams are the params for the applied upgrade query
+type QueryAppliedParams struct {
+	Name string `json:"name"`
+}
+
+// NewQueryAppliedParams creates a new instance of QueryAppliedParams
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}
+
+// NewQuerier creates a querier for the upgrade module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryCurrent:
+			return queryCurrent(ctx, k)
+		case QueryApplied:
+			return queryApplied(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+func queryCurrent(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return nil, ErrNoUpgradePlan(DefaultCodespace)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, plan)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryApplied(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAppliedParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	height := k.GetDoneHeight(ctx, params.Name)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, height)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}